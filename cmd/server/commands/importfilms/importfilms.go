@@ -0,0 +1,110 @@
+package importfilms
+
+import (
+	"context"
+	"errors"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/endpoints"
+	domainUser "film-management/internal/user/domain"
+	"film-management/pkg/cache"
+	"film-management/pkg/database"
+	filmRepo "film-management/repositories/storage/postgres/film"
+	userRepo "film-management/repositories/storage/postgres/user"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	ErrConnectFilmDB   = errors.New("error connect to film database")
+	ErrReadImportFile  = errors.New("error read import file")
+	ErrImportFormat    = errors.New("format of import file must be csv or jsonl")
+	ErrCreatorID       = errors.New("creator of imported films must be UUID of a user")
+	ErrCreatorNotFound = errors.New("creator of imported films not found")
+	ErrFindCreator     = errors.New("error find creator of imported films")
+)
+
+// Films imports films from the file as the creator and writes the report of rows as JSON to out.
+// The format is got from the file extension if it is empty, the import is done with the rights of the creator.
+// Imported films invalidate the cache of films if it is passed, so servers sharing it do not return old lists.
+func Films(dc *database.Config, path, format, creatorID string, dryRun bool, filmCache cache.Cache, cacheTTL time.Duration, out io.Writer, logger *zap.Logger) error {
+	logger.Info("Run import films", zap.String("path", path), zap.Bool("dryRun", dryRun))
+
+	// Get format from the file extension
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = endpoints.ImportFormatCSV
+		case ".jsonl", ".ndjson":
+			format = endpoints.ImportFormatJSONL
+		default:
+			return ErrImportFormat
+		}
+	}
+
+	creatorUUID, errUUID := uuid.Parse(creatorID)
+	if errUUID != nil {
+		return ErrCreatorID
+	}
+
+	data, errRead := os.ReadFile(path)
+	if errRead != nil {
+		logger.Error("Error read import file", zap.Error(errRead))
+
+		return ErrReadImportFile
+	}
+
+	clientDB, errDB := database.Connect(dc, logger)
+	if errDB != nil {
+		logger.Error("Error connect to film database", zap.Error(errDB))
+
+		return ErrConnectFilmDB
+	}
+
+	// Films are saved with the creator, so the creator must exist and the import is done with its role
+	creator, errCreator := userRepo.NewUserRepository(clientDB, logger).FindOneUserByUUID(context.Background(), creatorUUID)
+	if errCreator != nil {
+		if errors.Is(errCreator, domainUser.ErrUserNotFound) {
+			return ErrCreatorNotFound
+		}
+		logger.Error("Error find creator of imported films", zap.Error(errCreator))
+
+		return ErrFindCreator
+	}
+
+	service := domain.NewService(filmRepo.NewFilmRepository(clientDB, logger))
+	if filmCache != nil && cacheTTL > 0 {
+		service = domain.NewCachingMiddleware(filmCache, cacheTTL, logger)(service)
+	}
+
+	dryRunValue := "false"
+	if dryRun {
+		dryRunValue = "true"
+	}
+
+	resp, err := endpoints.MakeImportFilmsEndpoint(service)(context.Background(), endpoints.ImportFilmsRequest{
+		UserID:   creator.UUID.String(),
+		UserRole: string(creator.Role),
+		Format:   format,
+		DryRun:   dryRunValue,
+		Data:     data,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, _ := resp.(endpoints.ImportFilmsResponse)
+	if report.Err != nil {
+		return report.Err
+	}
+
+	encoder := jsoniter.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
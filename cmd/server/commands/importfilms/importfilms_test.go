@@ -0,0 +1,111 @@
+package importfilms_test
+
+import (
+	"bytes"
+	"context"
+	"film-management/cmd/server/commands/importfilms"
+	"film-management/cmd/server/commands/migrate"
+	"film-management/internal/film/domain"
+	modelsFilm "film-management/internal/film/domain/models"
+	modelsUser "film-management/internal/user/domain/models"
+	"film-management/pkg/cache"
+	"film-management/pkg/database"
+	"film-management/pkg/database/sqlite"
+	"film-management/pkg/policy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const importCSV = "title,director,genres,release_date,casts,synopsis\n" +
+	"Alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n"
+
+func TestFilms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		role            policy.Role
+		creatorID       string
+		dryRun          bool
+		expectedErr     error
+		expectedCreated bool
+	}{
+		{name: "editor", role: policy.RoleEditor, expectedCreated: true},
+		{name: "dry run", role: policy.RoleEditor, dryRun: true},
+		{name: "viewer", role: policy.RoleViewer, expectedErr: domain.ErrFilmNotCreatePermission},
+		{name: "unknown creator", role: policy.RoleEditor, creatorID: uuid.New().String(), expectedErr: importfilms.ErrCreatorNotFound},
+		{name: "creator is not uuid", role: policy.RoleEditor, creatorID: "alice", expectedErr: importfilms.ErrCreatorID},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			config, creator := newDatabase(t, test.role)
+
+			path := filepath.Join(t.TempDir(), "films.csv")
+			require.NoError(t, os.WriteFile(path, []byte(importCSV), 0o600))
+
+			creatorID := test.creatorID
+			if creatorID == "" {
+				creatorID = creator.UUID.String()
+			}
+
+			filmCache := cache.NewLRU(10)
+			out := &bytes.Buffer{}
+
+			err := importfilms.Films(&config, path, "", creatorID, test.dryRun, filmCache, time.Minute, out, zap.NewNop())
+			if test.expectedErr != nil {
+				require.EqualError(t, err, test.expectedErr.Error())
+				assert.Empty(t, out.String())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), `"title": "Alpha"`)
+
+			// The generation of cached films is started by imported films only
+			_, errGeneration := filmCache.Get(ctx, "films:generation")
+			if test.expectedCreated {
+				assert.NoError(t, errGeneration)
+			} else {
+				assert.ErrorIs(t, errGeneration, cache.ErrNotFound)
+			}
+		})
+	}
+}
+
+// newDatabase is a function to create a SQLite database with genre drama and a creator of films with the role.
+func newDatabase(t *testing.T, role policy.Role) (database.Config, modelsUser.User) {
+	t.Helper()
+
+	config := database.Config{
+		Driver: sqlite.Driver,
+		SQLite: sqlite.Config{Path: filepath.Join(t.TempDir(), "film_management.db")},
+	}
+	require.NoError(t, migrate.Database(&config, zap.NewNop()))
+
+	db, err := database.Connect(&config, zap.NewNop())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if sqlDB, errDB := db.DB(); errDB == nil {
+			sqlDB.Close()
+		}
+	})
+
+	creator := modelsUser.User{Username: "alice", Password: "password", Role: role}
+	require.NoError(t, db.Create(&creator).Error)
+	require.NoError(t, db.Create(&modelsFilm.Genre{Name: "drama"}).Error)
+
+	return config, creator
+}
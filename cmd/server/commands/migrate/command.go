@@ -0,0 +1,55 @@
+package migrate
+
+import (
+	"errors"
+	"film-management/pkg/database"
+	"go.uber.org/zap"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrMigrateUsage is an error of unknown migrate command or its arguments.
+var ErrMigrateUsage = errors.New("usage: migrate up | migrate down N | migrate status | migrate create NAME")
+
+// Command runs the migrate command of arguments after "migrate": up, down N, status or create NAME.
+// Migrations of create are added to dir, the other commands work with database of the storage driver.
+func Command(dc *database.Config, dir string, args []string, out io.Writer, logger *zap.Logger) error {
+	if len(args) == 0 {
+		return ErrMigrateUsage
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return ErrMigrateUsage
+		}
+
+		return Database(dc, logger)
+	case "down":
+		if len(args) != 2 {
+			return ErrMigrateUsage
+		}
+
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return ErrMigrateUsage
+		}
+
+		return Rollback(dc, n, logger)
+	case "status":
+		if len(args) != 1 {
+			return ErrMigrateUsage
+		}
+
+		return Status(dc, out, logger)
+	case "create":
+		if len(args) < 2 {
+			return ErrMigrateUsage
+		}
+
+		return Create(dir, strings.Join(args[1:], "_"), out, logger)
+	default:
+		return ErrMigrateUsage
+	}
+}
@@ -1,51 +1,177 @@
 package migrate
 
 import (
+	"context"
 	"errors"
 	modelsFilm "film-management/internal/film/domain/models"
 	"film-management/internal/user/domain/models"
-	"film-management/pkg/database/postgresql"
+	"film-management/migrations"
+	"film-management/pkg/database"
+	"film-management/pkg/database/migrator"
+	"film-management/pkg/policy"
+	filmRepo "film-management/repositories/storage/postgres/film"
+	"fmt"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"io"
+	"text/tabwriter"
 	"time"
 )
 
 var (
-	ErrMigrateFilmDatabase = errors.New("error migrate film database")
-	ErrConnectFilmDB       = errors.New("error connect to film database")
+	ErrMigrateFilmDatabase  = errors.New("error migrate film database")
+	ErrConnectFilmDB        = errors.New("error connect to film database")
+	ErrRollbackFilmDatabase = errors.New("error roll back film database")
+	ErrStatusFilmDatabase   = errors.New("error get status of film database migrations")
+	ErrCreateMigration      = errors.New("error create migration")
 )
 
-// PostgresDatabase migrate database.
-func PostgresDatabase(sc *postgresql.Config, logger *zap.Logger) error {
+// Database migrate database of the storage driver up to the last migration.
+func Database(dc *database.Config, logger *zap.Logger) error {
 	logger.Info("Run cron migrate database")
-	clientDB, errDB := postgresql.Connect(sc, logger)
+	filmMigrator, errMigrator := newMigrator(dc, logger)
 
-	if errDB != nil {
-		logger.Error("Error connect to p2p database", zap.Error(errDB))
-
-		return ErrConnectFilmDB
+	if errMigrator != nil {
+		return errMigrator
 	}
 
-	// Migrate database
-	if err := clientDB.AutoMigrate(
-		&models.User{},
-		&modelsFilm.Film{},
-		&modelsFilm.Genre{},
-		&modelsFilm.Director{},
-		&modelsFilm.Cast{}); err != nil {
+	count, err := filmMigrator.Up(context.Background())
+	if err != nil {
 		logger.Error("Error migrate p2p database", zap.Error(err))
 
 		return ErrMigrateFilmDatabase
 	}
 
-	logger.Info("Migrate p2p database success")
+	logger.Info("Migrate p2p database success", zap.Int("applied", count))
+
+	return nil
+}
+
+// Rollback rolls back the last n migrations of database of the storage driver.
+func Rollback(dc *database.Config, n int, logger *zap.Logger) error {
+	logger.Info("Run roll back database")
+	filmMigrator, errMigrator := newMigrator(dc, logger)
+
+	if errMigrator != nil {
+		return errMigrator
+	}
+
+	count, err := filmMigrator.Down(context.Background(), n)
+	if err != nil {
+		logger.Error("Error roll back p2p database", zap.Error(err))
+
+		return ErrRollbackFilmDatabase
+	}
+
+	logger.Info("Roll back p2p database success", zap.Int("rolled_back", count))
+
+	return nil
+}
+
+// Status writes states of migrations of database of the storage driver.
+func Status(dc *database.Config, out io.Writer, logger *zap.Logger) error {
+	filmMigrator, errMigrator := newMigrator(dc, logger)
+
+	if errMigrator != nil {
+		return errMigrator
+	}
+
+	statuses, err := filmMigrator.Status(context.Background())
+	if err != nil {
+		logger.Error("Error get status of p2p database migrations", zap.Error(err))
+
+		return ErrStatusFilmDatabase
+	}
+
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+
+	for _, status := range statuses {
+		state, appliedAt := "pending", ""
+
+		if status.Applied {
+			state, appliedAt = "applied", status.AppliedAt.UTC().Format(time.RFC3339)
+		}
+
+		if status.Missing {
+			state = "applied, no files"
+		}
+
+		fmt.Fprintf(writer, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+
+	return writer.Flush()
+}
+
+// Create adds files of a new migration for every storage driver to the directory of migrations.
+func Create(dir string, name string, out io.Writer, logger *zap.Logger) error {
+	files, err := migrator.Create(dir, name)
+	if err != nil {
+		logger.Error("Error create migration", zap.Error(err))
+
+		return ErrCreateMigration
+	}
+
+	for _, file := range files {
+		fmt.Fprintln(out, file)
+	}
 
 	return nil
 }
 
+// newMigrator connects to database of the storage driver and returns migrator of migrations of the driver.
+func newMigrator(dc *database.Config, logger *zap.Logger) (*migrator.Migrator, error) {
+	clientDB, errDB := database.Connect(dc, logger)
+
+	if errDB != nil {
+		logger.Error("Error connect to p2p database", zap.Error(errDB))
+
+		return nil, ErrConnectFilmDB
+	}
+
+	filmMigrations, err := migrations.Load(dc.Driver)
+	if err != nil {
+		logger.Error("Error load migrations", zap.Error(err))
+
+		return nil, ErrMigrateFilmDatabase
+	}
+
+	return migrator.NewMigrator(clientDB, filmMigrations, logger), nil
+}
+
+// backfillRevisions makes the first revision with the current state of films which have no revisions.
+func backfillRevisions(clientDB *gorm.DB) error {
+	var films []modelsFilm.Film
+
+	if err := clientDB.Unscoped().
+		Preload("Genres").
+		Preload("Director").
+		Preload("Credits", func(db *gorm.DB) *gorm.DB { return db.Order("credits.billing_order ASC") }).
+		Preload("Credits.Cast").
+		Where("NOT EXISTS (SELECT 1 FROM revisions WHERE revisions.film_id = films.uuid)").
+		Find(&films).Error; err != nil {
+		return err
+	}
+
+	if len(films) == 0 {
+		return nil
+	}
+
+	revisions := make([]*modelsFilm.Revision, 0, len(films))
+
+	for _, film := range films {
+		revision := modelsFilm.NewRevision(film, film.CreatorID, modelsFilm.RevisionActionCreate)
+		revision.Number = 1
+		revisions = append(revisions, revision)
+	}
+
+	return clientDB.Create(&revisions).Error
+}
+
 // SeedTestData seeds test data.
-func SeedTestData(sc *postgresql.Config, logger *zap.Logger) error {
+func SeedTestData(dc *database.Config, logger *zap.Logger) error {
 	logger.Info("Run cron migrate database")
-	clientDB, errDB := postgresql.Connect(sc, logger)
+	clientDB, errDB := database.Connect(dc, logger)
 
 	if errDB != nil {
 		logger.Error("Error connect to p2p database", zap.Error(errDB))
@@ -55,8 +181,8 @@ func SeedTestData(sc *postgresql.Config, logger *zap.Logger) error {
 
 	// Add users
 	users := []models.User{
-		{Username: "user1", Password: "$2a$14$ETk8B0Jndb3mrJauT3Ns1OPSAgR.RnfKqTQhLzGLoaTiFIODum7XC"},
-		{Username: "user2", Password: "$2a$14$ETk8B0Jndb3mrJauT3Ns1OPSAgR.RnfKqTQhLzGLoaTiFIODum7XC"},
+		{Username: "user1", Password: "$2a$14$ETk8B0Jndb3mrJauT3Ns1OPSAgR.RnfKqTQhLzGLoaTiFIODum7XC", Role: policy.RoleAdmin},
+		{Username: "user2", Password: "$2a$14$ETk8B0Jndb3mrJauT3Ns1OPSAgR.RnfKqTQhLzGLoaTiFIODum7XC", Role: policy.RoleEditor},
 	}
 	clientDB.Create(&users)
 
@@ -103,16 +229,41 @@ func SeedTestData(sc *postgresql.Config, logger *zap.Logger) error {
 
 	// Add films
 	films := []modelsFilm.Film{
-		{CreatorID: users[0].UUID, Title: "Film 1", Director: directors[0], Genres: []modelsFilm.Genre{genres[0], genres[1]}, ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Casts: []modelsFilm.Cast{casts[0], casts[1]}, Synopsis: "Synopsis A"},
-		{CreatorID: users[1].UUID, Title: "Film 2", Director: directors[1], Genres: []modelsFilm.Genre{genres[1], genres[2]}, ReleaseDate: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), Casts: []modelsFilm.Cast{casts[1], casts[2]}, Synopsis: "Synopsis B"},
-		{CreatorID: users[0].UUID, Title: "Film 3", Director: directors[2], Genres: []modelsFilm.Genre{genres[3], genres[4]}, ReleaseDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Casts: []modelsFilm.Cast{casts[2], casts[3]}, Synopsis: "Synopsis C"},
-		{CreatorID: users[1].UUID, Title: "Film 4", Director: directors[3], Genres: []modelsFilm.Genre{genres[5], genres[6]}, ReleaseDate: time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC), Casts: []modelsFilm.Cast{casts[3], casts[4]}, Synopsis: "Synopsis D"},
-		{CreatorID: users[0].UUID, Title: "Film 5", Director: directors[4], Genres: []modelsFilm.Genre{genres[7], genres[8]}, ReleaseDate: time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC), Casts: []modelsFilm.Cast{casts[5], casts[1]}, Synopsis: "Synopsis E"},
-		{CreatorID: users[1].UUID, Title: "Film 6", Director: directors[5], Genres: []modelsFilm.Genre{genres[9], genres[10]}, ReleaseDate: time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC), Casts: []modelsFilm.Cast{casts[2], casts[4]}, Synopsis: "Synopsis F"},
+		{CreatorID: users[0].UUID, Title: "Film 1", Director: directors[0], Genres: []modelsFilm.Genre{genres[0], genres[1]}, ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Credits: castCredits(casts[0], casts[1]), Synopsis: "Synopsis A"},
+		{CreatorID: users[1].UUID, Title: "Film 2", Director: directors[1], Genres: []modelsFilm.Genre{genres[1], genres[2]}, ReleaseDate: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC), Credits: castCredits(casts[1], casts[2]), Synopsis: "Synopsis B"},
+		{CreatorID: users[0].UUID, Title: "Film 3", Director: directors[2], Genres: []modelsFilm.Genre{genres[3], genres[4]}, ReleaseDate: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Credits: castCredits(casts[2], casts[3]), Synopsis: "Synopsis C"},
+		{CreatorID: users[1].UUID, Title: "Film 4", Director: directors[3], Genres: []modelsFilm.Genre{genres[5], genres[6]}, ReleaseDate: time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC), Credits: castCredits(casts[3], casts[4]), Synopsis: "Synopsis D"},
+		{CreatorID: users[0].UUID, Title: "Film 5", Director: directors[4], Genres: []modelsFilm.Genre{genres[7], genres[8]}, ReleaseDate: time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC), Credits: castCredits(casts[5], casts[1]), Synopsis: "Synopsis E"},
+		{CreatorID: users[1].UUID, Title: "Film 6", Director: directors[5], Genres: []modelsFilm.Genre{genres[9], genres[10]}, ReleaseDate: time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC), Credits: castCredits(casts[2], casts[4]), Synopsis: "Synopsis F"},
 	}
 	clientDB.Create(&films)
 
+	// Build the search vector of seeded films
+	if err := filmRepo.RefreshSearchVector(clientDB, "films.search_vector IS NULL"); err != nil {
+		logger.Error("Error build film search vector", zap.Error(err))
+	}
+
+	// Make the first revision of seeded films
+	if err := backfillRevisions(clientDB); err != nil {
+		logger.Error("Error make film revisions", zap.Error(err))
+	}
+
 	logger.Info("Test data seeded successfully")
 
 	return nil
 }
+
+// castCredits returns credits of actors in billing order.
+func castCredits(casts ...modelsFilm.Cast) []modelsFilm.Credit {
+	credits := make([]modelsFilm.Credit, 0, len(casts))
+
+	for i, cast := range casts {
+		credits = append(credits, modelsFilm.Credit{
+			Cast:         cast,
+			Department:   modelsFilm.DepartmentCast,
+			BillingOrder: i + 1,
+		})
+	}
+
+	return credits
+}
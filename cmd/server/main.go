@@ -2,22 +2,49 @@ package main
 
 import (
 	"context"
+	"film-management/cmd/server/commands/importfilms"
 	"film-management/cmd/server/commands/migrate"
 	"film-management/config"
 	httpCommonHandler "film-management/internal/common/transport/http"
+	domainDirector "film-management/internal/director/domain"
+	directorEndpoint "film-management/internal/director/endpoints"
+	httpDirectorHandler "film-management/internal/director/transport/http"
 	domainFilm "film-management/internal/film/domain"
 	filmEndpoint "film-management/internal/film/endpoints"
+	grpcFilmHandler "film-management/internal/film/transport/grpc"
+	pbFilm "film-management/internal/film/transport/grpc/pb"
 	httpFilmHandler "film-management/internal/film/transport/http"
+	graphqlEndpoint "film-management/internal/graphql/endpoints"
+	graphqlResolvers "film-management/internal/graphql/resolvers"
+	httpGraphQLHandler "film-management/internal/graphql/transport/http"
+	domainStats "film-management/internal/stats/domain"
+	statsEndpoint "film-management/internal/stats/endpoints"
+	httpStatsHandler "film-management/internal/stats/transport/http"
 	domainUser "film-management/internal/user/domain"
 	userEndpoint "film-management/internal/user/endpoints"
+	grpcUserHandler "film-management/internal/user/transport/grpc"
+	pbUser "film-management/internal/user/transport/grpc/pb"
 	httpUserHandler "film-management/internal/user/transport/http"
+	domainWatchlist "film-management/internal/watchlist/domain"
+	watchlistEndpoint "film-management/internal/watchlist/endpoints"
+	httpWatchlistHandler "film-management/internal/watchlist/transport/http"
 	"film-management/pkg/auth"
+	"film-management/pkg/cache"
+	"film-management/pkg/database"
 	"film-management/pkg/database/postgresql"
+	"film-management/pkg/database/sqlite"
 	"film-management/pkg/logger"
 	"film-management/pkg/password"
+	"film-management/pkg/policy"
+	grpcAuth "film-management/pkg/transport/grpc/middlewares/auth"
+	grpcRecovery "film-management/pkg/transport/grpc/middlewares/recovery"
 	"film-management/pkg/transport/http/response"
+	"film-management/repositories/storage/memory"
+	directorRepo "film-management/repositories/storage/postgres/director"
 	filmRepo "film-management/repositories/storage/postgres/film"
+	statsRepo "film-management/repositories/storage/postgres/stats"
 	userRepo "film-management/repositories/storage/postgres/user"
+	watchlistRepo "film-management/repositories/storage/postgres/watchlist"
 	"flag"
 	"fmt"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
@@ -25,6 +52,7 @@ import (
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"net"
 	"net/http"
 	"os"
@@ -33,12 +61,23 @@ import (
 	"time"
 )
 
+// userDenylistRepository is a repository of users which is also a denylist of revoked access tokens.
+type userDenylistRepository interface {
+	domainUser.UserRepository
+	auth.Denylist
+}
+
 func main() {
 	// Init flags
 	var (
 		configPath              = flag.String("config-path", "./config", "Path to config file")
-		migratePostgresDatabase = flag.Bool("migrate-postgres-database", false, "Migrate postgres database")
-		seedTestData            = flag.Bool("seed-postgres-database", false, "Seed test data")
+		migratePostgresDatabase = flag.Bool("migrate-postgres-database", false, "Migrate database of the storage driver (postgres or sqlite), the same as migrate up")
+		seedTestData            = flag.Bool("seed-postgres-database", false, "Seed test data into database of the storage driver (postgres or sqlite)")
+		importFilmsPath         = flag.String("import-films", "", "Path to CSV or JSONL file of films to import")
+		importFilmsFormat       = flag.String("import-format", "", "Format of the import file (csv or jsonl), by default from the file extension")
+		importFilmsCreator      = flag.String("import-creator", "", "UUID of the user who is the creator of imported films")
+		importFilmsDryRun       = flag.Bool("import-dry-run", false, "Validate the import file without saving films")
+		migrationsPath          = flag.String("migrations-path", "./migrations", "Path to migrations where migrate create adds files")
 	)
 
 	// Parse flags
@@ -50,11 +89,20 @@ func main() {
 	// Init logger
 	log := logger.GetZapLogger(&cfg.Log)
 
+	// Run migrate command: migrate up, migrate down N, migrate status or migrate create NAME
+	if flag.Arg(0) == "migrate" {
+		if err := migrate.Command(&cfg.Storage.Config, *migrationsPath, flag.Args()[1:], os.Stdout, log); err != nil {
+			log.Fatal("Failed to run migrate command", zap.Error(err))
+		}
+
+		return
+	}
+
 	// Migrate database
 	if *migratePostgresDatabase {
-		err := migrate.PostgresDatabase(&cfg.Storage.Postgres, log)
+		err := migrate.Database(&cfg.Storage.Config, log)
 		if err != nil {
-			log.Error("Failed to migrate Postgres database", zap.Error(err))
+			log.Error("Failed to migrate database", zap.Error(err))
 		}
 
 		return
@@ -62,7 +110,7 @@ func main() {
 
 	// Seed test data database
 	if *seedTestData {
-		err := migrate.SeedTestData(&cfg.Storage.Postgres, log)
+		err := migrate.SeedTestData(&cfg.Storage.Config, log)
 		if err != nil {
 			log.Error("Failed to seed test data", zap.Error(err))
 		}
@@ -70,10 +118,28 @@ func main() {
 		return
 	}
 
-	// Init postgres client
-	postgresClientDB, errClientDB := postgresql.Connect(&cfg.Storage.Postgres, log)
-	if errClientDB != nil {
-		log.Error("Failed to connect to postgres database", zap.Error(errClientDB))
+	// Init cache of films
+	var filmCache cache.Cache
+	switch backend := cfg.Services.Film.Cache.Backend; backend {
+	case cache.BackendLRU:
+		filmCache = cache.NewLRU(cfg.Services.Film.Cache.Size)
+	case cache.BackendRedis:
+		filmCache = cache.NewRedis(cfg.Storage.Redis)
+	case cache.BackendNone:
+	default:
+		log.Error("Unknown cache backend of films", zap.String("backend", backend))
+	}
+
+	// Import films, imported films invalidate the cache of films shared with servers
+	if *importFilmsPath != "" {
+		cacheTTL := time.Duration(cfg.Services.Film.Cache.TTLSec) * time.Second
+
+		err := importfilms.Films(&cfg.Storage.Config, *importFilmsPath, *importFilmsFormat, *importFilmsCreator, *importFilmsDryRun, filmCache, cacheTTL, os.Stdout, log)
+		if err != nil {
+			log.Error("Failed to import films", zap.Error(err))
+		}
+
+		return
 	}
 
 	// Init opts for services and repositories
@@ -82,18 +148,77 @@ func main() {
 		optsForUser []domainUser.OptFunc
 		// Init opts for film service
 		optsForFilm []domainFilm.OptFunc
+		// Init opts for watchlist service
+		optsForWatchlist []domainWatchlist.OptFunc
+		// Init opts for director service
+		optsForDirector []domainDirector.OptFunc
+		// Init opts for stats service
+		optsForStats []domainStats.OptFunc
 	)
 
+	// Set default role for new users
+	if defaultRole := policy.Role(cfg.Services.User.DefaultRole); defaultRole.IsValid() {
+		optsForUser = append(optsForUser, domainUser.WithDefaultRole(defaultRole))
+	} else {
+		log.Error("Unknown default role for new users", zap.String("role", cfg.Services.User.DefaultRole))
+	}
+
+	// Set how long deleted films are kept in trash
+	if trashRetention := cfg.Services.Film.TrashRetentionHours; trashRetention > 0 {
+		optsForFilm = append(optsForFilm, domainFilm.WithTrashRetention(time.Duration(trashRetention)*time.Hour))
+	}
+
+	// Require ETag of a film in If-Match to update or delete it
+	optsForFilm = append(optsForFilm, domainFilm.WithVersionRequired(cfg.Services.Film.RequireIfMatch))
+
+	// Set how long statistics are cached
+	optsForStats = append(optsForStats, domainStats.WithCacheTTL(time.Duration(cfg.Services.Stats.CacheTTLSec)*time.Second))
+
 	// Init Repositories
 	var (
 		// User repository
-		userRepository = userRepo.NewUserRepository(postgresClientDB, log)
+		userRepository userDenylistRepository
 		// Film repository
-		filmRepository = filmRepo.NewFilmRepository(postgresClientDB, log)
+		filmRepository domainFilm.Repository
+		// Watchlist repository
+		watchlistRepository domainWatchlist.Repository
+		// Director repository
+		directorRepository domainDirector.Repository
+		// Stats repository
+		statsRepository domainStats.Repository
+	)
+
+	switch driver := cfg.Storage.Driver; driver {
+	case postgresql.Driver, sqlite.Driver:
+		// Init database client, SQL repositories work with both databases
+		clientDB, errClientDB := database.Connect(&cfg.Storage.Config, log)
+		if errClientDB != nil {
+			log.Error("Failed to connect to database", zap.String("driver", driver), zap.Error(errClientDB))
+		}
+
+		userRepository = userRepo.NewUserRepository(clientDB, log)
+		filmRepository = filmRepo.NewFilmRepository(clientDB, log)
+		watchlistRepository = watchlistRepo.NewWatchlistRepository(clientDB, log)
+		directorRepository = directorRepo.NewDirectorRepository(clientDB, log)
+		statsRepository = statsRepo.NewStatsRepository(clientDB, log)
+	case memory.Driver:
+		// All repositories share one store, data is lost on restart
+		store := memory.NewStore()
+
+		userRepository = memory.NewUserRepository(store, log)
+		filmRepository = memory.NewFilmRepository(store, log)
+		watchlistRepository = memory.NewWatchlistRepository(store, log)
+		directorRepository = memory.NewDirectorRepository(store, log)
+		statsRepository = memory.NewStatsRepository(store, log)
+	default:
+		log.Fatal("Unknown storage driver", zap.String("driver", driver))
+	}
+
+	var (
 		// Password service
 		passwordService = password.NewPasswordService(log)
 		// Auth service
-		authService = auth.NewAuthService(cfg.Services.Auth, log)
+		authService = auth.NewAuthService(cfg.Services.Auth, log, auth.WithDenylist(userRepository))
 	)
 
 	// Init services
@@ -132,6 +257,10 @@ func main() {
 	var filmService domainFilm.Service
 	{
 		filmService = domainFilm.NewService(filmRepository, optsForFilm...)
+		// Cache films before logging and metrics, so cached requests are logged and measured too
+		if filmCache != nil && cfg.Services.Film.Cache.TTLSec > 0 {
+			filmService = domainFilm.NewCachingMiddleware(filmCache, time.Duration(cfg.Services.Film.Cache.TTLSec)*time.Second, log)(filmService)
+		}
 		filmService = domainFilm.NewLoggingMiddleware(log)(filmService)
 		// Init metrics middleware
 		fieldKeys := []string{"method", "error"}
@@ -158,12 +287,114 @@ func main() {
 		)(filmService)
 	}
 
+	// Watchlist service
+	var watchlistService domainWatchlist.Service
+	{
+		watchlistService = domainWatchlist.NewService(watchlistRepository, optsForWatchlist...)
+		watchlistService = domainWatchlist.NewLoggingMiddleware(log)(watchlistService)
+		// Init metrics middleware
+		fieldKeys := []string{"method", "error"}
+		watchlistService = domainWatchlist.NewInstrumentingMiddleware(
+			kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+				Namespace: "domain",
+				Subsystem: fmt.Sprintf("%s_%s", cfg.Name, "watchlist"),
+				Name:      "request_count",
+				Help:      "Number of requests received.",
+			}, fieldKeys),
+			kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+				Namespace: "domain",
+				Subsystem: fmt.Sprintf("%s_%s", cfg.Name, "watchlist"),
+				Name:      "request_duration_seconds",
+				Help:      "Total duration of requests in seconds.",
+				Buckets: []float64{
+					0.1,  // 100 ms
+					0.2,  // 200 ms
+					0.25, // 250 ms
+					0.5,  // 500 ms
+					1,    // 1 s
+				},
+			}, fieldKeys),
+		)(watchlistService)
+	}
+
+	// Director service
+	var directorService domainDirector.Service
+	{
+		directorService = domainDirector.NewService(directorRepository, optsForDirector...)
+		// Films embed their directors, so changes of directors invalidate cached films
+		if filmCache != nil && cfg.Services.Film.Cache.TTLSec > 0 {
+			directorService = domainDirector.NewCachingMiddleware(filmCache, log)(directorService)
+		}
+		directorService = domainDirector.NewLoggingMiddleware(log)(directorService)
+		// Init metrics middleware
+		fieldKeys := []string{"method", "error"}
+		directorService = domainDirector.NewInstrumentingMiddleware(
+			kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+				Namespace: "domain",
+				Subsystem: fmt.Sprintf("%s_%s", cfg.Name, "director"),
+				Name:      "request_count",
+				Help:      "Number of requests received.",
+			}, fieldKeys),
+			kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+				Namespace: "domain",
+				Subsystem: fmt.Sprintf("%s_%s", cfg.Name, "director"),
+				Name:      "request_duration_seconds",
+				Help:      "Total duration of requests in seconds.",
+				Buckets: []float64{
+					0.1,  // 100 ms
+					0.2,  // 200 ms
+					0.25, // 250 ms
+					0.5,  // 500 ms
+					1,    // 1 s
+				},
+			}, fieldKeys),
+		)(directorService)
+	}
+
+	// Stats service
+	var statsService domainStats.Service
+	{
+		statsService = domainStats.NewService(statsRepository, optsForStats...)
+		statsService = domainStats.NewLoggingMiddleware(log)(statsService)
+		// Init metrics middleware
+		fieldKeys := []string{"method", "error"}
+		statsService = domainStats.NewInstrumentingMiddleware(
+			kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+				Namespace: "domain",
+				Subsystem: fmt.Sprintf("%s_%s", cfg.Name, "stats"),
+				Name:      "request_count",
+				Help:      "Number of requests received.",
+			}, fieldKeys),
+			kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+				Namespace: "domain",
+				Subsystem: fmt.Sprintf("%s_%s", cfg.Name, "stats"),
+				Name:      "request_duration_seconds",
+				Help:      "Total duration of requests in seconds.",
+				Buckets: []float64{
+					0.1,  // 100 ms
+					0.2,  // 200 ms
+					0.25, // 250 ms
+					0.5,  // 500 ms
+					1,    // 1 s
+				},
+			}, fieldKeys),
+		)(statsService)
+	}
+
 	// Init endpoints
 	var (
 		// User endpoints
 		userEndpoints = userEndpoint.NewEndpoints(userService, log)
 		// Film endpoints
 		filmEndpoints = filmEndpoint.NewEndpoints(filmService, log)
+		// Watchlist endpoints
+		watchlistEndpoints = watchlistEndpoint.NewEndpoints(watchlistService, log)
+		// Director endpoints
+		directorEndpoints = directorEndpoint.NewEndpoints(directorService, log)
+		// Stats endpoints
+		statsEndpoints = statsEndpoint.NewEndpoints(statsService, log)
+		// GraphQL endpoints, films are read from the film service and changed by film endpoints
+		graphqlEndpoints = graphqlEndpoint.NewEndpoints(graphqlResolvers.NewSchema(filmService, filmEndpoints, directorEndpoints, log), log)
 	)
 
 	// Init http handlers
@@ -173,13 +404,36 @@ func main() {
 		// Common handlers
 		httpHandlers.Handle(httpCommonHandler.APIPath, httpCommonHandler.NewHTTPHandlers(cfg, log))
 		// User handlers
-		httpHandlers.Handle(httpUserHandler.APIPath, httpUserHandler.NewHTTPHandlers(userEndpoints, cfg, log))
-		// Film handlers
-		httpHandlers.Handle(httpFilmHandler.APIPath, httpFilmHandler.NewHTTPHandlers(filmEndpoints, authService, cfg, log))
+		httpHandlers.Handle(httpUserHandler.APIPath, httpUserHandler.NewHTTPHandlers(userEndpoints, authService, cfg, log))
+		// Film and genre handlers
+		filmHandlers := httpFilmHandler.NewHTTPHandlers(filmEndpoints, authService, cfg, log)
+		httpHandlers.Handle(httpFilmHandler.APIPath, filmHandlers)
+		httpHandlers.Handle(httpFilmHandler.GenresAPIPath, filmHandlers)
+		// Watchlist handlers
+		httpHandlers.Handle(httpWatchlistHandler.APIPath, httpWatchlistHandler.NewHTTPHandlers(watchlistEndpoints, authService, cfg, log))
+		// Director handlers
+		httpHandlers.Handle(httpDirectorHandler.APIPath, httpDirectorHandler.NewHTTPHandlers(directorEndpoints, authService, cfg, log))
+		// Stats handlers
+		httpHandlers.Handle(httpStatsHandler.APIPath, httpStatsHandler.NewHTTPHandlers(statsEndpoints, authService, cfg, log))
+		// GraphQL handlers
+		httpHandlers.Handle(httpGraphQLHandler.APIPath, httpGraphQLHandler.NewHTTPHandlers(graphqlEndpoints, authService, cfg, log))
 		// Base 404 handler
 		httpHandlers.HandleFunc("/", response.NotFoundFunc)
 	}
 
+	// Init gRPC server
+	var grpcServer *grpc.Server
+	{
+		grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(
+			grpcRecovery.UnaryServerInterceptor(log),
+			grpcAuth.UnaryServerInterceptor(cfg.GRPC.NotAuthMethods, authService, log),
+		))
+		// User server
+		pbUser.RegisterUserServiceServer(grpcServer, grpcUserHandler.NewGRPCServer(userEndpoints, log))
+		// Film server
+		pbFilm.RegisterFilmServiceServer(grpcServer, grpcFilmHandler.NewGRPCServer(filmEndpoints, log))
+	}
+
 	// Init metrics handler
 	http.DefaultServeMux.Handle("/metrics", promhttp.Handler())
 
@@ -232,6 +486,46 @@ func main() {
 			}
 		})
 	}
+	{
+		// Init gRPC server
+		grpcListener, errGRPCListener := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
+		if errGRPCListener != nil {
+			log.Error("Exiting due to gRPC listener error", zap.Error(errGRPCListener))
+		}
+
+		g.Add(func() error {
+			log.Info("transport gRPC", zap.String("port", fmt.Sprintf(":%d", cfg.GRPC.Port)))
+
+			return grpcServer.Serve(grpcListener)
+		}, func(error) {
+			grpcServer.GracefulStop()
+		})
+	}
+	if purgeInterval := cfg.Services.Film.TrashPurgeIntervalMin; purgeInterval > 0 {
+		// Purge films which are in trash longer than the retention period
+		ticker := time.NewTicker(time.Duration(purgeInterval) * time.Minute)
+		cancelPurge := make(chan struct{})
+		g.Add(func() error {
+			for {
+				select {
+				case <-ticker.C:
+					count, err := filmService.PurgeTrash(context.Background())
+					if err != nil {
+						log.Error("Failed to purge trash of films", zap.Error(err))
+
+						continue
+					}
+
+					log.Info("Trash of films purged", zap.Int64("count", count))
+				case <-cancelPurge:
+					return nil
+				}
+			}
+		}, func(error) {
+			ticker.Stop()
+			close(cancelPurge)
+		})
+	}
 	{
 		cancelInterrupt := make(chan struct{})
 		g.Add(func() error {
@@ -2,6 +2,8 @@ package config
 
 import (
 	"film-management/pkg/auth"
+	"film-management/pkg/cache"
+	"film-management/pkg/database"
 	"film-management/pkg/database/postgresql"
 	"film-management/pkg/logger"
 	"github.com/spf13/viper"
@@ -29,12 +31,33 @@ type Config struct {
 		ReadHeaderTimeout time.Duration
 		WriteTimeout      time.Duration
 	}
+	GRPC struct {
+		Port           int
+		NotAuthMethods []string
+	}
 	Log     logger.Config
 	Storage struct {
-		Postgres postgresql.Config
+		database.Config `mapstructure:",squash"`
+		Redis           cache.RedisConfig
 	}
 	Services struct {
 		Auth auth.Config
+		User struct {
+			DefaultRole string
+		}
+		Film struct {
+			TrashRetentionHours   int
+			TrashPurgeIntervalMin int
+			RequireIfMatch        bool
+			Cache                 struct {
+				Backend string
+				Size    int
+				TTLSec  int
+			}
+		}
+		Stats struct {
+			CacheTTLSec int
+		}
 	}
 }
 
@@ -81,23 +104,48 @@ func setDefaults(v *viper.Viper) {
 		"/api/v1/swagger",
 		"/api/v1/user/register",
 		"/api/v1/user/login",
+		"/api/v1/user/refresh",
 	})
 	// Debug Http
 	v.SetDefault("debugHttp.port", 8081)
 	v.SetDefault("debugHttp.readTimeout", 5)
 	v.SetDefault("debugHttp.readHeaderTimeout", 3)
 	v.SetDefault("debugHttp.writeTimeout", 10)
+	// gRPC
+	v.SetDefault("grpc.port", 8082)
+	v.SetDefault("grpc.notAuthMethods", []string{
+		"/user.v1.UserService/Register",
+		"/user.v1.UserService/Login",
+		"/user.v1.UserService/Refresh",
+	})
 	// Services
-	// User
+	// Auth
 	v.SetDefault("services.auth.authDurationMin", 60)
+	v.SetDefault("services.auth.refreshDurationMin", 43200)
 	v.SetDefault("services.auth.pathPublicKeyFile", "config/ssl/jwtRS256.key.pub")
 	v.SetDefault("services.auth.pathPrivateKeyFile", "config/ssl/jwtRS256.key")
+	// User
+	v.SetDefault("services.user.defaultRole", "editor")
+	// Film
+	v.SetDefault("services.film.trashRetentionHours", 720)
+	v.SetDefault("services.film.trashPurgeIntervalMin", 60)
+	v.SetDefault("services.film.requireIfMatch", false)
+	v.SetDefault("services.film.cache.backend", "lru")
+	v.SetDefault("services.film.cache.size", 1000)
+	v.SetDefault("services.film.cache.ttlSec", 60)
+	// Stats
+	v.SetDefault("services.stats.cacheTTLSec", 300)
 	// Storage
+	v.SetDefault("storage.driver", postgresql.Driver)
 	v.SetDefault("storage.postgres.host", "db_film_management")
 	v.SetDefault("storage.postgres.port", 5432)
 	v.SetDefault("storage.postgres.user", "film")
 	v.SetDefault("storage.postgres.password", "film")
 	v.SetDefault("storage.postgres.database", "db")
+	v.SetDefault("storage.sqlite.path", "film_management.db")
+	v.SetDefault("storage.redis.addr", "redis_film_management:6379")
+	v.SetDefault("storage.redis.db", 0)
+	v.SetDefault("storage.redis.poolSize", 10)
 	// Log
 	v.SetDefault("log.json", false)
 	v.SetDefault("log.level", "debug")
@@ -16,14 +16,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/films": {
+        "/directors/": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "View all films",
+                "description": "View all directors with count of their films",
                 "consumes": [
                     "application/json"
                 ],
@@ -31,34 +31,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Film"
+                    "Directors"
                 ],
-                "summary": "View all films",
+                "summary": "View all directors",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "Star Wars",
-                        "description": "title",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "2023-12-11 or 2023-10-11:2023-12-11",
-                        "description": "date",
-                        "name": "release_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "action,adventure",
-                        "description": "genres",
-                        "name": "genres",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "title.asc or title.desc or release_date.asc or release_date.desc",
+                        "example": "name.asc or films_count.desc",
                         "description": "sort",
                         "name": "sort",
                         "in": "query"
@@ -76,6 +55,13 @@ const docTemplate = `{
                         "description": "offset",
                         "name": "offset",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Nolan",
+                        "description": "search by part of name",
+                        "name": "name",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -90,7 +76,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.ViewAllFilmsResponse"
+                                            "$ref": "#/definitions/endpoints.ViewAllDirectorsResponse"
                                         }
                                     }
                                 }
@@ -124,14 +110,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/films/": {
-            "post": {
+        "/directors/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a film",
+                "description": "View a director with filmography, newest films first",
                 "consumes": [
                     "application/json"
                 ],
@@ -139,18 +125,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Film"
+                    "Directors"
                 ],
-                "summary": "Add a film",
+                "summary": "View a director",
                 "parameters": [
                     {
-                        "description": "Add Film Form",
-                        "name": "form",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/endpoints.AddFilmRequest"
-                        }
+                        "type": "string",
+                        "description": "Director ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -165,7 +149,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.AddFilmResponse"
+                                            "$ref": "#/definitions/endpoints.ViewDirectorResponse"
                                         }
                                     }
                                 }
@@ -184,6 +168,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "422": {
                         "description": "Data Validation Failed",
                         "schema": {
@@ -197,16 +187,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/films/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "View a film",
+                "description": "Rename a director. Only admins can rename directors. If another director already has the name, merge directors instead.",
                 "consumes": [
                     "application/json"
                 ],
@@ -214,16 +202,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Film"
+                    "Directors"
                 ],
-                "summary": "View a film",
+                "summary": "Rename a director",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Film UUID",
+                        "description": "Director ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update director form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.UpdateDirectorRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -238,7 +235,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.ViewFilmResponse"
+                                            "$ref": "#/definitions/endpoints.UpdateDirectorResponse"
                                         }
                                     }
                                 }
@@ -257,12 +254,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -270,14 +279,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/directors/{id}/merge": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a film",
+                "description": "Merge duplicate directors into the director from path. Films of the merged directors are moved to this director and the merged directors are deleted. Only admins can merge directors.",
                 "consumes": [
                     "application/json"
                 ],
@@ -285,24 +296,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Film"
+                    "Directors"
                 ],
-                "summary": "Update a film",
+                "summary": "Merge directors",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Film UUID",
+                        "description": "Director ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update film form",
+                        "description": "Merge directors form",
                         "name": "form",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoints.UpdateFilmRequest"
+                            "$ref": "#/definitions/endpoints.MergeDirectorsRequest"
                         }
                     }
                 ],
@@ -318,7 +329,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.UpdateFilmResponse"
+                                            "$ref": "#/definitions/endpoints.MergeDirectorsResponse"
                                         }
                                     }
                                 }
@@ -362,14 +373,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/films": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a film",
+                "description": "View all films",
                 "consumes": [
                     "application/json"
                 ],
@@ -379,14 +392,111 @@ const docTemplate = `{
                 "tags": [
                     "Film"
                 ],
-                "summary": "Delete a film",
+                "summary": "View all films",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Film UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "example": "star wars",
+                        "description": "full-text search over title, synopsis, director and cast",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Star Wars",
+                        "description": "title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023-12-11 or 2023-10-11:2023-12-11",
+                        "description": "date",
+                        "name": "release_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "action,adventure",
+                        "description": "genres",
+                        "name": "genres",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "any or all or none",
+                        "description": "films have any, all or none of genres, any by default",
+                        "name": "genres_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023",
+                        "description": "release year",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Nolan",
+                        "description": "part of director name",
+                        "name": "director",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Hanks",
+                        "description": "part of actor name",
+                        "name": "cast",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "me",
+                        "description": "creator UUID, me is the current user",
+                        "name": "creator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "title.asc or title.desc or release_date.asc or release_date.desc or rating.asc or rating.desc or relevance.desc",
+                        "description": "sort, relevance is available with q only",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page, replaces offset",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "true or false",
+                        "description": "count films, true by default without cursor",
+                        "name": "with_count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "genres,decade,director",
+                        "description": "count films with the filters by genre, release decade and director",
+                        "name": "facets",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -401,7 +511,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.DeleteFilmResponse"
+                                            "$ref": "#/definitions/endpoints.ViewAllFilmsResponse"
                                         }
                                     }
                                 }
@@ -420,18 +530,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "422": {
                         "description": "Data Validation Failed",
                         "schema": {
@@ -447,32 +545,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
-            "get": {
-                "description": "Health Check",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Common"
-                ],
-                "summary": "Health Check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/http.HealthCheckResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/user/login": {
+        "/films/": {
             "post": {
-                "description": "Login",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a film. Viewers can not add films.",
                 "consumes": [
                     "application/json"
                 ],
@@ -480,17 +560,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Film"
                 ],
-                "summary": "Login",
+                "summary": "Add a film",
                 "parameters": [
                     {
-                        "description": "Login form",
+                        "description": "Add Film Form",
                         "name": "form",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoints.LoginRequest"
+                            "$ref": "#/definitions/endpoints.AddFilmRequest"
                         }
                     }
                 ],
@@ -506,7 +586,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.LoginResponse"
+                                            "$ref": "#/definitions/endpoints.AddFilmResponse"
                                         }
                                     }
                                 }
@@ -519,6 +599,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "422": {
                         "description": "Data Validation Failed",
                         "schema": {
@@ -534,27 +626,189 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/register": {
-            "post": {
-                "description": "Registration",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/films/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Export all films with the same filters and sort as the list of films. The films are streamed as CSV, JSON Lines or XLSX, the format is selected by the format parameter or by the Accept header, CSV is by default. Genres and casts are separated by \"|\" in CSV and XLSX.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
                 "tags": [
-                    "User"
+                    "Film"
                 ],
-                "summary": "Registration",
+                "summary": "Export films",
                 "parameters": [
                     {
-                        "description": "Register form",
-                        "name": "form",
+                        "type": "string",
+                        "example": "csv or jsonl or xlsx",
+                        "description": "format, replaces the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "star wars",
+                        "description": "full-text search over title, synopsis, director and cast",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Star Wars",
+                        "description": "title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023-12-11 or 2023-10-11:2023-12-11",
+                        "description": "date",
+                        "name": "release_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "action,adventure",
+                        "description": "genres",
+                        "name": "genres",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "any or all or none",
+                        "description": "films have any, all or none of genres, any by default",
+                        "name": "genres_mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023",
+                        "description": "release year",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Nolan",
+                        "description": "part of director name",
+                        "name": "director",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Hanks",
+                        "description": "part of actor name",
+                        "name": "cast",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "me",
+                        "description": "creator UUID, me is the current user",
+                        "name": "creator_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "title.asc or title.desc or release_date.asc or release_date.desc or rating.asc or rating.desc or relevance.desc",
+                        "description": "sort, relevance is available with q only",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Films",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Import films from CSV with a header of columns title, director, genres, release_date, casts and synopsis, where genres and casts are separated by \"|\", or from JSON Lines with a film per line in the form of adding a film. Every row is validated like adding a film, rows with a title of an existing film are skipped. With dry_run nothing is saved. Viewers can not import films.",
+                "consumes": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Import films",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "jsonl"
+                        ],
+                        "type": "string",
+                        "description": "Format of the file, by default it is got from Content-Type",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "Validate rows without saving films",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or JSON Lines file",
+                        "name": "file",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoints.RegisterRequest"
+                            "type": "string"
                         }
                     }
                 ],
@@ -570,7 +824,112 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/endpoints.RegisterResponse"
+                                            "$ref": "#/definitions/endpoints.ImportFilmsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/trash": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View own deleted films, they are purged after the retention period",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "View trash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "deleted_at.desc or title.asc",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewTrashResponse"
                                         }
                                     }
                                 }
@@ -583,6 +942,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "422": {
                         "description": "Data Validation Failed",
                         "schema": {
@@ -597,24 +962,3042 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "endpoints.AddFilmRequest": {
+        },
+        "/films/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View a film",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "View a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the film"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a film. Editors can update only own films, admins can update any film. Pass ETag of the film in If-Match to not overwrite changes made by others.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Update a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the film from view, * changes any version",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update film form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.UpdateFilmRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.UpdateFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the film"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a film to trash. Editors can delete only own films, admins can delete any film. The film can be restored until it is purged after the retention period.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Delete a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the film from view, * changes any version",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.DeleteFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update only the passed fields of a film with JSON Merge Patch or JSON Patch of the form fields, only the changed fields are validated. Plain JSON is a merge patch. Editors can update only own films, admins can update any film.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Patch a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the film from view, * changes any version",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Merge patch with the changed fields, or JSON Patch operations on them",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.FilmPatchForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.PatchFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the film"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/rating": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rate a film from 1 to 10. A repeated rating replaces the previous one of the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Rate a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rate film form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.RateFilmRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.RateFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore a deleted film from trash. Editors can restore only own films, admins can restore any film.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Restore a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.RestoreFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View all reviews of a film. Hidden reviews are visible only to the film creator and admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "View all reviews of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "created_at.asc or created_at.desc or updated_at.asc or updated_at.desc",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewAllReviewsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a review of a film",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Add a review of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add review form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.AddReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.AddReviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/reviews/{review_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update own review of a film",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Update own review of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review UUID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update review form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.UpdateReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.UpdateReviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete own review of a film. Admins can delete any review.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Delete own review of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review UUID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.DeleteReviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/reviews/{review_id}/visibility": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Hide or show a review of a film. Only the film creator or an admin can moderate reviews.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Hide or show a review of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Review UUID",
+                        "name": "review_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hide review form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.HideReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.HideReviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View the history of a film, every add, update, delete, restore and rollback makes a revision with the state of the film after it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "View all revisions of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "number.desc",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewAllRevisionsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/revisions/diff": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View fields of a film changed between two revisions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Diff two revisions of a film",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "from revision number",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 3,
+                        "description": "to revision number",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.DiffRevisionsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/films/{id}/revisions/{number}/rollback": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore fields of a film from a past revision, it makes a new revision. Only admins can rollback films.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Film"
+                ],
+                "summary": "Rollback a film to a revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Revision number",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.RollbackFilmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View all genres with count of their films. Only admins can manage genres.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "View all genres",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "name.asc or films_count.desc",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewAllGenresResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a genre. Only admins can manage genres.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Add a genre",
+                "parameters": [
+                    {
+                        "description": "Add genre form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.AddGenreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.AddGenreResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rename a genre. Only admins can manage genres.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Rename a genre",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Genre ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update genre form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.UpdateGenreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.UpdateGenreResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a genre. Only admins can manage genres. A genre used by films is deleted only with cascade=true, it is removed from the films then.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genre"
+                ],
+                "summary": "Delete a genre",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Genre ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "true or false",
+                        "description": "remove the genre from films",
+                        "name": "cascade",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.DeleteGenreResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Execute a query or a mutation over films, directors, genres and cast, the schema is in internal/graphql/resolvers/schema.graphql.\nErrors of fields are returned with status 200 in errors, the code of an error is in extensions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "Execute a GraphQL query",
+                "parameters": [
+                    {
+                        "description": "GraphQL Query",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.ExecuteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Data and errors of the query",
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.ExecuteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Health Check",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common"
+                ],
+                "summary": "Health Check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.HealthCheckResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View totals of films, directors, cast members and users, films per genre and release year, top directors and creators.\nStatistics ignore films in trash and are cached, Cache-Control tells how long they stay fresh.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "View statistics",
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age of cached statistics"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "Login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Login form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revoke the current auth token and, if passed, all refresh tokens of the login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Logout",
+                "parameters": [
+                    {
+                        "description": "Logout form",
+                        "name": "form",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.LogoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.LogoutResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/refresh": {
+            "post": {
+                "description": "Get new auth tokens by refresh token. The refresh token can be used only once, a reused refresh token revokes all tokens of the login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Refresh",
+                "parameters": [
+                    {
+                        "description": "Refresh form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.LoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/register": {
+            "post": {
+                "description": "Registration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Registration",
+                "parameters": [
+                    {
+                        "description": "Register form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.RegisterResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/watchlist/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "View watchlist of the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "View watchlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "title.asc or release_date.desc or rating.desc or added_at.desc or watched_at.desc",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "10",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "true or false",
+                        "description": "watched",
+                        "name": "watched",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.ViewWatchlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a film to watchlist of the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Add a film to watchlist",
+                "parameters": [
+                    {
+                        "description": "Add to watchlist form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.AddToWatchlistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.AddToWatchlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/watchlist/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a film from watchlist of the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Remove a film from watchlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.RemoveFromWatchlistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/watchlist/{id}/watched": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Mark a film in watchlist as watched or unwatched. Watched date is today by default.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watchlist"
+                ],
+                "summary": "Mark a film in watchlist as watched",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Film UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Mark as watched form",
+                        "name": "form",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoints.MarkAsWatchedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/endpoints.MarkAsWatchedResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Data Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponseValidation"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "endpoints.AddFilmRequest": {
+            "type": "object",
+            "required": [
+                "casts",
+                "director",
+                "genres",
+                "releaseDate",
+                "synopsis",
+                "title"
+            ],
+            "properties": {
+                "casts": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/endpoints.CreditForm"
+                    }
+                },
+                "director": {
+                    "type": "string",
+                    "maxLength": 40,
+                    "minLength": 3,
+                    "example": "John Doe"
+                },
+                "genres": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "action",
+                        "adventure",
+                        "sci-fi"
+                    ]
+                },
+                "releaseDate": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "synopsis": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 10,
+                    "example": "This is a synopsis."
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Garry Potter"
+                }
+            }
+        },
+        "endpoints.AddFilmResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemFilm"
+                }
+            }
+        },
+        "endpoints.AddGenreRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "western"
+                }
+            }
+        },
+        "endpoints.AddGenreResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemGenre"
+                }
+            }
+        },
+        "endpoints.AddReviewRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "minLength": 3,
+                    "example": "A great film with a brilliant cast."
+                }
+            }
+        },
+        "endpoints.AddReviewResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemReview"
+                }
+            }
+        },
+        "endpoints.AddToWatchlistRequest": {
+            "type": "object",
+            "required": [
+                "film_uuid"
+            ],
+            "properties": {
+                "film_uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.AddToWatchlistResponse": {
+            "type": "object",
+            "properties": {
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.CreditForm": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "billing_order": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "character": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Andy Dufresne"
+                },
+                "department": {
+                    "type": "string",
+                    "enum": [
+                        "cast",
+                        "writer",
+                        "producer",
+                        "composer"
+                    ],
+                    "example": "cast"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Tim Robbins"
+                }
+            }
+        },
+        "endpoints.DeleteFilmResponse": {
+            "type": "object"
+        },
+        "endpoints.DeleteGenreResponse": {
+            "type": "object"
+        },
+        "endpoints.DeleteReviewResponse": {
+            "type": "object"
+        },
+        "endpoints.DiffRevisionsResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemFieldChange"
+                    }
+                },
+                "from": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "endpoints.ExecuteRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "FilmPage"
+                },
+                "query": {
+                    "type": "string",
+                    "maxLength": 20000,
+                    "example": "{ films(limit: 5) { items { title director { name } } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "endpoints.ExecuteResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                }
+            }
+        },
+        "endpoints.FilmPatchForm": {
+            "type": "object",
+            "required": [
+                "casts",
+                "director",
+                "genres",
+                "releaseDate",
+                "synopsis",
+                "title"
+            ],
+            "properties": {
+                "casts": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/endpoints.CreditForm"
+                    }
+                },
+                "director": {
+                    "type": "string",
+                    "maxLength": 40,
+                    "minLength": 3,
+                    "example": "John Doe"
+                },
+                "genres": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "action",
+                        "adventure",
+                        "sci-fi"
+                    ]
+                },
+                "releaseDate": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "synopsis": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 10,
+                    "example": "This is a synopsis."
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Garry Potter"
+                }
+            }
+        },
+        "endpoints.HideReviewRequest": {
+            "type": "object",
+            "properties": {
+                "hidden": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "endpoints.HideReviewResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemReview"
+                }
+            }
+        },
+        "endpoints.ImportFilmsResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 98
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemImportRow"
+                    }
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "endpoints.ItemAllFilms": {
+            "type": "object",
+            "properties": {
+                "casts": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "John Doe",
+                        "Jane Doe",
+                        "Foo Bar"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemCredit"
+                    }
+                },
+                "director": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "action",
+                        "adventure",
+                        "sci-fi"
+                    ]
+                },
+                "highlight": {
+                    "type": "string",
+                    "example": "The \u003cmark\u003eShawshank\u003c/mark\u003e Redemption. Two imprisoned men bond"
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "rating_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "relevance": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "synopsis": {
+                    "type": "string",
+                    "example": "This is a synopsis."
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Garry Potter"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.ItemCreator": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string",
+                    "example": "test"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.ItemCreatorCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "username": {
+                    "type": "string",
+                    "example": "user1"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.ItemCredit": {
+            "type": "object",
+            "properties": {
+                "billing_order": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "character": {
+                    "type": "string",
+                    "example": "Andy Dufresne"
+                },
+                "department": {
+                    "type": "string",
+                    "example": "cast"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tim Robbins"
+                }
+            }
+        },
+        "endpoints.ItemDirector": {
+            "type": "object",
+            "properties": {
+                "films_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Christopher Nolan"
+                }
+            }
+        },
+        "endpoints.ItemDirectorCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Christopher Nolan"
+                }
+            }
+        },
+        "endpoints.ItemDirectorFilm": {
+            "type": "object",
+            "properties": {
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "action",
+                        "sci-fi"
+                    ]
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "rating_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2010-07-16"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Inception"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.ItemFacet": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "value": {
+                    "type": "string",
+                    "example": "drama"
+                }
+            }
+        },
+        "endpoints.ItemFieldChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "title"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "Shawshank"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "The Shawshank Redemption"
+                }
+            }
+        },
+        "endpoints.ItemFilm": {
             "type": "object",
-            "required": [
-                "casts",
-                "director",
-                "genres",
-                "releaseDate",
-                "synopsis",
-                "title"
-            ],
             "properties": {
                 "casts": {
                     "type": "array",
-                    "maxItems": 10,
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     },
@@ -625,16 +4008,22 @@ const docTemplate = `{
                         "Baz Quux"
                     ]
                 },
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemCredit"
+                    }
+                },
                 "director": {
                     "type": "string",
-                    "maxLength": 40,
-                    "minLength": 3,
                     "example": "John Doe"
                 },
                 "genres": {
                     "type": "array",
-                    "maxItems": 5,
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     },
@@ -644,56 +4033,173 @@ const docTemplate = `{
                         "sci-fi"
                     ]
                 },
-                "releaseDate": {
+                "rating": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "rating_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "release_date": {
                     "type": "string",
                     "example": "2021-01-01"
                 },
                 "synopsis": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 10,
                     "example": "This is a synopsis."
                 },
                 "title": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3,
                     "example": "Garry Potter"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "endpoints.AddFilmResponse": {
+        "endpoints.ItemGenre": {
             "type": "object",
             "properties": {
-                "item": {
-                    "$ref": "#/definitions/endpoints.ItemFilm"
+                "films_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "western"
                 }
             }
         },
-        "endpoints.DeleteFilmResponse": {
-            "type": "object"
+        "endpoints.ItemGenreCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "name": {
+                    "type": "string",
+                    "example": "drama"
+                }
+            }
         },
-        "endpoints.ItemAllFilms": {
+        "endpoints.ItemImportRow": {
             "type": "object",
             "properties": {
-                "casts": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "John Doe",
-                        "Jane Doe",
-                        "Foo Bar"
-                    ]
+                "reason": {
+                    "type": "string",
+                    "example": "Synopsis must be at least 10 characters in length"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "example": "failed"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Garry Potter"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.ItemRating": {
+            "type": "object",
+            "properties": {
+                "film_uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "rating_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "score": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "endpoints.ItemReview": {
+            "type": "object",
+            "properties": {
+                "author_uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "film_uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "hidden": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "text": {
+                    "type": "string",
+                    "example": "A great film with a brilliant cast."
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "endpoints.ItemRevision": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "update"
                 },
                 "created_at": {
                     "type": "string",
                     "example": "2021-01-01 00:00:00"
                 },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemCredit"
+                    }
+                },
                 "director": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "Frank Darabont"
+                },
+                "editor_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
                 "genres": {
                     "type": "array",
@@ -701,14 +4207,17 @@ const docTemplate = `{
                         "type": "string"
                     },
                     "example": [
-                        "action",
-                        "adventure",
-                        "sci-fi"
+                        "drama",
+                        "crime"
                     ]
                 },
+                "number": {
+                    "type": "integer",
+                    "example": 2
+                },
                 "release_date": {
                     "type": "string",
-                    "example": "2021-01-01"
+                    "example": "1994-09-23"
                 },
                 "synopsis": {
                     "type": "string",
@@ -716,32 +4225,68 @@ const docTemplate = `{
                 },
                 "title": {
                     "type": "string",
-                    "example": "Garry Potter"
+                    "example": "The Shawshank Redemption"
+                }
+            }
+        },
+        "endpoints.ItemStats": {
+            "type": "object",
+            "properties": {
+                "films_by_genre": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemGenreCount"
+                    }
                 },
-                "updated_at": {
+                "films_by_year": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemYearCount"
+                    }
+                },
+                "generated_at": {
                     "type": "string",
                     "example": "2021-01-01 00:00:00"
                 },
-                "uuid": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "top_creators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemCreatorCount"
+                    }
+                },
+                "top_directors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemDirectorCount"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/endpoints.ItemStatsTotals"
                 }
             }
         },
-        "endpoints.ItemCreator": {
+        "endpoints.ItemStatsTotals": {
             "type": "object",
             "properties": {
-                "username": {
-                    "type": "string",
-                    "example": "test"
+                "casts": {
+                    "type": "integer",
+                    "example": 380
                 },
-                "uuid": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "directors": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "films": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "users": {
+                    "type": "integer",
+                    "example": 25
                 }
             }
         },
-        "endpoints.ItemFilm": {
+        "endpoints.ItemTrashFilm": {
             "type": "object",
             "properties": {
                 "casts": {
@@ -752,14 +4297,23 @@ const docTemplate = `{
                     "example": [
                         "John Doe",
                         "Jane Doe",
-                        "Foo Bar",
-                        "Baz Quux"
+                        "Foo Bar"
                     ]
                 },
                 "created_at": {
                     "type": "string",
                     "example": "2021-01-01 00:00:00"
                 },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemCredit"
+                    }
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
                 "director": {
                     "type": "string",
                     "example": "John Doe"
@@ -775,10 +4329,26 @@ const docTemplate = `{
                         "sci-fi"
                     ]
                 },
+                "highlight": {
+                    "type": "string",
+                    "example": "The \u003cmark\u003eShawshank\u003c/mark\u003e Redemption. Two imprisoned men bond"
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "rating_count": {
+                    "type": "integer",
+                    "example": 12
+                },
                 "release_date": {
                     "type": "string",
                     "example": "2021-01-01"
                 },
+                "relevance": {
+                    "type": "number",
+                    "example": 0.6
+                },
                 "synopsis": {
                     "type": "string",
                     "example": "This is a synopsis."
@@ -797,6 +4367,29 @@ const docTemplate = `{
                 }
             }
         },
+        "endpoints.ItemViewDirector": {
+            "type": "object",
+            "properties": {
+                "films": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemDirectorFilm"
+                    }
+                },
+                "films_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Christopher Nolan"
+                }
+            }
+        },
         "endpoints.ItemViewFilm": {
             "type": "object",
             "properties": {
@@ -817,6 +4410,12 @@ const docTemplate = `{
                 "creator": {
                     "$ref": "#/definitions/endpoints.ItemCreator"
                 },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemCredit"
+                    }
+                },
                 "director": {
                     "type": "string",
                     "example": "Frank Darabont"
@@ -831,6 +4430,14 @@ const docTemplate = `{
                         "crime"
                     ]
                 },
+                "rating": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "rating_count": {
+                    "type": "integer",
+                    "example": 12
+                },
                 "release_date": {
                     "type": "string",
                     "example": "1994-09-23"
@@ -847,9 +4454,46 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2021-01-01 00:00:00"
                 },
-                "uuid": {
+                "uuid": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "endpoints.ItemWatchlist": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string",
+                    "example": "2021-01-01 00:00:00"
+                },
+                "film": {
+                    "$ref": "#/definitions/endpoints.ItemAllFilms"
+                },
+                "watched": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "watched_at": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "2021-01-01"
+                }
+            }
+        },
+        "endpoints.ItemYearCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2010
                 }
             }
         },
@@ -884,6 +4528,122 @@ const docTemplate = `{
                 "expired_at": {
                     "type": "string",
                     "example": "2023-11-09T15:21:15.973955426Z"
+                },
+                "refresh_expired_at": {
+                    "type": "string",
+                    "example": "2023-12-09T14:21:15.973955426Z"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q8Yk1nq3Vf0bH4xgS2m1bEo7pZr9dWc6LtJ5uA0yKhE"
+                }
+            }
+        },
+        "endpoints.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16,
+                    "example": "q8Yk1nq3Vf0bH4xgS2m1bEo7pZr9dWc6LtJ5uA0yKhE"
+                }
+            }
+        },
+        "endpoints.LogoutResponse": {
+            "type": "object"
+        },
+        "endpoints.MarkAsWatchedRequest": {
+            "type": "object",
+            "properties": {
+                "watched": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "watched_at": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                }
+            }
+        },
+        "endpoints.MarkAsWatchedResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemWatchlist"
+                }
+            }
+        },
+        "endpoints.MergeDirectorsRequest": {
+            "type": "object",
+            "required": [
+                "director_ids"
+            ],
+            "properties": {
+                "director_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        2,
+                        3
+                    ]
+                }
+            }
+        },
+        "endpoints.MergeDirectorsResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemDirector"
+                }
+            }
+        },
+        "endpoints.PatchFilmResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemViewFilm"
+                }
+            }
+        },
+        "endpoints.RateFilmRequest": {
+            "type": "object",
+            "required": [
+                "score"
+            ],
+            "properties": {
+                "score": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 8
+                }
+            }
+        },
+        "endpoints.RateFilmResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemRating"
+                }
+            }
+        },
+        "endpoints.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16,
+                    "example": "q8Yk1nq3Vf0bH4xgS2m1bEo7pZr9dWc6LtJ5uA0yKhE"
                 }
             }
         },
@@ -921,6 +4681,47 @@ const docTemplate = `{
                 }
             }
         },
+        "endpoints.RemoveFromWatchlistResponse": {
+            "type": "object"
+        },
+        "endpoints.RestoreFilmResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemViewFilm"
+                }
+            }
+        },
+        "endpoints.RollbackFilmResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemViewFilm"
+                }
+            }
+        },
+        "endpoints.UpdateDirectorRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Christopher Nolan"
+                }
+            }
+        },
+        "endpoints.UpdateDirectorResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemDirector"
+                }
+            }
+        },
         "endpoints.UpdateFilmRequest": {
             "type": "object",
             "required": [
@@ -934,17 +4735,11 @@ const docTemplate = `{
             "properties": {
                 "casts": {
                     "type": "array",
-                    "maxItems": 10,
+                    "maxItems": 50,
                     "minItems": 1,
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "John Doe",
-                        " Jane Doe",
-                        " Foo Bar",
-                        " Baz Quux"
-                    ]
+                        "$ref": "#/definitions/endpoints.CreditForm"
+                    }
                 },
                 "director": {
                     "type": "string",
@@ -991,9 +4786,76 @@ const docTemplate = `{
                 }
             }
         },
+        "endpoints.UpdateGenreRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "western"
+                }
+            }
+        },
+        "endpoints.UpdateGenreResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemGenre"
+                }
+            }
+        },
+        "endpoints.UpdateReviewRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "minLength": 3,
+                    "example": "A great film with a brilliant cast."
+                }
+            }
+        },
+        "endpoints.UpdateReviewResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemReview"
+                }
+            }
+        },
+        "endpoints.ViewAllDirectorsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemDirector"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                }
+            }
+        },
         "endpoints.ViewAllFilmsResponse": {
             "type": "object",
             "properties": {
+                "facets": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/endpoints.ItemFacet"
+                        }
+                    }
+                },
                 "items": {
                     "type": "array",
                     "items": {
@@ -1005,6 +4867,56 @@ const docTemplate = `{
                 }
             }
         },
+        "endpoints.ViewAllGenresResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemGenre"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                }
+            }
+        },
+        "endpoints.ViewAllReviewsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemReview"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                }
+            }
+        },
+        "endpoints.ViewAllRevisionsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemRevision"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                }
+            }
+        },
+        "endpoints.ViewDirectorResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemViewDirector"
+                }
+            }
+        },
         "endpoints.ViewFilmResponse": {
             "type": "object",
             "properties": {
@@ -1013,6 +4925,42 @@ const docTemplate = `{
                 }
             }
         },
+        "endpoints.ViewStatsResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/endpoints.ItemStats"
+                }
+            }
+        },
+        "endpoints.ViewTrashResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemTrashFilm"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                }
+            }
+        },
+        "endpoints.ViewWatchlistResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoints.ItemWatchlist"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/pagination.Pagination"
+                }
+            }
+        },
         "http.HealthCheckResponse": {
             "type": "object",
             "properties": {
@@ -1025,6 +4973,10 @@ const docTemplate = `{
         "pagination.Pagination": {
             "type": "object",
             "properties": {
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoicmF0aW5nLmRlc2MiLCJ2Ijo3LjUsImlkIjoiNTUwZTg0MDAtZTI5Yi00MWQ0LWE3MTYtNDQ2NjU1NDQwMDAwIn0"
+                },
                 "page": {
                     "type": "integer",
                     "example": 1
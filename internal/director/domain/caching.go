@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	domainFilm "film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/cache"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"go.uber.org/zap"
+)
+
+// cachingMiddleware invalidates cached films when directors embedded into them change.
+// It must wrap the service before logging and instrumenting.
+type cachingMiddleware struct {
+	next   Service
+	cache  cache.Cache
+	logger *zap.Logger
+}
+
+// NewCachingMiddleware is a constructor for middleware which invalidates films cached in the cache of films.
+func NewCachingMiddleware(c cache.Cache, logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return &cachingMiddleware{
+			next:   next,
+			cache:  c,
+			logger: logger,
+		}
+	}
+}
+
+func (c cachingMiddleware) ViewAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Director, pagination.Pagination, error) {
+	return c.next.ViewAllDirectors(ctx, filterSortLimit)
+}
+
+func (c cachingMiddleware) ViewDirector(ctx context.Context, directorID uint) (models.Director, []models.Film, error) {
+	return c.next.ViewDirector(ctx, directorID)
+}
+
+func (c cachingMiddleware) UpdateDirector(ctx context.Context, model *models.Director, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.UpdateDirector(ctx, model, actor)
+}
+
+func (c cachingMiddleware) MergeDirectors(ctx context.Context, model *models.Director, sourceIDs []uint, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.MergeDirectors(ctx, model, sourceIDs, actor)
+}
+
+// invalidateOnSuccess is a method to invalidate cached films when the change of directors did not fail.
+func (c cachingMiddleware) invalidateOnSuccess(ctx context.Context, err *error) {
+	if *err == nil {
+		c.invalidate(ctx)
+	}
+}
+
+// invalidate is a method to start a new generation of cached films.
+func (c cachingMiddleware) invalidate(ctx context.Context) {
+	if err := domainFilm.InvalidateFilmsCache(ctx, c.cache); err != nil {
+		c.logger.Error("cachingMiddleware.invalidate.Incr", zap.Error(err))
+	}
+}
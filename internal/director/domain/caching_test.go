@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"film-management/internal/director/domain"
+	domainFilm "film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/cache"
+	"film-management/pkg/policy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+var errMutation = errors.New("mutation failed")
+
+// filmsService is a service of films which counts reads of films.
+type filmsService struct {
+	domainFilm.Service
+	reads int
+}
+
+func (s *filmsService) ViewFilm(_ context.Context, filmID uuid.UUID) (models.Film, error) {
+	s.reads++
+
+	return models.Film{UUID: filmID, Director: models.Director{ID: 1, Name: "Director A"}}, nil
+}
+
+// directorsService is a service of directors which fails mutations with err.
+type directorsService struct {
+	domain.Service
+	err error
+}
+
+func (s *directorsService) UpdateDirector(context.Context, *models.Director, policy.Actor) error {
+	return s.err
+}
+
+func (s *directorsService) MergeDirectors(context.Context, *models.Director, []uint, policy.Actor) error {
+	return s.err
+}
+
+func TestCachingMiddleware_Invalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	actor := policy.NewActor(uuid.New(), policy.RoleAdmin)
+	filmID := uuid.New()
+
+	type mutation func(s domain.Service) error
+
+	tests := []struct {
+		name        string
+		mutation    mutation
+		err         error
+		invalidated bool
+	}{
+		{
+			name: "UpdateDirector",
+			mutation: func(s domain.Service) error {
+				return s.UpdateDirector(ctx, &models.Director{ID: 1, Name: "Director B"}, actor)
+			},
+			invalidated: true,
+		},
+		{
+			name: "Failed UpdateDirector",
+			mutation: func(s domain.Service) error {
+				return s.UpdateDirector(ctx, &models.Director{ID: 1, Name: "Director B"}, actor)
+			},
+			err: errMutation,
+		},
+		{
+			name:        "MergeDirectors",
+			mutation:    func(s domain.Service) error { return s.MergeDirectors(ctx, &models.Director{ID: 1}, []uint{2}, actor) },
+			invalidated: true,
+		},
+		{
+			name:     "Failed MergeDirectors",
+			mutation: func(s domain.Service) error { return s.MergeDirectors(ctx, &models.Director{ID: 1}, []uint{2}, actor) },
+			err:      errMutation,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			requireAssert := require.New(t)
+
+			filmsCache := cache.NewLRU(100)
+
+			films := &filmsService{}
+			filmService := domainFilm.NewCachingMiddleware(filmsCache, time.Minute, zap.NewNop())(films)
+			directorService := domain.NewCachingMiddleware(filmsCache, zap.NewNop())(&directorsService{err: test.err})
+
+			for i := 0; i < 2; i++ {
+				_, err := filmService.ViewFilm(ctx, filmID)
+				requireAssert.NoError(err)
+			}
+
+			requireAssert.Equal(1, films.reads)
+
+			requireAssert.ErrorIs(test.mutation(directorService), test.err)
+
+			_, err := filmService.ViewFilm(ctx, filmID)
+			requireAssert.NoError(err)
+
+			if test.invalidated {
+				requireAssert.Equal(2, films.reads)
+			} else {
+				requireAssert.Equal(1, films.reads)
+			}
+		})
+	}
+}
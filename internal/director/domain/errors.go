@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+var (
+	ErrDirectorUpdate              = errors.New("failed to update director")
+	ErrDirectorMerge               = errors.New("failed to merge directors")
+	ErrDirectorFind                = errors.New("failed to find director")
+	ErrDirectorFindAll             = errors.New("failed to find all directors")
+	ErrDirectorFilmsFind           = errors.New("failed to find films of director")
+	ErrDirectorNotFound            = errors.New("director not found")
+	ErrDirectorExists              = errors.New("director with this name already exists, merge directors instead")
+	ErrDirectorMergeSame           = errors.New("director can not be merged into itself")
+	ErrDirectorNotUpdatePermission = errors.New("you don't have permission to update directors")
+	ErrDirectorNotMergePermission  = errors.New("you don't have permission to merge directors")
+	ErrDirectorFilterWrong         = errors.New("filter wrong")
+	ErrDirectorUnknownField        = errors.New("unknown field")
+)
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/instrumenting"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/go-kit/kit/metrics"
+	"time"
+)
+
+type instrumentingMiddleware struct {
+	requestCount    metrics.Counter
+	requestDuration metrics.Histogram
+	next            Service
+}
+
+// NewInstrumentingMiddleware returns an instance of the instrumenting middleware.
+func NewInstrumentingMiddleware(requestCount metrics.Counter,
+	requestDuration metrics.Histogram) Middleware {
+	return func(next Service) Service {
+		return &instrumentingMiddleware{
+			requestCount,
+			requestDuration,
+			next,
+		}
+	}
+}
+
+func (i instrumentingMiddleware) ViewAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) (items []models.Director, p pagination.Pagination, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewAllDirectors", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewAllDirectors(ctx, filterSortLimit)
+}
+
+func (i instrumentingMiddleware) ViewDirector(ctx context.Context, directorID uint) (director models.Director, films []models.Film, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewDirector", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewDirector(ctx, directorID)
+}
+
+func (i instrumentingMiddleware) UpdateDirector(ctx context.Context, model *models.Director, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "UpdateDirector", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.UpdateDirector(ctx, model, actor)
+}
+
+func (i instrumentingMiddleware) MergeDirectors(ctx context.Context, model *models.Director, sourceIDs []uint, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "MergeDirectors", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.MergeDirectors(ctx, model, sourceIDs, actor)
+}
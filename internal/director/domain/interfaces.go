@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+)
+
+// Service is an interface for domain service.
+type Service interface {
+	ViewAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Director, pagination.Pagination, error)
+	ViewDirector(ctx context.Context, directorID uint) (models.Director, []models.Film, error)
+	UpdateDirector(ctx context.Context, model *models.Director, actor policy.Actor) error
+	MergeDirectors(ctx context.Context, model *models.Director, sourceIDs []uint, actor policy.Actor) error
+}
+
+// Repository is a repository for directors.
+type Repository interface {
+	FindAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Director, pagination.Pagination, error)
+	FindOneDirector(ctx context.Context, directorID uint) (models.Director, error)
+	FindDirectorByName(ctx context.Context, name string) (models.Director, error)
+	FindAllDirectorFilms(ctx context.Context, directorID uint) ([]models.Film, error)
+	UpdateDirector(ctx context.Context, model *models.Director) error
+	MergeDirectors(ctx context.Context, targetID uint, sourceIDs []uint) error
+}
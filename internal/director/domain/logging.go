@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"go.uber.org/zap"
+)
+
+type loggingMiddleware struct {
+	next   Service
+	logger *zap.Logger
+}
+
+func NewLoggingMiddleware(logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return &loggingMiddleware{
+			next:   next,
+			logger: logger,
+		}
+	}
+}
+
+func (l loggingMiddleware) ViewAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) (items []models.Director, p pagination.Pagination, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewAllDirectors")).
+			Debug("domain",
+				zap.Any("filter", filterSortLimit.Filter),
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Int("offset", filterSortLimit.Offset),
+				zap.Int("page", p.Page),
+				zap.Int("page-size", p.PageSize),
+				zap.Int("total-count", p.TotalCount),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewAllDirectors(ctx, filterSortLimit)
+}
+
+func (l loggingMiddleware) ViewDirector(ctx context.Context, directorID uint) (director models.Director, films []models.Film, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewDirector")).
+			Debug("domain",
+				zap.Uint("directorID", directorID),
+				zap.Int("films", len(films)),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewDirector(ctx, directorID)
+}
+
+func (l loggingMiddleware) UpdateDirector(ctx context.Context, model *models.Director, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "UpdateDirector")).
+			Debug("domain",
+				zap.Uint("directorID", model.ID),
+				zap.String("name", model.Name),
+				zap.Any("userID", actor.UserID),
+				zap.Error(err))
+	}()
+
+	return l.next.UpdateDirector(ctx, model, actor)
+}
+
+func (l loggingMiddleware) MergeDirectors(ctx context.Context, model *models.Director, sourceIDs []uint, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "MergeDirectors")).
+			Debug("domain",
+				zap.Uint("directorID", model.ID),
+				zap.Uints("sourceIDs", sourceIDs),
+				zap.Any("userID", actor.UserID),
+				zap.Error(err))
+	}()
+
+	return l.next.MergeDirectors(ctx, model, sourceIDs, actor)
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// service is a struct for domain service.
+type service struct {
+	Opts
+}
+
+// NewService is a constructor for domain service.
+func NewService(repository Repository, opts ...OptFunc) Service {
+	// Init default options
+	o := defaultOpts(repository)
+
+	// Apply options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &service{
+		Opts: o,
+	}
+}
+
+// ViewAllDirectors View all directors.
+func (s service) ViewAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Director, pagination.Pagination, error) {
+	directorsFromDB, p, err := s.repository.FindAllDirectors(ctx, filterSortLimit)
+	if err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	return directorsFromDB, p, nil
+}
+
+// ViewDirector View a director with filmography.
+func (s service) ViewDirector(ctx context.Context, directorID uint) (models.Director, []models.Film, error) {
+	// Get director from db
+	directorFromDB, err := s.getDirectorFromDB(ctx, directorID)
+	if err != nil {
+		return models.Director{}, nil, err
+	}
+
+	// Get films of director from db
+	filmsFromDB, err := s.repository.FindAllDirectorFilms(ctx, directorID)
+	if err != nil {
+		return models.Director{}, nil, ErrDirectorFilmsFind
+	}
+
+	return directorFromDB, filmsFromDB, nil
+}
+
+// UpdateDirector Rename a director.
+func (s service) UpdateDirector(ctx context.Context, model *models.Director, actor policy.Actor) error {
+	// Check if user has permission to update directors
+	if !policy.Can(actor, policy.ActionDirectorUpdate, uuid.Nil) {
+		return customError.PermissionError{Err: ErrDirectorNotUpdatePermission}
+	}
+
+	// Get director from db
+	directorFromDB, err := s.getDirectorFromDB(ctx, model.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check if another director has the same name
+	if err = s.checkDirectorNameIsFree(ctx, model); err != nil {
+		return err
+	}
+
+	// Set new director data
+	directorFromDB.SetDataForUpdate(model)
+
+	// Update director in db
+	if errUpdate := s.repository.UpdateDirector(ctx, &directorFromDB); errUpdate != nil {
+		return ErrDirectorUpdate
+	}
+
+	*model = directorFromDB
+
+	return nil
+}
+
+// MergeDirectors Merge duplicate directors into one. Films of the merged directors are moved to the target director.
+func (s service) MergeDirectors(ctx context.Context, model *models.Director, sourceIDs []uint, actor policy.Actor) error {
+	// Check if user has permission to merge directors
+	if !policy.Can(actor, policy.ActionDirectorMerge, uuid.Nil) {
+		return customError.PermissionError{Err: ErrDirectorNotMergePermission}
+	}
+
+	// Check that director is not merged into itself
+	for _, sourceID := range sourceIDs {
+		if sourceID == model.ID {
+			return customError.ValidationError{Field: "director_ids", Err: ErrDirectorMergeSame}
+		}
+	}
+
+	// Check if all directors exist
+	if _, err := s.getDirectorFromDB(ctx, model.ID); err != nil {
+		return err
+	}
+
+	for _, sourceID := range sourceIDs {
+		if _, err := s.getDirectorFromDB(ctx, sourceID); err != nil {
+			return err
+		}
+	}
+
+	// Merge directors in db
+	if err := s.repository.MergeDirectors(ctx, model.ID, sourceIDs); err != nil {
+		return ErrDirectorMerge
+	}
+
+	// Get merged director from db
+	directorFromDB, err := s.getDirectorFromDB(ctx, model.ID)
+	if err != nil {
+		return err
+	}
+
+	*model = directorFromDB
+
+	return nil
+}
+
+// getDirectorFromDB Get director from db.
+func (s service) getDirectorFromDB(ctx context.Context, directorID uint) (models.Director, error) {
+	directorFromDB, err := s.repository.FindOneDirector(ctx, directorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrDirectorNotFound):
+			return models.Director{}, customError.NotFoundError{Err: ErrDirectorNotFound}
+		default:
+			return models.Director{}, ErrDirectorFind
+		}
+	}
+
+	return directorFromDB, nil
+}
+
+// checkDirectorNameIsFree Check that no other director has the name of the model.
+func (s service) checkDirectorNameIsFree(ctx context.Context, model *models.Director) error {
+	directorFromDB, err := s.repository.FindDirectorByName(ctx, model.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrDirectorNotFound):
+			return nil
+		default:
+			return ErrDirectorFind
+		}
+	}
+
+	if directorFromDB.ID != model.ID {
+		return customError.ValidationError{Field: "name", Err: ErrDirectorExists}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package endpoints
+
+import (
+	"film-management/internal/director/domain"
+	"github.com/go-kit/kit/endpoint"
+	"go.uber.org/zap"
+)
+
+// SetEndpoints collects all the endpoints that compose a director service.
+type SetEndpoints struct {
+	ViewAllDirectorsEndpoint endpoint.Endpoint
+	ViewDirectorEndpoint     endpoint.Endpoint
+	UpdateDirectorEndpoint   endpoint.Endpoint
+	MergeDirectorsEndpoint   endpoint.Endpoint
+}
+
+// NewEndpoints returns a SetEndpoints that wraps the provided server, and wires in all the provided middlewares.
+func NewEndpoints(s domain.Service, logger *zap.Logger) SetEndpoints {
+	var viewAllDirectorsEndpoint endpoint.Endpoint
+	{
+		viewAllDirectorsEndpoint = MakeViewAllDirectorsEndpoint(s)
+		viewAllDirectorsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewAllDirectors")))(viewAllDirectorsEndpoint)
+	}
+
+	var viewDirectorEndpoint endpoint.Endpoint
+	{
+		viewDirectorEndpoint = MakeViewDirectorEndpoint(s)
+		viewDirectorEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewDirector")))(viewDirectorEndpoint)
+	}
+
+	var updateDirectorEndpoint endpoint.Endpoint
+	{
+		updateDirectorEndpoint = MakeUpdateDirectorEndpoint(s)
+		updateDirectorEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "UpdateDirector")))(updateDirectorEndpoint)
+	}
+
+	var mergeDirectorsEndpoint endpoint.Endpoint
+	{
+		mergeDirectorsEndpoint = MakeMergeDirectorsEndpoint(s)
+		mergeDirectorsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "MergeDirectors")))(mergeDirectorsEndpoint)
+	}
+
+	return SetEndpoints{
+		ViewAllDirectorsEndpoint: viewAllDirectorsEndpoint,
+		ViewDirectorEndpoint:     viewDirectorEndpoint,
+		UpdateDirectorEndpoint:   updateDirectorEndpoint,
+		MergeDirectorsEndpoint:   mergeDirectorsEndpoint,
+	}
+}
@@ -0,0 +1,84 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/director/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"strconv"
+)
+
+// MakeMergeDirectorsEndpoint is an endpoint for MergeDirectors.
+func MakeMergeDirectorsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(MergeDirectorsRequest)
+		if !ok {
+			return MergeDirectorsResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return MergeDirectorsResponse{Err: errValidate}, nil
+		}
+
+		// Parse director ID
+		parseID, err := strconv.ParseUint(reqForm.ID, 10, 64)
+		if err != nil {
+			return MergeDirectorsResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return MergeDirectorsResponse{Err: err}, nil
+		}
+
+		// Prepare a director model
+		model := &models.Director{
+			ID: uint(parseID),
+		}
+
+		// Merge directors
+		if errMergeDirectors := s.MergeDirectors(ctx, model, reqForm.DirectorIDs, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errMergeDirectors != nil {
+			return MergeDirectorsResponse{Err: errMergeDirectors}, nil
+		}
+
+		return MergeDirectorsResponse{
+			Item: domainDirectorToItemDirector(*model),
+		}, nil
+	}
+}
+
+// MergeDirectorsRequest is a request for MergeDirectors.
+type MergeDirectorsRequest struct {
+	ID       string `json:"id" validate:"required,numeric" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	DirectorIDs []uint `json:"director_ids" validate:"required,min=1,max=100,unique,dive,min=1" example:"2,3"`
+}
+
+// Validate is a method to validate form.
+func (r *MergeDirectorsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// MergeDirectorsResponse is a response for MergeDirectors.
+type MergeDirectorsResponse struct {
+	Item ItemDirector `json:"item,omitempty"`
+	Err  error        `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r MergeDirectorsResponse) Failed() error { return r.Err }
@@ -0,0 +1,85 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/director/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"strconv"
+)
+
+// MakeUpdateDirectorEndpoint is an endpoint for UpdateDirector.
+func MakeUpdateDirectorEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(UpdateDirectorRequest)
+		if !ok {
+			return UpdateDirectorResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return UpdateDirectorResponse{Err: errValidate}, nil
+		}
+
+		// Parse director ID
+		parseID, err := strconv.ParseUint(reqForm.ID, 10, 64)
+		if err != nil {
+			return UpdateDirectorResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return UpdateDirectorResponse{Err: err}, nil
+		}
+
+		// Prepare a director model
+		model := &models.Director{
+			ID:   uint(parseID),
+			Name: reqForm.Name,
+		}
+
+		// Update director
+		if errUpdateDirector := s.UpdateDirector(ctx, model, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errUpdateDirector != nil {
+			return UpdateDirectorResponse{Err: errUpdateDirector}, nil
+		}
+
+		return UpdateDirectorResponse{
+			Item: domainDirectorToItemDirector(*model),
+		}, nil
+	}
+}
+
+// UpdateDirectorRequest is a request for UpdateDirector.
+type UpdateDirectorRequest struct {
+	ID       string `json:"id" validate:"required,numeric" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Name string `json:"name" validate:"required,min=3,max=100" example:"Christopher Nolan"`
+}
+
+// Validate is a method to validate form.
+func (r *UpdateDirectorRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// UpdateDirectorResponse is a response for UpdateDirector.
+type UpdateDirectorResponse struct {
+	Item ItemDirector `json:"item,omitempty"`
+	Err  error        `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r UpdateDirectorResponse) Failed() error { return r.Err }
@@ -0,0 +1,127 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/director/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+)
+
+// MakeViewAllDirectorsEndpoint is an endpoint for ViewAllDirectors.
+func MakeViewAllDirectorsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewAllDirectorsRequest)
+		if !ok {
+			return ViewAllDirectorsResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewAllDirectorsResponse{Err: errValidate}, nil
+		}
+
+		// Get sort
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"name", "films_count"}, "name.asc")
+		if err != nil {
+			return ViewAllDirectorsResponse{Err: err}, nil
+		}
+
+		// Get limit
+		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
+		if err != nil {
+			return ViewAllDirectorsResponse{Err: err}, nil
+		}
+
+		// Get offset
+		offset, err := pagination.GetOffsetOption(reqForm.Offset)
+		if err != nil {
+			return ViewAllDirectorsResponse{Err: err}, nil
+		}
+
+		// Get filters
+		myFilters := make(query.Filter)
+
+		if reqForm.Name != "" {
+			myFilters["name"] = reqForm.Name
+		}
+
+		// Build FilterSortLimit
+		filterSortLimit := query.NewFilterSortLimitBuilder().
+			SetSort(sortOption).
+			SetFilter(myFilters).
+			SetLimit(limit).
+			SetOffset(offset).
+			Build()
+
+		if items, p, errViewAllDirectors := s.ViewAllDirectors(ctx, filterSortLimit); errViewAllDirectors != nil {
+			return ViewAllDirectorsResponse{Err: errViewAllDirectors}, nil
+		} else {
+			return ViewAllDirectorsResponse{
+				Items:      domainDirectorsToItemDirectors(items),
+				Pagination: p,
+			}, nil
+		}
+	}
+}
+
+// ViewAllDirectorsRequest is a request for ViewAllDirectors.
+type ViewAllDirectorsRequest struct {
+	Sort   string `json:"sort" validate:"omitempty,min=3,max=30" example:"name.asc"`
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset int    `json:"offset" validate:"omitempty,min=0" example:"0"`
+	Name   string `json:"name" validate:"omitempty,min=1,max=100" example:"Nolan"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewAllDirectorsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewAllDirectorsResponse is a response for ViewAllDirectors.
+type ViewAllDirectorsResponse struct {
+	Items      []ItemDirector        `json:"items"`
+	Pagination pagination.Pagination `json:"pagination,omitempty"`
+	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewAllDirectorsResponse) Failed() error { return r.Err }
+
+// ItemDirector is a response item for director.
+type ItemDirector struct {
+	ID         uint   `json:"id" example:"1"`
+	Name       string `json:"name" example:"Christopher Nolan"`
+	FilmsCount int64  `json:"films_count" example:"12"`
+}
+
+// domainDirectorsToItemDirectors is a function to convert domain directors to item directors.
+func domainDirectorsToItemDirectors(items []models.Director) []ItemDirector {
+	directors := make([]ItemDirector, 0, len(items))
+
+	for _, item := range items {
+		directors = append(directors, domainDirectorToItemDirector(item))
+	}
+
+	return directors
+}
+
+// domainDirectorToItemDirector is a function to convert domain director to item director.
+func domainDirectorToItemDirector(item models.Director) ItemDirector {
+	return ItemDirector{
+		ID:         item.ID,
+		Name:       item.Name,
+		FilmsCount: item.FilmsCount,
+	}
+}
@@ -0,0 +1,110 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/director/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"strconv"
+	"time"
+)
+
+// MakeViewDirectorEndpoint is an endpoint for ViewDirector.
+func MakeViewDirectorEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewDirectorRequest)
+		if !ok {
+			return ViewDirectorResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewDirectorResponse{Err: errValidate}, nil
+		}
+
+		// Parse director ID
+		parseID, err := strconv.ParseUint(reqForm.ID, 10, 64)
+		if err != nil {
+			return ViewDirectorResponse{Err: err}, nil
+		}
+
+		if director, films, errViewDirector := s.ViewDirector(ctx, uint(parseID)); errViewDirector != nil {
+			return ViewDirectorResponse{Err: errViewDirector}, nil
+		} else {
+			return ViewDirectorResponse{
+				Item: ItemViewDirector{
+					ItemDirector: domainDirectorToItemDirector(director),
+					Films:        domainFilmsToItemDirectorFilms(films),
+				},
+			}, nil
+		}
+	}
+}
+
+// ViewDirectorRequest is a request for ViewDirector.
+type ViewDirectorRequest struct {
+	ID string `json:"id" validate:"required,numeric" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewDirectorRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewDirectorResponse is a response for ViewDirector.
+type ViewDirectorResponse struct {
+	Item ItemViewDirector `json:"item,omitempty"`
+	Err  error            `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewDirectorResponse) Failed() error { return r.Err }
+
+// ItemViewDirector is a response item for director with filmography.
+type ItemViewDirector struct {
+	ItemDirector
+	Films []ItemDirectorFilm `json:"films"`
+}
+
+// ItemDirectorFilm is a response item for film in filmography of director.
+type ItemDirectorFilm struct {
+	UUID        uuid.UUID `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string    `json:"title" example:"Inception"`
+	Genres      []string  `json:"genres" example:"action,sci-fi"`
+	ReleaseDate string    `json:"release_date" example:"2010-07-16"`
+	Rating      float64   `json:"rating" example:"7.5"`
+	RatingCount int64     `json:"rating_count" example:"12"`
+}
+
+// domainFilmsToItemDirectorFilms is a function to convert domain films to items of director filmography.
+func domainFilmsToItemDirectorFilms(items []models.Film) []ItemDirectorFilm {
+	films := make([]ItemDirectorFilm, 0, len(items))
+
+	for _, item := range items {
+		genres := make([]string, 0, len(item.Genres))
+		for _, genre := range item.Genres {
+			genres = append(genres, genre.Name)
+		}
+
+		films = append(films, ItemDirectorFilm{
+			UUID:        item.UUID,
+			Title:       item.Title,
+			Genres:      genres,
+			ReleaseDate: item.ReleaseDate.Format(time.DateOnly),
+			Rating:      item.Rating,
+			RatingCount: item.RatingCount,
+		})
+	}
+
+	return films
+}
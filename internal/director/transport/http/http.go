@@ -0,0 +1,257 @@
+package http
+
+import (
+	"context"
+	"film-management/config"
+	httpCommon "film-management/internal/common/transport/http"
+	"film-management/internal/director/endpoints"
+	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/transport/http/middlewares/auth"
+	"film-management/pkg/transport/http/middlewares/cors"
+	"film-management/pkg/transport/http/middlewares/recovery"
+	"film-management/pkg/transport/http/response"
+	"film-management/pkg/utils"
+	httpKitTransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+const (
+	APIPath = httpCommon.APIPath + "directors/"
+)
+
+// NewHTTPHandlers is a function that returns a http.Handler that makes a set of endpoints available on predefined paths.
+func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service, cfg *config.Config, logger *zap.Logger) http.Handler {
+	options := []httpKitTransport.ServerOption{
+		httpKitTransport.ServerErrorHandler(httpTransport.NewLogErrorHandler(logger)),
+		httpKitTransport.ServerErrorEncoder(response.EncodeError),
+	}
+
+	// Handlers
+	// View all directors
+	viewAllDirectorsHandler := httpKitTransport.NewServer(
+		endpoints.ViewAllDirectorsEndpoint,
+		decodeHTTPViewAllDirectorsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// View a director
+	viewDirectorHandler := httpKitTransport.NewServer(
+		endpoints.ViewDirectorEndpoint,
+		decodeHTTPViewDirectorRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Update a director
+	updateDirectorHandler := httpKitTransport.NewServer(
+		endpoints.UpdateDirectorEndpoint,
+		decodeHTTPUpdateDirectorRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Merge directors
+	mergeDirectorsHandler := httpKitTransport.NewServer(
+		endpoints.MergeDirectorsEndpoint,
+		decodeHTTPMergeDirectorsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
+	r := mux.NewRouter()
+
+	// CORS
+	r.Use(mux.CORSMethodMiddleware(r))
+	r.Use(cors.Middleware(cfg.HTTP.CorsAllowedOrigins, logger))
+
+	// Recovery
+	r.Use(recovery.Middleware(logger))
+
+	// AUTH
+	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService, logger))
+
+	// Routes
+
+	// Directors
+	//
+	// View all directors
+	r.Handle(APIPath, viewAllDirectorsHandler).Methods(http.MethodGet)
+	// View a director
+	r.Handle(APIPath+"{id}", viewDirectorHandler).Methods(http.MethodGet)
+	// Update a director
+	r.Handle(APIPath+"{id}", updateDirectorHandler).Methods(http.MethodPut)
+	// Merge directors
+	r.Handle(APIPath+"{id}/merge", mergeDirectorsHandler).Methods(http.MethodPost)
+
+	// Set custom error handlers
+	response.SetErrorHandlers(r)
+
+	return r
+}
+
+// ViewAllDirectors godoc
+// @Summary View all directors
+// @Description View all directors with count of their films
+// @Tags Directors
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param sort query string false "sort" example(name.asc or films_count.desc)
+// @Param limit query string false "limit" example(10)
+// @Param offset query string false "offset" example(1)
+// @Param name query string false "search by part of name" example(Nolan)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewAllDirectorsResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /directors/ [get] .
+func decodeHTTPViewAllDirectorsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ViewAllDirectorsRequest
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
+	// Get limit from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("limit", r, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	// Get offset from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("offset", r, &req.Offset); err != nil {
+		return nil, err
+	}
+
+	// Get name from HTTP request
+	req.Name = r.URL.Query().Get("name")
+
+	return req, nil
+}
+
+// ViewDirector godoc
+// @Summary View a director
+// @Description View a director with filmography, newest films first
+// @Tags Directors
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Director ID"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewDirectorResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /directors/{id} [get] .
+func decodeHTTPViewDirectorRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get ID from path
+	idFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints.ViewDirectorRequest{ID: idFromPath}, nil
+}
+
+// UpdateDirector godoc
+// @Summary Rename a director
+// @Description Rename a director. Only admins can rename directors. If another director already has the name, merge directors instead.
+// @Tags Directors
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Director ID"
+// @Param form body endpoints.UpdateDirectorRequest true "Update director form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.UpdateDirectorResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /directors/{id} [put] .
+func decodeHTTPUpdateDirectorRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.UpdateDirectorRequest
+
+	// Get ID from path
+	idFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set ID, UserID and UserRole
+	reqForm.ID = idFromPath
+	reqForm.UserID = userID
+	reqForm.UserRole = userRole
+
+	return reqForm, nil
+}
+
+// MergeDirectors godoc
+// @Summary Merge directors
+// @Description Merge duplicate directors into the director from path. Films of the merged directors are moved to this director and the merged directors are deleted. Only admins can merge directors.
+// @Tags Directors
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Director ID"
+// @Param form body endpoints.MergeDirectorsRequest true "Merge directors form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.MergeDirectorsResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /directors/{id}/merge [post] .
+func decodeHTTPMergeDirectorsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.MergeDirectorsRequest
+
+	// Get ID from path
+	idFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set ID, UserID and UserRole
+	reqForm.ID = idFromPath
+	reqForm.UserID = userID
+	reqForm.UserRole = userRole
+
+	return reqForm, nil
+}
@@ -0,0 +1,332 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	modelsFilm "film-management/internal/film/domain/models"
+	"film-management/pkg/cache"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+	"time"
+)
+
+// filmsGenerationKey is a key of the counter of cached films, every change of films increments it,
+// so the values cached for the previous generation are not read anymore and expire by TTL.
+const filmsGenerationKey = "films:generation"
+
+// cachingMiddleware caches films and lists of films, it must wrap the service before logging and instrumenting.
+type cachingMiddleware struct {
+	next   Service
+	cache  cache.Cache
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// cachedFilms is a cached list of films with pagination.
+type cachedFilms struct {
+	Films      []modelsFilm.Film     `json:"films"`
+	Pagination pagination.Pagination `json:"pagination"`
+}
+
+// cachedFilmsQuery is a query of a list of films which identifies the cached list.
+type cachedFilmsQuery struct {
+	Sort      string             `json:"sort"`
+	Filter    query.Filter       `json:"filter"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+	Cursor    *pagination.Cursor `json:"cursor"`
+	WithCount bool               `json:"with_count"`
+}
+
+func NewCachingMiddleware(c cache.Cache, ttl time.Duration, logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return &cachingMiddleware{
+			next:   next,
+			cache:  c,
+			ttl:    ttl,
+			logger: logger,
+		}
+	}
+}
+
+func (c cachingMiddleware) AddFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.AddFilm(ctx, model, actor)
+}
+
+func (c cachingMiddleware) ImportFilms(ctx context.Context, rows []modelsFilm.ImportRow, dryRun bool, actor policy.Actor) ([]modelsFilm.ImportResult, error) {
+	results, err := c.next.ImportFilms(ctx, rows, dryRun, actor)
+
+	// Rows are imported one by one, so some of them may be added even on error
+	if !dryRun {
+		c.invalidate(ctx)
+	}
+
+	return results, err
+}
+
+func (c cachingMiddleware) UpdateFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.UpdateFilm(ctx, model, actor)
+}
+
+func (c cachingMiddleware) PatchFilm(ctx context.Context, patch modelsFilm.FilmPatch, actor policy.Actor) (_ modelsFilm.Film, err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.PatchFilm(ctx, patch, actor)
+}
+
+func (c cachingMiddleware) ViewFilm(ctx context.Context, filmID uuid.UUID) (modelsFilm.Film, error) {
+	generation, ok := c.generation(ctx)
+	if !ok {
+		return c.next.ViewFilm(ctx, filmID)
+	}
+
+	key := "films:" + generation + ":film:" + filmID.String()
+
+	var film modelsFilm.Film
+	if c.get(ctx, key, &film) {
+		return film, nil
+	}
+
+	film, err := c.next.ViewFilm(ctx, filmID)
+	if err != nil {
+		return film, err
+	}
+
+	// Password hash of the creator is never put in cache
+	cached := film
+	cached.Creator.Password = ""
+	c.set(ctx, key, cached)
+
+	return film, nil
+}
+
+func (c cachingMiddleware) ViewAllFilms(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Film, pagination.Pagination, error) {
+	generation, ok := c.generation(ctx)
+	if !ok {
+		return c.next.ViewAllFilms(ctx, filterSortLimit)
+	}
+
+	queryKey, err := filmsQueryKey(filterSortLimit)
+	if err != nil {
+		c.logger.Warn("cachingMiddleware.ViewAllFilms.Key", zap.Error(err))
+
+		return c.next.ViewAllFilms(ctx, filterSortLimit)
+	}
+
+	key := "films:" + generation + ":list:" + queryKey
+
+	var cached cachedFilms
+	if c.get(ctx, key, &cached) {
+		return cached.Films, cached.Pagination, nil
+	}
+
+	films, p, err := c.next.ViewAllFilms(ctx, filterSortLimit)
+	if err != nil {
+		return films, p, err
+	}
+
+	cached = cachedFilms{Films: make([]modelsFilm.Film, len(films)), Pagination: p}
+	for i, film := range films {
+		film.Creator.Password = ""
+		cached.Films[i] = film
+	}
+
+	c.set(ctx, key, cached)
+
+	return films, p, nil
+}
+
+func (c cachingMiddleware) ExportFilms(ctx context.Context, filterSortLimit query.FilterSortLimit, fn func(modelsFilm.Film) error) error {
+	return c.next.ExportFilms(ctx, filterSortLimit, fn)
+}
+
+func (c cachingMiddleware) ViewFilmFacets(ctx context.Context, filter query.Filter, facets []string) ([]modelsFilm.FacetCount, error) {
+	return c.next.ViewFilmFacets(ctx, filter, facets)
+}
+
+func (c cachingMiddleware) DeleteFilm(ctx context.Context, filmID uuid.UUID, version int, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.DeleteFilm(ctx, filmID, version, actor)
+}
+
+func (c cachingMiddleware) RateFilm(ctx context.Context, model *modelsFilm.Rating) (_ modelsFilm.Film, err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.RateFilm(ctx, model)
+}
+
+func (c cachingMiddleware) AddReview(ctx context.Context, model *modelsFilm.Review) error {
+	return c.next.AddReview(ctx, model)
+}
+
+func (c cachingMiddleware) UpdateReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) error {
+	return c.next.UpdateReview(ctx, model, actor)
+}
+
+func (c cachingMiddleware) HideReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) error {
+	return c.next.HideReview(ctx, model, actor)
+}
+
+func (c cachingMiddleware) ViewAllReviews(ctx context.Context, filmID uuid.UUID, actor policy.Actor, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Review, pagination.Pagination, error) {
+	return c.next.ViewAllReviews(ctx, filmID, actor, filterSortLimit)
+}
+
+func (c cachingMiddleware) DeleteReview(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID, actor policy.Actor) error {
+	return c.next.DeleteReview(ctx, filmID, reviewID, actor)
+}
+
+func (c cachingMiddleware) AddGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) error {
+	return c.next.AddGenre(ctx, model, actor)
+}
+
+func (c cachingMiddleware) UpdateGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.UpdateGenre(ctx, model, actor)
+}
+
+func (c cachingMiddleware) DeleteGenre(ctx context.Context, genreID uint, cascade bool, actor policy.Actor) (err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.DeleteGenre(ctx, genreID, cascade, actor)
+}
+
+func (c cachingMiddleware) ViewAllGenres(ctx context.Context, actor policy.Actor, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Genre, pagination.Pagination, error) {
+	return c.next.ViewAllGenres(ctx, actor, filterSortLimit)
+}
+
+func (c cachingMiddleware) ViewTrash(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Film, pagination.Pagination, error) {
+	return c.next.ViewTrash(ctx, userID, filterSortLimit)
+}
+
+func (c cachingMiddleware) RestoreFilm(ctx context.Context, filmID uuid.UUID, actor policy.Actor) (_ modelsFilm.Film, err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.RestoreFilm(ctx, filmID, actor)
+}
+
+func (c cachingMiddleware) PurgeTrash(ctx context.Context) (int64, error) {
+	return c.next.PurgeTrash(ctx)
+}
+
+func (c cachingMiddleware) ViewAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Revision, pagination.Pagination, error) {
+	return c.next.ViewAllRevisions(ctx, filmID, filterSortLimit)
+}
+
+func (c cachingMiddleware) DiffRevisions(ctx context.Context, filmID uuid.UUID, fromNumber int, toNumber int) ([]modelsFilm.RevisionChange, error) {
+	return c.next.DiffRevisions(ctx, filmID, fromNumber, toNumber)
+}
+
+func (c cachingMiddleware) RollbackFilm(ctx context.Context, filmID uuid.UUID, number int, actor policy.Actor) (_ modelsFilm.Film, err error) {
+	defer c.invalidateOnSuccess(ctx, &err)
+
+	return c.next.RollbackFilm(ctx, filmID, number, actor)
+}
+
+// generation is a method to get the current generation of cached films, cache is skipped when it is unavailable.
+func (c cachingMiddleware) generation(ctx context.Context) (string, bool) {
+	generation, err := c.cache.Get(ctx, filmsGenerationKey)
+	if err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			return "0", true
+		}
+
+		c.logger.Warn("cachingMiddleware.generation.Get", zap.Error(err))
+
+		return "", false
+	}
+
+	return string(generation), true
+}
+
+// get is a method to read a cached value of the key into the model.
+func (c cachingMiddleware) get(ctx context.Context, key string, model interface{}) bool {
+	data, err := c.cache.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, cache.ErrNotFound) {
+			c.logger.Warn("cachingMiddleware.get.Get", zap.String("key", key), zap.Error(err))
+		}
+
+		return false
+	}
+
+	if errUnmarshal := jsoniter.Unmarshal(data, model); errUnmarshal != nil {
+		c.logger.Warn("cachingMiddleware.get.Unmarshal", zap.String("key", key), zap.Error(errUnmarshal))
+
+		return false
+	}
+
+	return true
+}
+
+// set is a method to cache the model for TTL.
+func (c cachingMiddleware) set(ctx context.Context, key string, model interface{}) {
+	data, err := jsoniter.Marshal(model)
+	if err != nil {
+		c.logger.Warn("cachingMiddleware.set.Marshal", zap.String("key", key), zap.Error(err))
+
+		return
+	}
+
+	if errSet := c.cache.Set(ctx, key, data, c.ttl); errSet != nil {
+		c.logger.Warn("cachingMiddleware.set.Set", zap.String("key", key), zap.Error(errSet))
+	}
+}
+
+// invalidateOnSuccess is a method to invalidate cached films when the change did not fail.
+func (c cachingMiddleware) invalidateOnSuccess(ctx context.Context, err *error) {
+	if *err == nil {
+		c.invalidate(ctx)
+	}
+}
+
+// invalidate is a method to start a new generation of cached films.
+func (c cachingMiddleware) invalidate(ctx context.Context) {
+	if err := InvalidateFilmsCache(ctx, c.cache); err != nil {
+		c.logger.Error("cachingMiddleware.invalidate.Incr", zap.Error(err))
+	}
+}
+
+// InvalidateFilmsCache is a function to start a new generation of cached films, so films cached before are not read anymore.
+// Services which change data embedded into films, e.g. names of directors, call it too.
+func InvalidateFilmsCache(ctx context.Context, c cache.Cache) error {
+	_, err := c.Incr(ctx, filmsGenerationKey)
+
+	return err
+}
+
+// filmsQueryKey is a function to get a hash of the query of a list of films.
+func filmsQueryKey(filterSortLimit query.FilterSortLimit) (string, error) {
+	q := cachedFilmsQuery{
+		Filter:    filterSortLimit.Filter,
+		Limit:     filterSortLimit.Limit,
+		Offset:    filterSortLimit.Offset,
+		Cursor:    filterSortLimit.Cursor,
+		WithCount: filterSortLimit.WithCount,
+	}
+
+	if filterSortLimit.Sort != nil {
+		q.Sort = filterSortLimit.Sort.Field() + "." + filterSortLimit.Sort.Order()
+	}
+
+	// Keys of the filter are sorted, so equal queries have equal hashes
+	data, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(q)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:]), nil
+}
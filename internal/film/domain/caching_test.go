@@ -0,0 +1,221 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/cache"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+var errMutation = errors.New("mutation failed")
+
+// readsService is a service which counts reads of films and fails mutations with err.
+type readsService struct {
+	domain.Service
+	reads int
+	err   error
+}
+
+func (s *readsService) ViewFilm(_ context.Context, filmID uuid.UUID) (models.Film, error) {
+	s.reads++
+
+	return models.Film{UUID: filmID, Title: "Film 1"}, nil
+}
+
+func (s *readsService) ViewAllFilms(_ context.Context, _ query.FilterSortLimit) ([]models.Film, pagination.Pagination, error) {
+	s.reads++
+
+	return []models.Film{{Title: "Film 1"}}, pagination.Pagination{}, nil
+}
+
+func (s *readsService) AddFilm(context.Context, *models.Film, policy.Actor) error {
+	return s.err
+}
+
+func (s *readsService) ImportFilms(context.Context, []models.ImportRow, bool, policy.Actor) ([]models.ImportResult, error) {
+	return nil, s.err
+}
+
+func (s *readsService) UpdateFilm(context.Context, *models.Film, policy.Actor) error {
+	return s.err
+}
+
+func (s *readsService) PatchFilm(context.Context, models.FilmPatch, policy.Actor) (models.Film, error) {
+	return models.Film{}, s.err
+}
+
+func (s *readsService) DeleteFilm(context.Context, uuid.UUID, int, policy.Actor) error {
+	return s.err
+}
+
+func (s *readsService) RestoreFilm(context.Context, uuid.UUID, policy.Actor) (models.Film, error) {
+	return models.Film{}, s.err
+}
+
+func (s *readsService) RollbackFilm(context.Context, uuid.UUID, int, policy.Actor) (models.Film, error) {
+	return models.Film{}, s.err
+}
+
+func (s *readsService) RateFilm(context.Context, *models.Rating) (models.Film, error) {
+	return models.Film{}, s.err
+}
+
+func (s *readsService) UpdateGenre(context.Context, *models.Genre, policy.Actor) error {
+	return s.err
+}
+
+func (s *readsService) DeleteGenre(context.Context, uint, bool, policy.Actor) error {
+	return s.err
+}
+
+func TestCachingMiddleware_Invalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	actor := policy.NewActor(uuid.New(), policy.RoleAdmin)
+	filmID := uuid.New()
+
+	type mutation func(s domain.Service) error
+
+	tests := []struct {
+		name        string
+		mutation    mutation
+		err         error
+		invalidated bool
+	}{
+		{
+			name:        "AddFilm",
+			mutation:    func(s domain.Service) error { return s.AddFilm(ctx, &models.Film{}, actor) },
+			invalidated: true,
+		},
+		{
+			name: "ImportFilms",
+			mutation: func(s domain.Service) error {
+				_, err := s.ImportFilms(ctx, []models.ImportRow{{}}, false, actor)
+				return err
+			},
+			invalidated: true,
+		},
+		{
+			name: "Failed ImportFilms",
+			mutation: func(s domain.Service) error {
+				_, err := s.ImportFilms(ctx, []models.ImportRow{{}}, false, actor)
+				return err
+			},
+			err:         errMutation,
+			invalidated: true,
+		},
+		{
+			name: "Dry run of ImportFilms",
+			mutation: func(s domain.Service) error {
+				_, err := s.ImportFilms(ctx, []models.ImportRow{{}}, true, actor)
+				return err
+			},
+		},
+		{
+			name:        "UpdateFilm",
+			mutation:    func(s domain.Service) error { return s.UpdateFilm(ctx, &models.Film{UUID: filmID}, actor) },
+			invalidated: true,
+		},
+		{
+			name:     "Failed UpdateFilm",
+			mutation: func(s domain.Service) error { return s.UpdateFilm(ctx, &models.Film{UUID: filmID}, actor) },
+			err:      errMutation,
+		},
+		{
+			name: "PatchFilm",
+			mutation: func(s domain.Service) error {
+				_, err := s.PatchFilm(ctx, models.FilmPatch{}, actor)
+				return err
+			},
+			invalidated: true,
+		},
+		{
+			name:        "DeleteFilm",
+			mutation:    func(s domain.Service) error { return s.DeleteFilm(ctx, filmID, 1, actor) },
+			invalidated: true,
+		},
+		{
+			name: "RestoreFilm",
+			mutation: func(s domain.Service) error {
+				_, err := s.RestoreFilm(ctx, filmID, actor)
+				return err
+			},
+			invalidated: true,
+		},
+		{
+			name: "RollbackFilm",
+			mutation: func(s domain.Service) error {
+				_, err := s.RollbackFilm(ctx, filmID, 1, actor)
+				return err
+			},
+			invalidated: true,
+		},
+		{
+			name: "RateFilm",
+			mutation: func(s domain.Service) error {
+				_, err := s.RateFilm(ctx, &models.Rating{FilmID: filmID, Score: 7})
+				return err
+			},
+			invalidated: true,
+		},
+		{
+			name:        "UpdateGenre",
+			mutation:    func(s domain.Service) error { return s.UpdateGenre(ctx, &models.Genre{ID: 1}, actor) },
+			invalidated: true,
+		},
+		{
+			name:        "DeleteGenre",
+			mutation:    func(s domain.Service) error { return s.DeleteGenre(ctx, 1, true, actor) },
+			invalidated: true,
+		},
+		{
+			name:     "Failed DeleteGenre",
+			mutation: func(s domain.Service) error { return s.DeleteGenre(ctx, 1, true, actor) },
+			err:      errMutation,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			requireAssert := require.New(t)
+
+			next := &readsService{err: test.err}
+			service := domain.NewCachingMiddleware(cache.NewLRU(100), time.Minute, zap.NewNop())(next)
+
+			read := func() {
+				_, err := service.ViewFilm(ctx, filmID)
+				requireAssert.NoError(err)
+
+				_, _, err = service.ViewAllFilms(ctx, query.FilterSortLimit{})
+				requireAssert.NoError(err)
+			}
+
+			read()
+			read()
+			requireAssert.Equal(2, next.reads)
+
+			requireAssert.ErrorIs(test.mutation(service), test.err)
+
+			read()
+
+			if test.invalidated {
+				requireAssert.Equal(4, next.reads)
+			} else {
+				requireAssert.Equal(2, next.reads)
+			}
+		})
+	}
+}
@@ -3,20 +3,63 @@ package domain
 import "errors"
 
 var (
-	ErrFilmCreate           = errors.New("failed to create film")
-	ErrFilmUpdate           = errors.New("failed to update film")
-	ErrFilmDelete           = errors.New("failed to delete film")
-	ErrFilmFind             = errors.New("failed to find film")
-	ErrFilmFindAll          = errors.New("failed to find all films")
-	ErrFilmNotPermission    = errors.New("access denied, you do not have permission to edit this film")
-	ErrFilmNotFound         = errors.New("film not found")
-	ErrFilmExistsWithTitle  = errors.New("film already exists with the same title")
-	ErrFilmCheckExistence   = errors.New("failed to check film existence")
-	ErrFilmCreateCast       = errors.New("failed to create cast")
-	ErrFilmGetCastsByNames  = errors.New("failed to get casts by names")
-	ErrFilmGetGenresByNames = errors.New("failed to get genres by names")
-	ErrFilmFindGenres       = errors.New("failed to find genres")
-	ErrFilmGenresNotFound   = errors.New("genres do not exist in the database")
-	ErrFilmFilterWrong      = errors.New("filter wrong")
-	ErrFilmUnknownField     = errors.New("unknown field")
+	ErrFilmCreate              = errors.New("failed to create film")
+	ErrFilmUpdate              = errors.New("failed to update film")
+	ErrFilmDelete              = errors.New("failed to delete film")
+	ErrFilmFind                = errors.New("failed to find film")
+	ErrFilmFacets              = errors.New("failed to count facets of films")
+	ErrFilmFindAll             = errors.New("failed to find all films")
+	ErrFilmNotPermission       = errors.New("access denied, you do not have permission to edit this film")
+	ErrFilmNotCreatePermission = errors.New("access denied, you do not have permission to add films")
+	ErrFilmNotFound            = errors.New("film not found")
+	ErrFilmExistsWithTitle     = errors.New("film already exists with the same title")
+	ErrFilmCheckExistence      = errors.New("failed to check film existence")
+	ErrFilmCreateCast          = errors.New("failed to create cast")
+	ErrFilmGetCastsByNames     = errors.New("failed to get casts by names")
+	ErrFilmGetGenresByNames    = errors.New("failed to get genres by names")
+	ErrFilmFindGenres          = errors.New("failed to find genres")
+	ErrFilmGenresNotFound      = errors.New("genres do not exist in the database")
+	ErrFilmFilterWrong         = errors.New("filter wrong")
+	ErrFilmUnknownField        = errors.New("unknown field")
+	ErrFilmCursorSort          = errors.New("cursor is not available for this sort")
+	ErrFilmRate                = errors.New("failed to rate film")
+	ErrFilmRestore             = errors.New("failed to restore film")
+	ErrFilmFindTrash           = errors.New("failed to find deleted films")
+	ErrFilmNotFoundInTrash     = errors.New("film not found in trash")
+	ErrFilmPurge               = errors.New("failed to purge deleted films")
+	ErrFilmVersionMismatch     = errors.New("film was changed by another request, view it again to get the current etag")
+	ErrFilmVersionRequired     = errors.New("if-match header with etag of the film is required")
+	ErrFilmPatchWrong          = errors.New("patch sets unknown fields or fields of wrong type")
+
+	ErrImportTooManyRows  = errors.New("file has too many rows")
+	ErrImportHeader       = errors.New("csv file must start with a header of columns")
+	ErrImportColumn       = errors.New("csv file has an unknown column")
+	ErrImportRowWrongJSON = errors.New("row is not a valid json object of film")
+
+	ErrRevisionCreate             = errors.New("failed to create revision")
+	ErrRevisionFind               = errors.New("failed to find revision")
+	ErrRevisionFindAll            = errors.New("failed to find all revisions")
+	ErrRevisionNotFound           = errors.New("revision not found")
+	ErrRevisionWrong              = errors.New("revision has wrong data")
+	ErrRevisionNotRollbackAllowed = errors.New("access denied, you do not have permission to rollback films")
+	ErrRevisionRollback           = errors.New("failed to rollback film to revision")
+
+	ErrReviewCreate                = errors.New("failed to create review")
+	ErrReviewUpdate                = errors.New("failed to update review")
+	ErrReviewDelete                = errors.New("failed to delete review")
+	ErrReviewFind                  = errors.New("failed to find review")
+	ErrReviewFindAll               = errors.New("failed to find all reviews")
+	ErrReviewNotFound              = errors.New("review not found")
+	ErrReviewNotPermission         = errors.New("access denied, you do not have permission to edit this review")
+	ErrReviewNotModeratePermission = errors.New("access denied, you do not have permission to moderate reviews of this film")
+
+	ErrGenreCreate        = errors.New("failed to create genre")
+	ErrGenreUpdate        = errors.New("failed to update genre")
+	ErrGenreDelete        = errors.New("failed to delete genre")
+	ErrGenreFind          = errors.New("failed to find genre")
+	ErrGenreFindAll       = errors.New("failed to find all genres")
+	ErrGenreNotFound      = errors.New("genre not found")
+	ErrGenreExists        = errors.New("genre already exists with the same name")
+	ErrGenreInUse         = errors.New("genre is used by films, delete it with cascade to remove it from the films")
+	ErrGenreNotPermission = errors.New("access denied, you do not have permission to manage genres")
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	modelsFilm "film-management/internal/film/domain/models"
 	"film-management/pkg/instrumenting"
+	"film-management/pkg/policy"
 	"film-management/pkg/query"
 	"film-management/pkg/query/pagination"
 	"github.com/go-kit/kit/metrics"
@@ -29,24 +30,44 @@ func NewInstrumentingMiddleware(requestCount metrics.Counter,
 	}
 }
 
-func (i instrumentingMiddleware) AddFilm(ctx context.Context, model *modelsFilm.Film) (err error) {
+func (i instrumentingMiddleware) AddFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "AddFilm", "error", instrumenting.PrintErr(err)}
 		i.requestCount.With(lvs...).Add(1)
 		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return i.next.AddFilm(ctx, model)
+	return i.next.AddFilm(ctx, model, actor)
 }
 
-func (i instrumentingMiddleware) UpdateFilm(ctx context.Context, model *modelsFilm.Film) (err error) {
+func (i instrumentingMiddleware) ImportFilms(ctx context.Context, rows []modelsFilm.ImportRow, dryRun bool, actor policy.Actor) (results []modelsFilm.ImportResult, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ImportFilms", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ImportFilms(ctx, rows, dryRun, actor)
+}
+
+func (i instrumentingMiddleware) UpdateFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "UpdateFilm", "error", instrumenting.PrintErr(err)}
 		i.requestCount.With(lvs...).Add(1)
 		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return i.next.UpdateFilm(ctx, model)
+	return i.next.UpdateFilm(ctx, model, actor)
+}
+
+func (i instrumentingMiddleware) PatchFilm(ctx context.Context, patch modelsFilm.FilmPatch, actor policy.Actor) (model modelsFilm.Film, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "PatchFilm", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.PatchFilm(ctx, patch, actor)
 }
 
 func (i instrumentingMiddleware) ViewFilm(ctx context.Context, filmID uuid.UUID) (model modelsFilm.Film, err error) {
@@ -69,12 +90,192 @@ func (i instrumentingMiddleware) ViewAllFilms(ctx context.Context, filterSortPag
 	return i.next.ViewAllFilms(ctx, filterSortPagination)
 }
 
-func (i instrumentingMiddleware) DeleteFilm(ctx context.Context, filmID uuid.UUID, userID uuid.UUID) (err error) {
+func (i instrumentingMiddleware) ExportFilms(ctx context.Context, filterSortLimit query.FilterSortLimit, fn func(modelsFilm.Film) error) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ExportFilms", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ExportFilms(ctx, filterSortLimit, fn)
+}
+
+func (i instrumentingMiddleware) ViewFilmFacets(ctx context.Context, filter query.Filter, facets []string) (counts []modelsFilm.FacetCount, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewFilmFacets", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewFilmFacets(ctx, filter, facets)
+}
+
+func (i instrumentingMiddleware) DeleteFilm(ctx context.Context, filmID uuid.UUID, version int, actor policy.Actor) (err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "DeleteFilm", "error", instrumenting.PrintErr(err)}
 		i.requestCount.With(lvs...).Add(1)
 		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return i.next.DeleteFilm(ctx, filmID, userID)
+	return i.next.DeleteFilm(ctx, filmID, version, actor)
+}
+
+func (i instrumentingMiddleware) RateFilm(ctx context.Context, model *modelsFilm.Rating) (film modelsFilm.Film, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "RateFilm", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.RateFilm(ctx, model)
+}
+
+func (i instrumentingMiddleware) AddReview(ctx context.Context, model *modelsFilm.Review) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "AddReview", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.AddReview(ctx, model)
+}
+
+func (i instrumentingMiddleware) UpdateReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "UpdateReview", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.UpdateReview(ctx, model, actor)
+}
+
+func (i instrumentingMiddleware) HideReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "HideReview", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.HideReview(ctx, model, actor)
+}
+
+func (i instrumentingMiddleware) ViewAllReviews(ctx context.Context, filmID uuid.UUID, actor policy.Actor, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Review, p pagination.Pagination, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewAllReviews", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewAllReviews(ctx, filmID, actor, filterSortLimit)
+}
+
+func (i instrumentingMiddleware) DeleteReview(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "DeleteReview", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.DeleteReview(ctx, filmID, reviewID, actor)
+}
+
+func (i instrumentingMiddleware) AddGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "AddGenre", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.AddGenre(ctx, model, actor)
+}
+
+func (i instrumentingMiddleware) UpdateGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "UpdateGenre", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.UpdateGenre(ctx, model, actor)
+}
+
+func (i instrumentingMiddleware) DeleteGenre(ctx context.Context, genreID uint, cascade bool, actor policy.Actor) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "DeleteGenre", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.DeleteGenre(ctx, genreID, cascade, actor)
+}
+
+func (i instrumentingMiddleware) ViewAllGenres(ctx context.Context, actor policy.Actor, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Genre, p pagination.Pagination, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewAllGenres", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewAllGenres(ctx, actor, filterSortLimit)
+}
+
+func (i instrumentingMiddleware) ViewTrash(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Film, p pagination.Pagination, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewTrash", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewTrash(ctx, userID, filterSortLimit)
+}
+
+func (i instrumentingMiddleware) RestoreFilm(ctx context.Context, filmID uuid.UUID, actor policy.Actor) (model modelsFilm.Film, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "RestoreFilm", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.RestoreFilm(ctx, filmID, actor)
+}
+
+func (i instrumentingMiddleware) PurgeTrash(ctx context.Context) (count int64, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "PurgeTrash", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.PurgeTrash(ctx)
+}
+
+func (i instrumentingMiddleware) ViewAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Revision, p pagination.Pagination, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewAllRevisions", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewAllRevisions(ctx, filmID, filterSortLimit)
+}
+
+func (i instrumentingMiddleware) DiffRevisions(ctx context.Context, filmID uuid.UUID, fromNumber int, toNumber int) (changes []modelsFilm.RevisionChange, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "DiffRevisions", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.DiffRevisions(ctx, filmID, fromNumber, toNumber)
+}
+
+func (i instrumentingMiddleware) RollbackFilm(ctx context.Context, filmID uuid.UUID, number int, actor policy.Actor) (model modelsFilm.Film, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "RollbackFilm", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.RollbackFilm(ctx, filmID, number, actor)
 }
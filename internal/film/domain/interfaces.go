@@ -3,20 +3,42 @@ package domain
 import (
 	"context"
 	"film-management/internal/film/domain/models"
+	"film-management/pkg/policy"
 	"film-management/pkg/query"
 	"film-management/pkg/query/pagination"
 	"github.com/google/uuid"
+	"time"
 )
 
 // Service is an interface for domain service.
 //
 //go:generate mockgen -source=interfaces.go -destination=mocks/mock_service.go -package=mocks
 type Service interface {
-	AddFilm(ctx context.Context, model *models.Film) error
-	UpdateFilm(ctx context.Context, model *models.Film) error
+	AddFilm(ctx context.Context, model *models.Film, actor policy.Actor) error
+	ImportFilms(ctx context.Context, rows []models.ImportRow, dryRun bool, actor policy.Actor) ([]models.ImportResult, error)
+	UpdateFilm(ctx context.Context, model *models.Film, actor policy.Actor) error
+	PatchFilm(ctx context.Context, patch models.FilmPatch, actor policy.Actor) (models.Film, error)
 	ViewFilm(ctx context.Context, filmID uuid.UUID) (models.Film, error)
 	ViewAllFilms(ctx context.Context, filterSortPagination query.FilterSortLimit) ([]models.Film, pagination.Pagination, error)
-	DeleteFilm(ctx context.Context, filmID uuid.UUID, userID uuid.UUID) error
+	ExportFilms(ctx context.Context, filterSortLimit query.FilterSortLimit, fn func(models.Film) error) error
+	ViewFilmFacets(ctx context.Context, filter query.Filter, facets []string) ([]models.FacetCount, error)
+	DeleteFilm(ctx context.Context, filmID uuid.UUID, version int, actor policy.Actor) error
+	ViewTrash(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error)
+	RestoreFilm(ctx context.Context, filmID uuid.UUID, actor policy.Actor) (models.Film, error)
+	PurgeTrash(ctx context.Context) (int64, error)
+	ViewAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Revision, pagination.Pagination, error)
+	DiffRevisions(ctx context.Context, filmID uuid.UUID, fromNumber int, toNumber int) ([]models.RevisionChange, error)
+	RollbackFilm(ctx context.Context, filmID uuid.UUID, number int, actor policy.Actor) (models.Film, error)
+	RateFilm(ctx context.Context, model *models.Rating) (models.Film, error)
+	AddReview(ctx context.Context, model *models.Review) error
+	UpdateReview(ctx context.Context, model *models.Review, actor policy.Actor) error
+	HideReview(ctx context.Context, model *models.Review, actor policy.Actor) error
+	ViewAllReviews(ctx context.Context, filmID uuid.UUID, actor policy.Actor, filterSortLimit query.FilterSortLimit) ([]models.Review, pagination.Pagination, error)
+	DeleteReview(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID, actor policy.Actor) error
+	AddGenre(ctx context.Context, model *models.Genre, actor policy.Actor) error
+	UpdateGenre(ctx context.Context, model *models.Genre, actor policy.Actor) error
+	DeleteGenre(ctx context.Context, genreID uint, cascade bool, actor policy.Actor) error
+	ViewAllGenres(ctx context.Context, actor policy.Actor, filterSortLimit query.FilterSortLimit) ([]models.Genre, pagination.Pagination, error)
 }
 
 // Repository is a repository for domain service
@@ -24,16 +46,24 @@ type Repository interface {
 	FilmRepository
 	GenreRepository
 	CastRepository
+	RatingRepository
+	ReviewRepository
+	RevisionRepository
 }
 
 // FilmRepository is a repository for film.
 type FilmRepository interface {
-	CreateFilm(ctx context.Context, model *models.Film) error
-	UpdateFilm(ctx context.Context, model *models.Film) error
+	CreateFilm(ctx context.Context, model *models.Film, revision *models.Revision) error
+	UpdateFilm(ctx context.Context, model *models.Film, revision *models.Revision) error
 	FindOneFilmByUUID(ctx context.Context, uuid uuid.UUID) (models.Film, error)
 	FindOneFilmForViewByUUID(ctx context.Context, uuid uuid.UUID) (models.Film, error)
 	FindAllFilms(ctx context.Context, filterSortPagination query.FilterSortLimit) ([]models.Film, pagination.Pagination, error)
-	DeleteFilm(ctx context.Context, uuid uuid.UUID) error
+	CountFilmFacets(ctx context.Context, filter query.Filter, facets []string) ([]models.FacetCount, error)
+	DeleteFilm(ctx context.Context, uuid uuid.UUID, version int, revision *models.Revision) error
+	FindOneDeletedFilmByUUID(ctx context.Context, uuid uuid.UUID) (models.Film, error)
+	FindAllDeletedFilms(ctx context.Context, creatorID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error)
+	RestoreFilm(ctx context.Context, uuid uuid.UUID, revision *models.Revision) error
+	PurgeDeletedFilms(ctx context.Context, deletedBefore time.Time) (int64, error)
 	FilmExistsWithTitle(ctx context.Context, title string, filmID uuid.UUID, operation models.Operation) error
 }
 
@@ -41,6 +71,10 @@ type FilmRepository interface {
 type GenreRepository interface {
 	CreateGenre(ctx context.Context, model *models.Genre) (*models.Genre, error)
 	GetGenresByNames(ctx context.Context, names []string) ([]models.Genre, error)
+	UpdateGenre(ctx context.Context, model *models.Genre) error
+	FindOneGenre(ctx context.Context, genreID uint) (models.Genre, error)
+	FindAllGenres(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Genre, pagination.Pagination, error)
+	DeleteGenre(ctx context.Context, genreID uint) error
 }
 
 // CastRepository is a repository for cast.
@@ -48,3 +82,23 @@ type CastRepository interface {
 	CreateCast(ctx context.Context, model *models.Cast) (*models.Cast, error)
 	GetCastsByNames(ctx context.Context, names []string) ([]models.Cast, error)
 }
+
+// RatingRepository is a repository for rating.
+type RatingRepository interface {
+	SaveRating(ctx context.Context, model *models.Rating) error
+}
+
+// ReviewRepository is a repository for review.
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, model *models.Review) error
+	UpdateReview(ctx context.Context, model *models.Review) error
+	FindOneReviewByUUID(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID) (models.Review, error)
+	FindAllReviews(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Review, pagination.Pagination, error)
+	DeleteReview(ctx context.Context, reviewID uuid.UUID) error
+}
+
+// RevisionRepository is a repository for film revision.
+type RevisionRepository interface {
+	FindAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Revision, pagination.Pagination, error)
+	FindOneRevision(ctx context.Context, filmID uuid.UUID, number int) (models.Revision, error)
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	modelsFilm "film-management/internal/film/domain/models"
+	"film-management/pkg/policy"
 	"film-management/pkg/query"
 	"film-management/pkg/query/pagination"
 	"github.com/google/uuid"
@@ -23,26 +24,55 @@ func NewLoggingMiddleware(logger *zap.Logger) Middleware {
 	}
 }
 
-func (l loggingMiddleware) AddFilm(ctx context.Context, model *modelsFilm.Film) (err error) {
+func (l loggingMiddleware) AddFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) (err error) {
 	defer func() {
 		l.logger.With(zap.String("method", "AddFilm")).
 			Debug("domain",
 				zap.Any("film", model),
+				zap.Any("actor", actor),
 				zap.Error(err))
 	}()
 
-	return l.next.AddFilm(ctx, model)
+	return l.next.AddFilm(ctx, model, actor)
 }
 
-func (l loggingMiddleware) UpdateFilm(ctx context.Context, model *modelsFilm.Film) (err error) {
+func (l loggingMiddleware) ImportFilms(ctx context.Context, rows []modelsFilm.ImportRow, dryRun bool, actor policy.Actor) (results []modelsFilm.ImportResult, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ImportFilms")).
+			Debug("domain",
+				zap.Int("rows", len(rows)),
+				zap.Bool("dryRun", dryRun),
+				zap.Any("actor", actor),
+				zap.Int("results", len(results)),
+				zap.Error(err))
+	}()
+
+	return l.next.ImportFilms(ctx, rows, dryRun, actor)
+}
+
+func (l loggingMiddleware) UpdateFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) (err error) {
 	defer func() {
 		l.logger.With(zap.String("method", "UpdateFilm")).
 			Debug("domain",
 				zap.Any("film", model),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.UpdateFilm(ctx, model, actor)
+}
+
+func (l loggingMiddleware) PatchFilm(ctx context.Context, patch modelsFilm.FilmPatch, actor policy.Actor) (model modelsFilm.Film, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "PatchFilm")).
+			Debug("domain",
+				zap.Any("patch", patch),
+				zap.Any("actor", actor),
+				zap.Int("version", model.Version),
 				zap.Error(err))
 	}()
 
-	return l.next.UpdateFilm(ctx, model)
+	return l.next.PatchFilm(ctx, patch, actor)
 }
 
 func (l loggingMiddleware) ViewFilm(ctx context.Context, filmID uuid.UUID) (model modelsFilm.Film, err error) {
@@ -73,14 +103,262 @@ func (l loggingMiddleware) ViewAllFilms(ctx context.Context, filterSortLimit que
 	return l.next.ViewAllFilms(ctx, filterSortLimit)
 }
 
-func (l loggingMiddleware) DeleteFilm(ctx context.Context, filmID uuid.UUID, userID uuid.UUID) (err error) {
+func (l loggingMiddleware) ExportFilms(ctx context.Context, filterSortLimit query.FilterSortLimit, fn func(modelsFilm.Film) error) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ExportFilms")).
+			Debug("domain",
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Error(err))
+	}()
+
+	return l.next.ExportFilms(ctx, filterSortLimit, fn)
+}
+
+func (l loggingMiddleware) ViewFilmFacets(ctx context.Context, filter query.Filter, facets []string) (counts []modelsFilm.FacetCount, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewFilmFacets")).
+			Debug("domain",
+				zap.Strings("facets", facets),
+				zap.Int("counts", len(counts)),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewFilmFacets(ctx, filter, facets)
+}
+
+func (l loggingMiddleware) DeleteFilm(ctx context.Context, filmID uuid.UUID, version int, actor policy.Actor) (err error) {
 	defer func() {
 		l.logger.With(zap.String("method", "DeleteFilm")).
 			Debug("domain",
 				zap.Any("filmID", filmID),
-				zap.Any("userID", userID),
+				zap.Int("version", version),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.DeleteFilm(ctx, filmID, version, actor)
+}
+
+func (l loggingMiddleware) RateFilm(ctx context.Context, model *modelsFilm.Rating) (film modelsFilm.Film, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "RateFilm")).
+			Debug("domain",
+				zap.Any("rating", model),
+				zap.Float64("film_rating", film.Rating),
+				zap.Int64("film_rating_count", film.RatingCount),
+				zap.Error(err))
+	}()
+
+	return l.next.RateFilm(ctx, model)
+}
+
+func (l loggingMiddleware) AddReview(ctx context.Context, model *modelsFilm.Review) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "AddReview")).
+			Debug("domain",
+				zap.Any("review", model),
+				zap.Error(err))
+	}()
+
+	return l.next.AddReview(ctx, model)
+}
+
+func (l loggingMiddleware) UpdateReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "UpdateReview")).
+			Debug("domain",
+				zap.Any("review", model),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.UpdateReview(ctx, model, actor)
+}
+
+func (l loggingMiddleware) HideReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "HideReview")).
+			Debug("domain",
+				zap.Any("review", model),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.HideReview(ctx, model, actor)
+}
+
+func (l loggingMiddleware) ViewAllReviews(ctx context.Context, filmID uuid.UUID, actor policy.Actor, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Review, p pagination.Pagination, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewAllReviews")).
+			Debug("domain",
+				zap.Any("filmID", filmID),
+				zap.Any("actor", actor),
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Int("offset", filterSortLimit.Offset),
+				zap.Int("page", p.Page),
+				zap.Int("page-size", p.PageSize),
+				zap.Int("total-count", p.TotalCount),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewAllReviews(ctx, filmID, actor, filterSortLimit)
+}
+
+func (l loggingMiddleware) DeleteReview(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "DeleteReview")).
+			Debug("domain",
+				zap.Any("filmID", filmID),
+				zap.Any("reviewID", reviewID),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.DeleteReview(ctx, filmID, reviewID, actor)
+}
+
+func (l loggingMiddleware) AddGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "AddGenre")).
+			Debug("domain",
+				zap.String("name", model.Name),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.AddGenre(ctx, model, actor)
+}
+
+func (l loggingMiddleware) UpdateGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "UpdateGenre")).
+			Debug("domain",
+				zap.Uint("genreID", model.ID),
+				zap.String("name", model.Name),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.UpdateGenre(ctx, model, actor)
+}
+
+func (l loggingMiddleware) DeleteGenre(ctx context.Context, genreID uint, cascade bool, actor policy.Actor) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "DeleteGenre")).
+			Debug("domain",
+				zap.Uint("genreID", genreID),
+				zap.Bool("cascade", cascade),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.DeleteGenre(ctx, genreID, cascade, actor)
+}
+
+func (l loggingMiddleware) ViewAllGenres(ctx context.Context, actor policy.Actor, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Genre, p pagination.Pagination, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewAllGenres")).
+			Debug("domain",
+				zap.Any("actor", actor),
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Int("offset", filterSortLimit.Offset),
+				zap.Int("page", p.Page),
+				zap.Int("page-size", p.PageSize),
+				zap.Int("total-count", p.TotalCount),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewAllGenres(ctx, actor, filterSortLimit)
+}
+
+func (l loggingMiddleware) ViewTrash(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Film, p pagination.Pagination, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewTrash")).
+			Debug("domain",
+				zap.String("userID", userID.String()),
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Int("offset", filterSortLimit.Offset),
+				zap.Int("page", p.Page),
+				zap.Int("page-size", p.PageSize),
+				zap.Int("total-count", p.TotalCount),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewTrash(ctx, userID, filterSortLimit)
+}
+
+func (l loggingMiddleware) RestoreFilm(ctx context.Context, filmID uuid.UUID, actor policy.Actor) (model modelsFilm.Film, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "RestoreFilm")).
+			Debug("domain",
+				zap.String("filmID", filmID.String()),
+				zap.Any("actor", actor),
+				zap.Error(err))
+	}()
+
+	return l.next.RestoreFilm(ctx, filmID, actor)
+}
+
+func (l loggingMiddleware) PurgeTrash(ctx context.Context) (count int64, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "PurgeTrash")).
+			Debug("domain",
+				zap.Int64("count", count),
+				zap.Error(err))
+	}()
+
+	return l.next.PurgeTrash(ctx)
+}
+
+func (l loggingMiddleware) ViewAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) (models []modelsFilm.Revision, p pagination.Pagination, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewAllRevisions")).
+			Debug("domain",
+				zap.String("filmID", filmID.String()),
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Int("offset", filterSortLimit.Offset),
+				zap.Int("page", p.Page),
+				zap.Int("page-size", p.PageSize),
+				zap.Int("total-count", p.TotalCount),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewAllRevisions(ctx, filmID, filterSortLimit)
+}
+
+func (l loggingMiddleware) DiffRevisions(ctx context.Context, filmID uuid.UUID, fromNumber int, toNumber int) (changes []modelsFilm.RevisionChange, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "DiffRevisions")).
+			Debug("domain",
+				zap.String("filmID", filmID.String()),
+				zap.Int("from", fromNumber),
+				zap.Int("to", toNumber),
+				zap.Int("changes", len(changes)),
+				zap.Error(err))
+	}()
+
+	return l.next.DiffRevisions(ctx, filmID, fromNumber, toNumber)
+}
+
+func (l loggingMiddleware) RollbackFilm(ctx context.Context, filmID uuid.UUID, number int, actor policy.Actor) (model modelsFilm.Film, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "RollbackFilm")).
+			Debug("domain",
+				zap.String("filmID", filmID.String()),
+				zap.Int("number", number),
+				zap.Any("actor", actor),
 				zap.Error(err))
 	}()
 
-	return l.next.DeleteFilm(ctx, filmID, userID)
+	return l.next.RollbackFilm(ctx, filmID, number, actor)
 }
@@ -0,0 +1,29 @@
+package models
+
+import "github.com/google/uuid"
+
+// Department is a type for department of person credited in a film.
+type Department string
+
+const (
+	// DepartmentCast is a department for actors.
+	DepartmentCast Department = "cast"
+	// DepartmentWriter is a department for writers.
+	DepartmentWriter Department = "writer"
+	// DepartmentProducer is a department for producers.
+	DepartmentProducer Department = "producer"
+	// DepartmentComposer is a department for composers.
+	DepartmentComposer Department = "composer"
+)
+
+// Credit is a model for person credited in a film.
+type Credit struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	FilmID       uuid.UUID  `json:"filmID" gorm:"type:uuid;not null;index"`
+	CastID       uint       `json:"castID" gorm:"not null;index"`
+	Character    string     `json:"character" gorm:"size:100"`
+	Department   Department `json:"department" gorm:"size:30;not null;default:cast"`
+	BillingOrder int        `json:"billing_order" gorm:"not null;default:0"`
+
+	Cast Cast `json:"cast" gorm:"foreignKey:CastID;references:ID;constraint:OnDelete:CASCADE"`
+}
@@ -3,4 +3,11 @@ package models
 type Director struct {
 	ID   uint   `json:"id" gorm:"primaryKey"`
 	Name string `json:"name" gorm:"unique;not null;size:100"`
+
+	FilmsCount int64 `json:"films_count" gorm:"->;-:migration"`
+}
+
+// SetDataForUpdate sets data for update.
+func (d *Director) SetDataForUpdate(data *Director) {
+	d.Name = data.Name
 }
@@ -0,0 +1,17 @@
+package models
+
+const (
+	// FacetGenres is a facet of films by genre name.
+	FacetGenres = "genres"
+	// FacetDecade is a facet of films by the first year of release decade.
+	FacetDecade = "decade"
+	// FacetDirector is a facet of films by director name.
+	FacetDirector = "director"
+)
+
+// FacetCount is a count of films with a value of a facet.
+type FacetCount struct {
+	Facet string
+	Value string
+	Count int64
+}
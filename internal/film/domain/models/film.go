@@ -7,20 +7,40 @@ import (
 	"time"
 )
 
+// VersionAny is a version of a film from request which matches any version of the film, it is passed as If-Match: *.
+const VersionAny = -1
+
 type Film struct {
 	UUID        uuid.UUID `json:"uuid" gorm:"type:uuid;primaryKey"`
 	CreatorID   uuid.UUID `json:"creatorID" gorm:"type:uuid;not null"`
 	Title       string    `json:"title" gorm:"unique;not null;size:100"`
 	DirectorID  uint      `json:"directorID" gorm:"not null"`
 	ReleaseDate time.Time `json:"release_date" gorm:"type:date;not null;index"`
-	Casts       []Cast    `json:"casts" gorm:"many2many:film_casts;constraint:OnDelete:CASCADE"`
 	Genres      []Genre   `json:"genres" gorm:"many2many:film_genres;constraint:OnDelete:CASCADE"`
 	Synopsis    string    `json:"synopsis" gorm:"type:text;not null"`
+	Rating      float64   `json:"rating" gorm:"type:numeric(4,2);not null;default:0;index"`
+	RatingCount int64     `json:"rating_count" gorm:"not null;default:0"`
 	CreatedAt   int64     `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt   int64     `json:"updated_at" gorm:"autoUpdateTime"`
 
+	// Version is incremented on every update of the film, it is returned as ETag for optimistic concurrency
+	Version int `json:"version" gorm:"not null;default:1"`
+
+	// DeletedAt is set when the film is moved to trash, trashed films are purged after retention period
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+
+	// SearchVector is maintained by the repository, it is never read or written from the model, its GIN index is made by migration
+	SearchVector string  `json:"-" gorm:"type:tsvector;->:false;<-:false"`
+	Relevance    float64 `json:"relevance" gorm:"->;-:migration"`
+	Highlight    string  `json:"highlight" gorm:"->;-:migration"`
+
 	Creator  models.User `json:"creator" gorm:"foreignKey:CreatorID;references:UUID;constraint:OnDelete:CASCADE"`
 	Director Director    `json:"director" gorm:"foreignKey:DirectorID;references:ID;constraint:OnDelete:CASCADE"`
+	Credits  []Credit    `json:"credits" gorm:"foreignKey:FilmID;references:UUID;constraint:OnDelete:CASCADE"`
+	Ratings  []Rating    `json:"-" gorm:"foreignKey:FilmID;references:UUID;constraint:OnDelete:CASCADE"`
+	Reviews  []Review    `json:"-" gorm:"foreignKey:FilmID;references:UUID;constraint:OnDelete:CASCADE"`
+
+	Revisions []Revision `json:"-" gorm:"foreignKey:FilmID;references:UUID;constraint:OnDelete:CASCADE"`
 }
 
 func (f *Film) BeforeCreate(_ *gorm.DB) error {
@@ -34,11 +54,55 @@ func (f *Film) SetDataForUpdate(data *Film) {
 	f.Title = data.Title
 	f.Director = data.Director
 	f.ReleaseDate = data.ReleaseDate
-	f.Casts = data.Casts
+	f.Credits = data.Credits
 	f.Synopsis = data.Synopsis
 	f.Genres = data.Genres
 }
 
+// FilmPatch is a set of changed fields of film, nil fields are not changed.
+type FilmPatch struct {
+	UUID        uuid.UUID
+	Version     int
+	Title       *string
+	Director    *Director
+	ReleaseDate *time.Time
+	Genres      []Genre
+	Credits     []Credit
+	Synopsis    *string
+}
+
+// IsEmpty checks that the patch does not change any field.
+func (p FilmPatch) IsEmpty() bool {
+	return p.Title == nil && p.Director == nil && p.ReleaseDate == nil && p.Genres == nil && p.Credits == nil && p.Synopsis == nil
+}
+
+// ApplyPatch sets changed fields of the patch.
+func (f *Film) ApplyPatch(patch FilmPatch) {
+	if patch.Title != nil {
+		f.Title = *patch.Title
+	}
+
+	if patch.Director != nil {
+		f.Director = *patch.Director
+	}
+
+	if patch.ReleaseDate != nil {
+		f.ReleaseDate = *patch.ReleaseDate
+	}
+
+	if patch.Genres != nil {
+		f.Genres = patch.Genres
+	}
+
+	if patch.Credits != nil {
+		f.Credits = patch.Credits
+	}
+
+	if patch.Synopsis != nil {
+		f.Synopsis = *patch.Synopsis
+	}
+}
+
 // Operation is a type for film operation.
 type Operation string
 
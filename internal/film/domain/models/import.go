@@ -0,0 +1,40 @@
+package models
+
+import "github.com/google/uuid"
+
+// ImportStatus is a status of a row of film import.
+type ImportStatus string
+
+const (
+	// ImportStatusCreated is a status of row with created film, in dry run the film would be created.
+	ImportStatusCreated ImportStatus = "created"
+	// ImportStatusSkipped is a status of row with film which already exists with the same title.
+	ImportStatusSkipped ImportStatus = "skipped"
+	// ImportStatusFailed is a status of row with wrong data.
+	ImportStatusFailed ImportStatus = "failed"
+)
+
+// ImportRow is a film from a row of import.
+type ImportRow struct {
+	Row  int
+	Film Film
+}
+
+// ImportResult is a result of import of one row.
+type ImportResult struct {
+	Row    int
+	Title  string
+	Status ImportStatus
+	Reason string
+	FilmID uuid.UUID
+}
+
+// NewImportResultFailed is a constructor for ImportResult of failed row.
+func NewImportResultFailed(row int, title string, reason string) ImportResult {
+	return ImportResult{
+		Row:    row,
+		Title:  title,
+		Status: ImportStatusFailed,
+		Reason: reason,
+	}
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"film-management/internal/user/domain/models"
+	"github.com/google/uuid"
+)
+
+const (
+	// RatingMinScore is a minimal score of film rating.
+	RatingMinScore = 1
+	// RatingMaxScore is a maximal score of film rating.
+	RatingMaxScore = 10
+)
+
+// Rating is a model for user rating of film.
+type Rating struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	FilmID    uuid.UUID `json:"filmID" gorm:"type:uuid;not null;uniqueIndex:idx_ratings_film_user"`
+	UserID    uuid.UUID `json:"userID" gorm:"type:uuid;not null;uniqueIndex:idx_ratings_film_user"`
+	Score     int       `json:"score" gorm:"not null"`
+	CreatedAt int64     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt int64     `json:"updated_at" gorm:"autoUpdateTime"`
+
+	User models.User `json:"-" gorm:"foreignKey:UserID;references:UUID;constraint:OnDelete:CASCADE"`
+}
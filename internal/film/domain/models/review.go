@@ -0,0 +1,31 @@
+package models
+
+import (
+	"film-management/internal/user/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Review is a model for user text review of film.
+type Review struct {
+	UUID      uuid.UUID `json:"uuid" gorm:"type:uuid;primaryKey"`
+	FilmID    uuid.UUID `json:"filmID" gorm:"type:uuid;not null;index"`
+	AuthorID  uuid.UUID `json:"authorID" gorm:"type:uuid;not null;index"`
+	Text      string    `json:"text" gorm:"type:text;not null"`
+	Hidden    bool      `json:"hidden" gorm:"not null;default:false"`
+	CreatedAt int64     `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt int64     `json:"updated_at" gorm:"autoUpdateTime"`
+
+	Author models.User `json:"author" gorm:"foreignKey:AuthorID;references:UUID;constraint:OnDelete:CASCADE"`
+}
+
+func (r *Review) BeforeCreate(_ *gorm.DB) error {
+	r.UUID = uuid.New()
+
+	return nil
+}
+
+// SetDataForUpdate sets data for update.
+func (r *Review) SetDataForUpdate(data *Review) {
+	r.Text = data.Text
+}
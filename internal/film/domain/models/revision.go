@@ -0,0 +1,179 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var ErrRevisionSnapshotScan = errors.New("failed to scan revision snapshot")
+
+// RevisionAction is a type for action which created a film revision.
+type RevisionAction string
+
+const (
+	// RevisionActionCreate is an action for adding film.
+	RevisionActionCreate RevisionAction = "create"
+	// RevisionActionUpdate is an action for updating film.
+	RevisionActionUpdate RevisionAction = "update"
+	// RevisionActionDelete is an action for moving film to trash.
+	RevisionActionDelete RevisionAction = "delete"
+	// RevisionActionRestore is an action for restoring film from trash.
+	RevisionActionRestore RevisionAction = "restore"
+	// RevisionActionRollback is an action for rollback of film to a past revision.
+	RevisionActionRollback RevisionAction = "rollback"
+)
+
+// Revision is an immutable snapshot of film made on every change.
+type Revision struct {
+	ID        uint             `json:"id" gorm:"primaryKey"`
+	FilmID    uuid.UUID        `json:"filmID" gorm:"type:uuid;not null;uniqueIndex:idx_revisions_film_number"`
+	Number    int              `json:"number" gorm:"not null;uniqueIndex:idx_revisions_film_number"`
+	EditorID  uuid.UUID        `json:"editorID" gorm:"type:uuid;not null;index"`
+	Action    RevisionAction   `json:"action" gorm:"size:20;not null"`
+	Snapshot  RevisionSnapshot `json:"snapshot" gorm:"type:jsonb;not null"`
+	CreatedAt int64            `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// NewRevision is a constructor for Revision of the film made by the editor.
+func NewRevision(film Film, editorID uuid.UUID, action RevisionAction) *Revision {
+	return &Revision{
+		FilmID:   film.UUID,
+		EditorID: editorID,
+		Action:   action,
+		Snapshot: NewRevisionSnapshot(film),
+	}
+}
+
+// RevisionSnapshot is a state of film fields in a revision.
+type RevisionSnapshot struct {
+	Title       string           `json:"title"`
+	Director    string           `json:"director"`
+	Genres      []string         `json:"genres"`
+	Credits     []RevisionCredit `json:"credits"`
+	Synopsis    string           `json:"synopsis"`
+	ReleaseDate string           `json:"release_date"`
+}
+
+// RevisionCredit is a state of person credited in a film in a revision.
+type RevisionCredit struct {
+	Name         string     `json:"name"`
+	Character    string     `json:"character"`
+	Department   Department `json:"department"`
+	BillingOrder int        `json:"billing_order"`
+}
+
+// NewRevisionSnapshot is a constructor for RevisionSnapshot of the film.
+func NewRevisionSnapshot(film Film) RevisionSnapshot {
+	snapshot := RevisionSnapshot{
+		Title:       film.Title,
+		Director:    film.Director.Name,
+		Genres:      make([]string, 0, len(film.Genres)),
+		Credits:     make([]RevisionCredit, 0, len(film.Credits)),
+		Synopsis:    film.Synopsis,
+		ReleaseDate: film.ReleaseDate.Format(time.DateOnly),
+	}
+
+	for _, genre := range film.Genres {
+		snapshot.Genres = append(snapshot.Genres, genre.Name)
+	}
+
+	// Genres have no order
+	sort.Strings(snapshot.Genres)
+
+	for _, credit := range film.Credits {
+		snapshot.Credits = append(snapshot.Credits, RevisionCredit{
+			Name:         credit.Cast.Name,
+			Character:    credit.Character,
+			Department:   credit.Department,
+			BillingOrder: credit.BillingOrder,
+		})
+	}
+
+	return snapshot
+}
+
+// Film returns film with fields of the snapshot, genres and casts have only names.
+func (s RevisionSnapshot) Film() (Film, error) {
+	releaseDate, err := time.Parse(time.DateOnly, s.ReleaseDate)
+	if err != nil {
+		return Film{}, err
+	}
+
+	film := Film{
+		Title:       s.Title,
+		Director:    Director{Name: s.Director},
+		Genres:      make([]Genre, 0, len(s.Genres)),
+		Credits:     make([]Credit, 0, len(s.Credits)),
+		Synopsis:    s.Synopsis,
+		ReleaseDate: releaseDate,
+	}
+
+	for _, genre := range s.Genres {
+		film.Genres = append(film.Genres, Genre{Name: genre})
+	}
+
+	for _, credit := range s.Credits {
+		film.Credits = append(film.Credits, Credit{
+			Cast:         Cast{Name: credit.Name},
+			Character:    credit.Character,
+			Department:   credit.Department,
+			BillingOrder: credit.BillingOrder,
+		})
+	}
+
+	return film, nil
+}
+
+// RevisionChange is a change of one film field between two revisions.
+type RevisionChange struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
+
+// Diff returns changes of fields from the snapshot to another snapshot in order of fields.
+func (s RevisionSnapshot) Diff(to RevisionSnapshot) []RevisionChange {
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"title", s.Title, to.Title},
+		{"director", s.Director, to.Director},
+		{"genres", s.Genres, to.Genres},
+		{"credits", s.Credits, to.Credits},
+		{"synopsis", s.Synopsis, to.Synopsis},
+		{"release_date", s.ReleaseDate, to.ReleaseDate},
+	}
+
+	changes := make([]RevisionChange, 0, len(fields))
+
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.from, field.to) {
+			changes = append(changes, RevisionChange{Field: field.name, From: field.from, To: field.to})
+		}
+	}
+
+	return changes
+}
+
+// Value implements driver.Valuer to store snapshot as JSON.
+func (s RevisionSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan implements sql.Scanner to read snapshot from JSON.
+func (s *RevisionSnapshot) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return ErrRevisionSnapshotScan
+	}
+}
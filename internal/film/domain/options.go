@@ -1,13 +1,33 @@
 package domain
 
+import "time"
+
 type OptFunc func(*Opts)
 
 type Opts struct {
-	repository Repository
+	repository     Repository
+	trashRetention time.Duration
+	// versionRequired requires version of the film on update and delete
+	versionRequired bool
 }
 
 func defaultOpts(repository Repository) Opts {
 	return Opts{
-		repository: repository,
+		repository:     repository,
+		trashRetention: 30 * 24 * time.Hour,
+	}
+}
+
+// WithTrashRetention sets how long deleted films are kept in trash before purge.
+func WithTrashRetention(retention time.Duration) OptFunc {
+	return func(o *Opts) {
+		o.trashRetention = retention
+	}
+}
+
+// WithVersionRequired sets if update and delete of a film require its current version.
+func WithVersionRequired(required bool) OptFunc {
+	return func(o *Opts) {
+		o.versionRequired = required
 	}
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	modelsFilm "film-management/internal/film/domain/models"
 	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
 	"film-management/pkg/query"
 	"film-management/pkg/query/pagination"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"strings"
+	"time"
 )
 
 // service is a struct for domain service.
@@ -31,7 +34,12 @@ func NewService(repository Repository, opts ...OptFunc) Service {
 	}
 }
 
-func (s service) AddFilm(ctx context.Context, model *modelsFilm.Film) error {
+func (s service) AddFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) error {
+	// Check permission
+	if !policy.Can(actor, policy.ActionFilmCreate, uuid.Nil) {
+		return customError.PermissionError{Err: ErrFilmNotCreatePermission}
+	}
+
 	// Check duplicate film with title
 	if err := s.checkDuplicateFilm(ctx, model.Title, model.UUID, modelsFilm.OperationAdd); err != nil {
 		return err
@@ -42,20 +50,87 @@ func (s service) AddFilm(ctx context.Context, model *modelsFilm.Film) error {
 		return err
 	}
 
-	// Set and create film casts
-	if err := s.setAndCreateFilmCasts(ctx, model); err != nil {
+	// Set and create film credits
+	if err := s.setAndCreateFilmCredits(ctx, model); err != nil {
 		return err
 	}
 
-	// Create film in db
-	if err := s.repository.CreateFilm(ctx, model); err != nil {
+	// Create film with the first revision in db
+	if err := s.repository.CreateFilm(ctx, model, modelsFilm.NewRevision(*model, actor.UserID, modelsFilm.RevisionActionCreate)); err != nil {
 		return ErrFilmCreate
 	}
 
 	return nil
 }
 
-func (s service) UpdateFilm(ctx context.Context, model *modelsFilm.Film) error {
+// ImportFilms Add films of the rows one by one, a film with the title of an existing film is skipped.
+// In dry run the films are only checked and nothing is created.
+func (s service) ImportFilms(ctx context.Context, rows []modelsFilm.ImportRow, dryRun bool, actor policy.Actor) ([]modelsFilm.ImportResult, error) {
+	// Check permission
+	if !policy.Can(actor, policy.ActionFilmCreate, uuid.Nil) {
+		return nil, customError.PermissionError{Err: ErrFilmNotCreatePermission}
+	}
+
+	results := make([]modelsFilm.ImportResult, 0, len(rows))
+	titles := make(map[string]struct{}, len(rows))
+
+	for _, row := range rows {
+		// Stop if the import is canceled
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		model := row.Film
+		result := modelsFilm.ImportResult{Row: row.Row, Title: model.Title}
+
+		// A film with the same title is earlier in the import, titles are compared in any case like in the repository
+		title := strings.ToLower(model.Title)
+		if _, ok := titles[title]; ok {
+			result.Status = modelsFilm.ImportStatusSkipped
+			result.Reason = ErrFilmExistsWithTitle.Error()
+			results = append(results, result)
+
+			continue
+		}
+
+		titles[title] = struct{}{}
+
+		var validationErr customError.ValidationError
+
+		switch err := s.importFilm(ctx, &model, dryRun, actor); {
+		case err == nil:
+			result.Status = modelsFilm.ImportStatusCreated
+			result.FilmID = model.UUID
+		case errors.As(err, &validationErr) && errors.Is(validationErr.Err, ErrFilmExistsWithTitle):
+			result.Status = modelsFilm.ImportStatusSkipped
+			result.Reason = ErrFilmExistsWithTitle.Error()
+		default:
+			result.Status = modelsFilm.ImportStatusFailed
+			result.Reason = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// importFilm Add a film of import, in dry run the film is only checked.
+func (s service) importFilm(ctx context.Context, model *modelsFilm.Film, dryRun bool, actor policy.Actor) error {
+	if !dryRun {
+		return s.AddFilm(ctx, model, actor)
+	}
+
+	// Check duplicate film with title
+	if err := s.checkDuplicateFilm(ctx, model.Title, model.UUID, modelsFilm.OperationAdd); err != nil {
+		return err
+	}
+
+	// Validate film genres, credits are not checked as missing casts are created
+	return s.setAndValidateFilmGenres(ctx, model)
+}
+
+func (s service) UpdateFilm(ctx context.Context, model *modelsFilm.Film, actor policy.Actor) error {
 	// Get film from db
 	filmFromDB, err := s.getFilmFromDB(ctx, model.UUID)
 	if err != nil {
@@ -63,34 +138,78 @@ func (s service) UpdateFilm(ctx context.Context, model *modelsFilm.Film) error {
 	}
 
 	// Check permission
-	if errPermission := s.checkFilmPermission(model.CreatorID, filmFromDB.CreatorID); errPermission != nil {
+	if errPermission := s.checkFilmPermission(actor, policy.ActionFilmUpdate, filmFromDB.CreatorID); errPermission != nil {
 		return errPermission
 	}
 
-	// Check duplicate film with title
-	if errDuplicate := s.checkDuplicateFilm(ctx, model.Title, filmFromDB.UUID, modelsFilm.OperationUpdate); errDuplicate != nil {
-		return errDuplicate
+	// Check version of the film
+	if errVersion := s.checkFilmVersion(model.Version, filmFromDB.Version); errVersion != nil {
+		return errVersion
 	}
 
-	// Set and validate film genres
-	if errGenres := s.setAndValidateFilmGenres(ctx, model); errGenres != nil {
-		return errGenres
+	// Update a film with revision
+	return s.updateFilm(ctx, &filmFromDB, model, actor.UserID, modelsFilm.RevisionActionUpdate)
+}
+
+// PatchFilm Update only the fields of a film set in the patch, genres and credits are resolved only if they are changed.
+func (s service) PatchFilm(ctx context.Context, patch modelsFilm.FilmPatch, actor policy.Actor) (modelsFilm.Film, error) {
+	// Get film with genres and credits from db, unchanged ones are saved as they are
+	filmFromDB, err := s.ViewFilm(ctx, patch.UUID)
+	if err != nil {
+		return modelsFilm.Film{}, err
 	}
 
-	// Set and create film casts
-	if errCasts := s.setAndCreateFilmCasts(ctx, model); errCasts != nil {
-		return errCasts
+	// Check permission
+	if errPermission := s.checkFilmPermission(actor, policy.ActionFilmUpdate, filmFromDB.CreatorID); errPermission != nil {
+		return modelsFilm.Film{}, errPermission
 	}
 
-	// Set new film data
-	filmFromDB.SetDataForUpdate(model)
+	// Check version of the film
+	if errVersion := s.checkFilmVersion(patch.Version, filmFromDB.Version); errVersion != nil {
+		return modelsFilm.Film{}, errVersion
+	}
 
-	// Update a film in db
-	if errUpdate := s.repository.UpdateFilm(ctx, &filmFromDB); errUpdate != nil {
-		return ErrFilmUpdate
+	// Nothing to change
+	if patch.IsEmpty() {
+		return filmFromDB, nil
 	}
 
-	return nil
+	// Check duplicate film with the new title
+	if patch.Title != nil {
+		if errDuplicate := s.checkDuplicateFilm(ctx, *patch.Title, filmFromDB.UUID, modelsFilm.OperationUpdate); errDuplicate != nil {
+			return modelsFilm.Film{}, errDuplicate
+		}
+	}
+
+	// New genres and credits are set on a film, they are copied back to the patch
+	resolved := modelsFilm.Film{Genres: patch.Genres, Credits: patch.Credits}
+
+	// Set and validate the new film genres
+	if patch.Genres != nil {
+		if errGenres := s.setAndValidateFilmGenres(ctx, &resolved); errGenres != nil {
+			return modelsFilm.Film{}, errGenres
+		}
+
+		patch.Genres = resolved.Genres
+	}
+
+	// Set and create the new film credits
+	if patch.Credits != nil {
+		if errCredits := s.setAndCreateFilmCredits(ctx, &resolved); errCredits != nil {
+			return modelsFilm.Film{}, errCredits
+		}
+
+		patch.Credits = resolved.Credits
+	}
+
+	// Set changed film data and save it
+	filmFromDB.ApplyPatch(patch)
+
+	if errSave := s.saveFilm(ctx, &filmFromDB, actor.UserID, modelsFilm.RevisionActionUpdate); errSave != nil {
+		return modelsFilm.Film{}, errSave
+	}
+
+	return s.ViewFilm(ctx, patch.UUID)
 }
 
 // ViewFilm View a film.
@@ -119,27 +238,441 @@ func (s service) ViewAllFilms(ctx context.Context, filterSortPagination query.Fi
 	return filmsFromDB, p, nil
 }
 
+// ExportFilms Export all films with filters, the films are read by batches of the limit and passed to fn one by one.
+func (s service) ExportFilms(ctx context.Context, filterSortLimit query.FilterSortLimit, fn func(modelsFilm.Film) error) error {
+	for {
+		filmsFromDB, p, err := s.repository.FindAllFilms(ctx, filterSortLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, film := range filmsFromDB {
+			if errFn := fn(film); errFn != nil {
+				return errFn
+			}
+		}
+
+		// Sort without cursor is read by offset, sort with cursor ends without the next cursor
+		if p.NextCursor == "" {
+			if filterSortLimit.Cursor != nil || len(filmsFromDB) < filterSortLimit.Limit {
+				return nil
+			}
+
+			filterSortLimit.Offset += filterSortLimit.Limit
+
+			continue
+		}
+
+		// Continue after the last film
+		cursor, err := pagination.GetCursorOption(p.NextCursor, filterSortLimit.Sort)
+		if err != nil {
+			return err
+		}
+
+		filterSortLimit.Cursor = cursor
+	}
+}
+
+// ViewFilmFacets View counts of films with the filter by values of the facets.
+func (s service) ViewFilmFacets(ctx context.Context, filter query.Filter, facets []string) ([]modelsFilm.FacetCount, error) {
+	if len(facets) == 0 {
+		return nil, nil
+	}
+
+	return s.repository.CountFilmFacets(ctx, filter, facets)
+}
+
 // DeleteFilm Delete a film.
-func (s service) DeleteFilm(ctx context.Context, filmID uuid.UUID, userID uuid.UUID) error {
-	// Get film from db
-	filmFromDB, err := s.getFilmFromDB(ctx, filmID)
+func (s service) DeleteFilm(ctx context.Context, filmID uuid.UUID, version int, actor policy.Actor) error {
+	// Get film with genres and credits from db for revision
+	filmFromDB, err := s.ViewFilm(ctx, filmID)
 	if err != nil {
 		return err
 	}
 
 	// Check permission
-	if errPermission := s.checkFilmPermission(userID, filmFromDB.CreatorID); errPermission != nil {
+	if errPermission := s.checkFilmPermission(actor, policy.ActionFilmDelete, filmFromDB.CreatorID); errPermission != nil {
 		return errPermission
 	}
 
-	// Delete a film in db
-	if errDelete := s.repository.DeleteFilm(ctx, filmID); errDelete != nil {
+	// Check version of the film
+	if errVersion := s.checkFilmVersion(version, filmFromDB.Version); errVersion != nil {
+		return errVersion
+	}
+
+	// Delete a film with revision in db, the film is deleted only if it was not changed after it was read
+	if errDelete := s.repository.DeleteFilm(ctx, filmID, filmFromDB.Version, modelsFilm.NewRevision(filmFromDB, actor.UserID, modelsFilm.RevisionActionDelete)); errDelete != nil {
+		if errors.Is(errDelete, ErrFilmVersionMismatch) {
+			return customError.PreconditionFailedError{Err: ErrFilmVersionMismatch}
+		}
+
 		return ErrFilmDelete
 	}
 
 	return nil
 }
 
+// ViewTrash View deleted films of the user, newest deleted first by default.
+func (s service) ViewTrash(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Film, pagination.Pagination, error) {
+	filmsFromDB, p, err := s.repository.FindAllDeletedFilms(ctx, userID, filterSortLimit)
+	if err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	return filmsFromDB, p, nil
+}
+
+// RestoreFilm Restore a deleted film from trash.
+func (s service) RestoreFilm(ctx context.Context, filmID uuid.UUID, actor policy.Actor) (modelsFilm.Film, error) {
+	// Get deleted film from db
+	filmFromDB, err := s.repository.FindOneDeletedFilmByUUID(ctx, filmID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrFilmNotFoundInTrash):
+			return modelsFilm.Film{}, customError.NotFoundError{Err: ErrFilmNotFoundInTrash}
+		default:
+			return modelsFilm.Film{}, ErrFilmFind
+		}
+	}
+
+	// Check permission
+	if errPermission := s.checkFilmPermission(actor, policy.ActionFilmRestore, filmFromDB.CreatorID); errPermission != nil {
+		return modelsFilm.Film{}, errPermission
+	}
+
+	// Restore a film with revision in db
+	if errRestore := s.repository.RestoreFilm(ctx, filmID, modelsFilm.NewRevision(filmFromDB, actor.UserID, modelsFilm.RevisionActionRestore)); errRestore != nil {
+		return modelsFilm.Film{}, ErrFilmRestore
+	}
+
+	return s.ViewFilm(ctx, filmID)
+}
+
+// PurgeTrash Delete films which are in trash longer than retention period, returns count of purged films.
+func (s service) PurgeTrash(ctx context.Context) (int64, error) {
+	count, err := s.repository.PurgeDeletedFilms(ctx, time.Now().Add(-s.trashRetention))
+	if err != nil {
+		return 0, ErrFilmPurge
+	}
+
+	return count, nil
+}
+
+// ViewAllRevisions View all revisions of a film.
+func (s service) ViewAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Revision, pagination.Pagination, error) {
+	// Check if a film exists
+	if _, err := s.getFilmFromDB(ctx, filmID); err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	revisionsFromDB, p, err := s.repository.FindAllRevisions(ctx, filmID, filterSortLimit)
+	if err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	return revisionsFromDB, p, nil
+}
+
+// DiffRevisions Get changes of film fields between two revisions.
+func (s service) DiffRevisions(ctx context.Context, filmID uuid.UUID, fromNumber int, toNumber int) ([]modelsFilm.RevisionChange, error) {
+	// Check if a film exists
+	if _, err := s.getFilmFromDB(ctx, filmID); err != nil {
+		return nil, err
+	}
+
+	// Get revisions from db
+	fromRevision, err := s.getRevisionFromDB(ctx, filmID, fromNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	toRevision, err := s.getRevisionFromDB(ctx, filmID, toNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromRevision.Snapshot.Diff(toRevision.Snapshot), nil
+}
+
+// RollbackFilm Set fields of a film from a past revision. The rollback is saved as a new revision.
+func (s service) RollbackFilm(ctx context.Context, filmID uuid.UUID, number int, actor policy.Actor) (modelsFilm.Film, error) {
+	// Check permission
+	if !policy.Can(actor, policy.ActionFilmRollback, uuid.Nil) {
+		return modelsFilm.Film{}, customError.PermissionError{Err: ErrRevisionNotRollbackAllowed}
+	}
+
+	// Get film from db
+	filmFromDB, err := s.getFilmFromDB(ctx, filmID)
+	if err != nil {
+		return modelsFilm.Film{}, err
+	}
+
+	// Get revision from db
+	revisionFromDB, err := s.getRevisionFromDB(ctx, filmID, number)
+	if err != nil {
+		return modelsFilm.Film{}, err
+	}
+
+	// Get film data from revision
+	model, err := revisionFromDB.Snapshot.Film()
+	if err != nil {
+		return modelsFilm.Film{}, ErrRevisionWrong
+	}
+
+	model.UUID = filmID
+
+	// Update a film with revision
+	if errUpdate := s.updateFilm(ctx, &filmFromDB, &model, actor.UserID, modelsFilm.RevisionActionRollback); errUpdate != nil {
+		if errors.Is(errUpdate, ErrFilmUpdate) {
+			return modelsFilm.Film{}, ErrRevisionRollback
+		}
+
+		return modelsFilm.Film{}, errUpdate
+	}
+
+	return s.ViewFilm(ctx, filmID)
+}
+
+// RateFilm Rate a film. A user has only one rating per film, a repeated rating replaces the previous one.
+func (s service) RateFilm(ctx context.Context, model *modelsFilm.Rating) (modelsFilm.Film, error) {
+	// Check if a film exists
+	if _, err := s.getFilmFromDB(ctx, model.FilmID); err != nil {
+		return modelsFilm.Film{}, err
+	}
+
+	// Save rating and recalculate aggregate score of the film in db
+	if err := s.repository.SaveRating(ctx, model); err != nil {
+		return modelsFilm.Film{}, ErrFilmRate
+	}
+
+	// Get film with the new aggregate score from db
+	return s.getFilmFromDB(ctx, model.FilmID)
+}
+
+// AddReview Add a review of a film.
+func (s service) AddReview(ctx context.Context, model *modelsFilm.Review) error {
+	// Check if a film exists
+	if _, err := s.getFilmFromDB(ctx, model.FilmID); err != nil {
+		return err
+	}
+
+	// Create review in db
+	if err := s.repository.CreateReview(ctx, model); err != nil {
+		return ErrReviewCreate
+	}
+
+	return nil
+}
+
+// UpdateReview Update own review of a film.
+func (s service) UpdateReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) error {
+	// Get review from db
+	reviewFromDB, err := s.getReviewFromDB(ctx, model.FilmID, model.UUID)
+	if err != nil {
+		return err
+	}
+
+	// Check permission
+	if !policy.Can(actor, policy.ActionReviewUpdate, reviewFromDB.AuthorID) {
+		return customError.PermissionError{Err: ErrReviewNotPermission}
+	}
+
+	// Set new review data
+	reviewFromDB.SetDataForUpdate(model)
+
+	// Update a review in db
+	if errUpdate := s.repository.UpdateReview(ctx, &reviewFromDB); errUpdate != nil {
+		return ErrReviewUpdate
+	}
+
+	*model = reviewFromDB
+
+	return nil
+}
+
+// HideReview Hide or show a review of a film. Only the film creator or an admin can moderate reviews.
+func (s service) HideReview(ctx context.Context, model *modelsFilm.Review, actor policy.Actor) error {
+	// Get film from db
+	filmFromDB, err := s.getFilmFromDB(ctx, model.FilmID)
+	if err != nil {
+		return err
+	}
+
+	// Check permission
+	if !policy.Can(actor, policy.ActionReviewModerate, filmFromDB.CreatorID) {
+		return customError.PermissionError{Err: ErrReviewNotModeratePermission}
+	}
+
+	// Get review from db
+	reviewFromDB, err := s.getReviewFromDB(ctx, model.FilmID, model.UUID)
+	if err != nil {
+		return err
+	}
+
+	// Update a review in db
+	reviewFromDB.Hidden = model.Hidden
+	if errUpdate := s.repository.UpdateReview(ctx, &reviewFromDB); errUpdate != nil {
+		return ErrReviewUpdate
+	}
+
+	*model = reviewFromDB
+
+	return nil
+}
+
+// ViewAllReviews View all reviews of a film. Hidden reviews are visible only to the film creator and admins.
+func (s service) ViewAllReviews(ctx context.Context, filmID uuid.UUID, actor policy.Actor, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Review, pagination.Pagination, error) {
+	// Get film from db
+	filmFromDB, err := s.getFilmFromDB(ctx, filmID)
+	if err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	// Hide moderated reviews from everybody who can not moderate them
+	if !policy.Can(actor, policy.ActionReviewModerate, filmFromDB.CreatorID) {
+		if filterSortLimit.Filter == nil {
+			filterSortLimit.Filter = make(query.Filter)
+		}
+
+		filterSortLimit.Filter["hidden"] = false
+	}
+
+	reviewsFromDB, p, err := s.repository.FindAllReviews(ctx, filmID, filterSortLimit)
+	if err != nil {
+		return nil, pagination.Pagination{}, ErrReviewFindAll
+	}
+
+	return reviewsFromDB, p, nil
+}
+
+// DeleteReview Delete own review of a film. Admins can delete any review.
+func (s service) DeleteReview(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID, actor policy.Actor) error {
+	// Get review from db
+	reviewFromDB, err := s.getReviewFromDB(ctx, filmID, reviewID)
+	if err != nil {
+		return err
+	}
+
+	// Check permission
+	if !policy.Can(actor, policy.ActionReviewDelete, reviewFromDB.AuthorID) {
+		return customError.PermissionError{Err: ErrReviewNotPermission}
+	}
+
+	// Delete a review in db
+	if errDelete := s.repository.DeleteReview(ctx, reviewID); errDelete != nil {
+		return ErrReviewDelete
+	}
+
+	return nil
+}
+
+// AddGenre Add a genre. Only admins can manage genres.
+func (s service) AddGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) error {
+	// Check permission
+	if err := s.checkGenrePermission(actor); err != nil {
+		return err
+	}
+
+	// Check duplicate genre with name
+	if err := s.checkDuplicateGenre(ctx, model); err != nil {
+		return err
+	}
+
+	// Create genre in db
+	if _, err := s.repository.CreateGenre(ctx, model); err != nil {
+		return ErrGenreCreate
+	}
+
+	return nil
+}
+
+// UpdateGenre Rename a genre. Only admins can manage genres.
+func (s service) UpdateGenre(ctx context.Context, model *modelsFilm.Genre, actor policy.Actor) error {
+	// Check permission
+	if err := s.checkGenrePermission(actor); err != nil {
+		return err
+	}
+
+	// Get genre from db
+	genreFromDB, err := s.getGenreFromDB(ctx, model.ID)
+	if err != nil {
+		return err
+	}
+
+	// Check duplicate genre with name
+	if errDuplicate := s.checkDuplicateGenre(ctx, model); errDuplicate != nil {
+		return errDuplicate
+	}
+
+	// Set new genre data
+	genreFromDB.SetDataForUpdate(model)
+
+	// Update genre in db
+	if errUpdate := s.repository.UpdateGenre(ctx, &genreFromDB); errUpdate != nil {
+		return ErrGenreUpdate
+	}
+
+	*model = genreFromDB
+
+	return nil
+}
+
+// DeleteGenre Delete a genre. A genre used by films is deleted only with cascade, it is removed from the films then.
+func (s service) DeleteGenre(ctx context.Context, genreID uint, cascade bool, actor policy.Actor) error {
+	// Check permission
+	if err := s.checkGenrePermission(actor); err != nil {
+		return err
+	}
+
+	// Get genre from db
+	genreFromDB, err := s.getGenreFromDB(ctx, genreID)
+	if err != nil {
+		return err
+	}
+
+	// Check if genre is used by films
+	if genreFromDB.FilmsCount > 0 && !cascade {
+		return customError.ValidationError{Field: "cascade", Err: ErrGenreInUse}
+	}
+
+	// Delete genre in db
+	if errDelete := s.repository.DeleteGenre(ctx, genreID); errDelete != nil {
+		return ErrGenreDelete
+	}
+
+	return nil
+}
+
+// ViewAllGenres View all genres with count of their films. Only admins can manage genres.
+func (s service) ViewAllGenres(ctx context.Context, actor policy.Actor, filterSortLimit query.FilterSortLimit) ([]modelsFilm.Genre, pagination.Pagination, error) {
+	// Check permission
+	if err := s.checkGenrePermission(actor); err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	genresFromDB, p, err := s.repository.FindAllGenres(ctx, filterSortLimit)
+	if err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	return genresFromDB, p, nil
+}
+
+// getReviewFromDB Get review from db.
+func (s service) getReviewFromDB(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID) (modelsFilm.Review, error) {
+	reviewFromDB, err := s.repository.FindOneReviewByUUID(ctx, filmID, reviewID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrReviewNotFound):
+			return modelsFilm.Review{}, customError.NotFoundError{Err: ErrReviewNotFound}
+		default:
+			return modelsFilm.Review{}, ErrReviewFind
+		}
+	}
+
+	return reviewFromDB, nil
+}
+
 // getFilmFromDB Get film from db.
 func (s service) getFilmFromDB(ctx context.Context, filmID uuid.UUID) (modelsFilm.Film, error) {
 	filmFromDB, err := s.repository.FindOneFilmByUUID(ctx, filmID)
@@ -155,15 +688,136 @@ func (s service) getFilmFromDB(ctx context.Context, filmID uuid.UUID) (modelsFil
 	return filmFromDB, nil
 }
 
-// checkFilmPermission Check if a film user is the same as the user who wants to do an action.
-func (s service) checkFilmPermission(firstUserID uuid.UUID, secondUserID uuid.UUID) error {
-	if firstUserID != secondUserID {
+// updateFilm Set new data of a film from the model and update it in db with revision of the editor.
+func (s service) updateFilm(ctx context.Context, filmFromDB *modelsFilm.Film, model *modelsFilm.Film, editorID uuid.UUID, action modelsFilm.RevisionAction) error {
+	// Check duplicate film with title
+	if errDuplicate := s.checkDuplicateFilm(ctx, model.Title, filmFromDB.UUID, modelsFilm.OperationUpdate); errDuplicate != nil {
+		return errDuplicate
+	}
+
+	// Set and validate film genres
+	if errGenres := s.setAndValidateFilmGenres(ctx, model); errGenres != nil {
+		return errGenres
+	}
+
+	// Set and create film credits
+	if errCredits := s.setAndCreateFilmCredits(ctx, model); errCredits != nil {
+		return errCredits
+	}
+
+	// Set new film data
+	filmFromDB.SetDataForUpdate(model)
+
+	// Save a film
+	if errSave := s.saveFilm(ctx, filmFromDB, editorID, action); errSave != nil {
+		return errSave
+	}
+
+	// Return the new version of the film
+	model.Version = filmFromDB.Version
+
+	return nil
+}
+
+// saveFilm Update a film in db with revision of the editor, the film is updated only if it was not changed after it was read.
+func (s service) saveFilm(ctx context.Context, filmFromDB *modelsFilm.Film, editorID uuid.UUID, action modelsFilm.RevisionAction) error {
+	if errUpdate := s.repository.UpdateFilm(ctx, filmFromDB, modelsFilm.NewRevision(*filmFromDB, editorID, action)); errUpdate != nil {
+		if errors.Is(errUpdate, ErrFilmVersionMismatch) {
+			return customError.PreconditionFailedError{Err: ErrFilmVersionMismatch}
+		}
+
+		return ErrFilmUpdate
+	}
+
+	return nil
+}
+
+// checkFilmVersion Check that the version of a film from request is the current one, zero means the version is not passed.
+// Any version matches the film read from db.
+func (s service) checkFilmVersion(version int, currentVersion int) error {
+	if version == modelsFilm.VersionAny {
+		return nil
+	}
+
+	if version == 0 {
+		if s.versionRequired {
+			return customError.PreconditionRequiredError{Err: ErrFilmVersionRequired}
+		}
+
+		return nil
+	}
+
+	if version != currentVersion {
+		return customError.PreconditionFailedError{Err: ErrFilmVersionMismatch}
+	}
+
+	return nil
+}
+
+// getRevisionFromDB Get revision of a film from db.
+func (s service) getRevisionFromDB(ctx context.Context, filmID uuid.UUID, number int) (modelsFilm.Revision, error) {
+	revisionFromDB, err := s.repository.FindOneRevision(ctx, filmID, number)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrRevisionNotFound):
+			return modelsFilm.Revision{}, customError.NotFoundError{Err: ErrRevisionNotFound}
+		default:
+			return modelsFilm.Revision{}, ErrRevisionFind
+		}
+	}
+
+	return revisionFromDB, nil
+}
+
+// checkFilmPermission Check if the user can do an action with a film of the creator.
+func (s service) checkFilmPermission(actor policy.Actor, action policy.Action, creatorID uuid.UUID) error {
+	if !policy.Can(actor, action, creatorID) {
 		return customError.PermissionError{Err: ErrFilmNotPermission}
 	}
 
 	return nil
 }
 
+// getGenreFromDB Get genre from db.
+func (s service) getGenreFromDB(ctx context.Context, genreID uint) (modelsFilm.Genre, error) {
+	genreFromDB, err := s.repository.FindOneGenre(ctx, genreID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrGenreNotFound):
+			return modelsFilm.Genre{}, customError.NotFoundError{Err: ErrGenreNotFound}
+		default:
+			return modelsFilm.Genre{}, ErrGenreFind
+		}
+	}
+
+	return genreFromDB, nil
+}
+
+// checkGenrePermission Check if user has permission to manage genres.
+func (s service) checkGenrePermission(actor policy.Actor) error {
+	if !policy.Can(actor, policy.ActionGenreManage, uuid.Nil) {
+		return customError.PermissionError{Err: ErrGenreNotPermission}
+	}
+
+	return nil
+}
+
+// checkDuplicateGenre Check if another genre with the same name already exists.
+func (s service) checkDuplicateGenre(ctx context.Context, model *modelsFilm.Genre) error {
+	genresFromDB, err := s.repository.GetGenresByNames(ctx, []string{model.Name})
+	if err != nil {
+		return ErrFilmGetGenresByNames
+	}
+
+	for _, genre := range genresFromDB {
+		if genre.ID != model.ID {
+			return customError.ValidationError{Field: "name", Err: ErrGenreExists}
+		}
+	}
+
+	return nil
+}
+
 // checkDuplicateFilm Check if a film with the same title already exists.
 func (s service) checkDuplicateFilm(ctx context.Context, title string, filmID uuid.UUID, operation modelsFilm.Operation) error {
 	if err := s.repository.FilmExistsWithTitle(ctx, title, filmID, operation); err != nil {
@@ -221,38 +875,43 @@ func (s service) getExistingGenres(ctx context.Context, genres []modelsFilm.Genr
 	return existingGenresMap, nil
 }
 
-// setAndCreateFilmCasts Set and create film casts.
-func (s service) setAndCreateFilmCasts(ctx context.Context, model *modelsFilm.Film) error {
+// setAndCreateFilmCredits Set and create people credited in a film.
+func (s service) setAndCreateFilmCredits(ctx context.Context, model *modelsFilm.Film) error {
 	// Get existing casts in db
-	existingCasts, err := s.getExistingCasts(ctx, model.Casts)
+	existingCasts, err := s.getExistingCasts(ctx, model.Credits)
 	if err != nil {
 		return err
 	}
 
-	// Set film casts
-	for i, cast := range model.Casts {
+	// Set film credits
+	for i, credit := range model.Credits {
 		// If cast exists in db, set it
-		if existingCast, ok := existingCasts[cast.Name]; ok {
-			model.Casts[i] = existingCast
+		if existingCast, ok := existingCasts[credit.Cast.Name]; ok {
+			model.Credits[i].Cast = existingCast
 		} else {
 			// If cast does not exist in db, create it
-			newCast, errCreate := s.repository.CreateCast(ctx, &cast)
+			newCast, errCreate := s.repository.CreateCast(ctx, &credit.Cast)
 			if errCreate != nil {
 				return ErrFilmCreateCast
 			}
-			model.Casts[i] = *newCast
+			model.Credits[i].Cast = *newCast
+
+			// The same person can be credited several times, e.g. as writer and actor
+			existingCasts[newCast.Name] = *newCast
 		}
+
+		model.Credits[i].CastID = model.Credits[i].Cast.ID
 	}
 
 	return nil
 }
 
-// getExistingCasts Get existing casts in db.
-func (s service) getExistingCasts(ctx context.Context, casts []modelsFilm.Cast) (map[string]modelsFilm.Cast, error) {
+// getExistingCasts Get existing casts of credits in db.
+func (s service) getExistingCasts(ctx context.Context, credits []modelsFilm.Credit) (map[string]modelsFilm.Cast, error) {
 	// Get cast names
-	castNames := make([]string, len(casts))
-	for i, cast := range casts {
-		castNames[i] = cast.Name
+	castNames := make([]string, len(credits))
+	for i, credit := range credits {
+		castNames[i] = credit.Cast.Name
 	}
 
 	// Get existing casts in db
@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/policy"
+	"film-management/repositories/storage/memory"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestPatchFilm(t *testing.T) {
+	t.Parallel()
+
+	requireAssert := require.New(t)
+
+	ctx := context.TODO()
+	admin := policy.NewActor(uuid.New(), policy.RoleAdmin)
+	service := domain.NewService(memory.NewFilmRepository(memory.NewStore(), zap.NewNop()))
+
+	requireAssert.NoError(service.AddGenre(ctx, &models.Genre{Name: "drama"}, admin))
+	requireAssert.NoError(service.AddGenre(ctx, &models.Genre{Name: "comedy"}, admin))
+
+	film := models.Film{
+		Title:       "Alpha",
+		Director:    models.Director{Name: "John Doe"},
+		Genres:      []models.Genre{{Name: "drama"}},
+		Credits:     []models.Credit{{Cast: models.Cast{Name: "Jane Roe"}, Department: models.DepartmentCast}},
+		Synopsis:    "Synopsis of Alpha",
+		ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	requireAssert.NoError(service.AddFilm(ctx, &film, admin))
+
+	// Genres and casts of the patch are passed by names, they are saved with their IDs
+	patched, err := service.PatchFilm(ctx, models.FilmPatch{
+		UUID:    film.UUID,
+		Genres:  []models.Genre{{Name: "comedy"}, {Name: "drama"}},
+		Credits: []models.Credit{{Cast: models.Cast{Name: "Jane Roe"}, Department: models.DepartmentCast}, {Cast: models.Cast{Name: "Joe Bloggs"}, Department: models.DepartmentCast}},
+	}, admin)
+	requireAssert.NoError(err)
+	requireAssert.Equal(film.Version+1, patched.Version)
+
+	genres := make([]string, 0, len(patched.Genres))
+	for _, genre := range patched.Genres {
+		requireAssert.NotZero(genre.ID)
+		genres = append(genres, genre.Name)
+	}
+
+	requireAssert.ElementsMatch([]string{"comedy", "drama"}, genres)
+
+	casts := make([]string, 0, len(patched.Credits))
+	for _, credit := range patched.Credits {
+		requireAssert.NotZero(credit.CastID)
+		requireAssert.Equal(credit.CastID, credit.Cast.ID)
+		casts = append(casts, credit.Cast.Name)
+	}
+
+	requireAssert.Equal([]string{"Jane Roe", "Joe Bloggs"}, casts)
+	requireAssert.Equal(film.Credits[0].CastID, patched.Credits[0].CastID)
+}
@@ -5,9 +5,11 @@ import (
 	"film-management/internal/film/domain"
 	"film-management/internal/film/domain/models"
 	"film-management/pkg/errors"
+	"film-management/pkg/policy"
 	"film-management/pkg/validation"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -25,45 +27,14 @@ func MakeAddFilmEndpoint(s domain.Service) endpoint.Endpoint {
 			return AddFilmResponse{Err: errValidate}, nil
 		}
 
-		// Parse date
-		parseDate, err := time.Parse(time.DateOnly, reqForm.ReleaseDate)
-		if err != nil {
-			return AddFilmResponse{Err: err}, nil
-		}
-
-		// Parse creator UUID
-		parseCreatorUUID, err := uuid.Parse(reqForm.CreatorID)
+		// Prepare a film model
+		model, err := reqForm.toDomainFilm()
 		if err != nil {
 			return AddFilmResponse{Err: err}, nil
 		}
 
-		// Prepare genres
-		var genres []models.Genre
-		for _, genreName := range reqForm.Genres {
-			genre := models.Genre{Name: strings.ToLower(genreName)}
-			genres = append(genres, genre)
-		}
-
-		// Prepare casts
-		var casts []models.Cast
-		for _, castName := range reqForm.Casts {
-			cast := models.Cast{Name: castName}
-			casts = append(casts, cast)
-		}
-
-		// Prepare a film model
-		model := &models.Film{
-			CreatorID:   parseCreatorUUID,
-			Title:       reqForm.Title,
-			Director:    models.Director{Name: reqForm.Director},
-			ReleaseDate: parseDate,
-			Casts:       casts,
-			Synopsis:    reqForm.Synopsis,
-			Genres:      genres,
-		}
-
 		// Add film
-		if errAddFilm := s.AddFilm(ctx, model); errAddFilm != nil {
+		if errAddFilm := s.AddFilm(ctx, model, policy.NewActor(model.CreatorID, policy.Role(reqForm.CreatorRole))); errAddFilm != nil {
 			return AddFilmResponse{Err: errAddFilm}, nil
 		}
 
@@ -75,14 +46,15 @@ func MakeAddFilmEndpoint(s domain.Service) endpoint.Endpoint {
 
 // AddFilmRequest is a request for Add film.
 type AddFilmRequest struct {
-	CreatorID string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
-
-	Title       string   `json:"title" validate:"required,min=3,max=100" example:"Garry Potter"`
-	Director    string   `json:"director" validate:"required,min=3,max=40" example:"John Doe"`
-	ReleaseDate string   `json:"releaseDate" validate:"required,customDate" example:"2021-01-01"`
-	Genres      []string `json:"genres" validate:"required,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
-	Casts       []string `json:"casts" validate:"required,min=1,max=10,dive,min=3,max=100" example:"John Doe,Jane Doe,Foo Bar,Baz Quux"`
-	Synopsis    string   `json:"synopsis" validate:"required,min=10,max=1000" example:"This is a synopsis."`
+	CreatorID   string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorRole string `json:"creatorRole" swaggerignore:"true"`
+
+	Title       string       `json:"title" validate:"required,min=3,max=100" example:"Garry Potter"`
+	Director    string       `json:"director" validate:"required,min=3,max=40" example:"John Doe"`
+	ReleaseDate string       `json:"releaseDate" validate:"required,customDate" example:"2021-01-01"`
+	Genres      []string     `json:"genres" validate:"required,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
+	Casts       []CreditForm `json:"casts" validate:"required,min=1,max=50,dive"`
+	Synopsis    string       `json:"synopsis" validate:"required,min=10,max=1000" example:"This is a synopsis."`
 }
 
 // Validate is a method to validate form.
@@ -97,6 +69,38 @@ func (r *AddFilmRequest) Validate() error {
 	return customValidator.Validate(r)
 }
 
+// toDomainFilm is a method to convert the validated form to domain Film.
+func (r *AddFilmRequest) toDomainFilm() (*models.Film, error) {
+	// Parse date
+	parseDate, err := time.Parse(time.DateOnly, r.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse creator UUID
+	parseCreatorUUID, err := uuid.Parse(r.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare genres
+	var genres []models.Genre
+	for _, genreName := range r.Genres {
+		genre := models.Genre{Name: strings.ToLower(genreName)}
+		genres = append(genres, genre)
+	}
+
+	return &models.Film{
+		CreatorID:   parseCreatorUUID,
+		Title:       r.Title,
+		Director:    models.Director{Name: r.Director},
+		ReleaseDate: parseDate,
+		Credits:     creditFormsToDomainCredits(r.Casts),
+		Synopsis:    r.Synopsis,
+		Genres:      genres,
+	}, nil
+}
+
 // AddFilmResponse is a response for AddFilm.
 type AddFilmResponse struct {
 	Item ItemFilm `json:"item,omitempty"`
@@ -106,17 +110,24 @@ type AddFilmResponse struct {
 // Failed implements response.Failed.
 func (r AddFilmResponse) Failed() error { return r.Err }
 
+// Headers implements http.Headerer to return version of the film as ETag.
+func (r AddFilmResponse) Headers() http.Header { return filmETagHeaders(r.Item.Version) }
+
 // ItemFilm is a response for ViewFilm.
 type ItemFilm struct {
-	UUID        uuid.UUID `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Title       string    `json:"title" example:"Garry Potter"`
-	Director    string    `json:"director" example:"John Doe"`
-	Genres      []string  `json:"genres" example:"action,adventure,sci-fi"`
-	ReleaseDate string    `json:"release_date" example:"2021-01-01"`
-	Casts       []string  `json:"casts" example:"John Doe,Jane Doe,Foo Bar,Baz Quux"`
-	Synopsis    string    `json:"synopsis" example:"This is a synopsis."`
-	CreatedAt   string    `json:"created_at" example:"2021-01-01 00:00:00"`
-	UpdatedAt   string    `json:"updated_at" example:"2021-01-01 00:00:00"`
+	UUID        uuid.UUID    `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string       `json:"title" example:"Garry Potter"`
+	Director    string       `json:"director" example:"John Doe"`
+	Genres      []string     `json:"genres" example:"action,adventure,sci-fi"`
+	ReleaseDate string       `json:"release_date" example:"2021-01-01"`
+	Casts       []string     `json:"casts" example:"John Doe,Jane Doe,Foo Bar,Baz Quux"`
+	Credits     []ItemCredit `json:"credits"`
+	Synopsis    string       `json:"synopsis" example:"This is a synopsis."`
+	Rating      float64      `json:"rating" example:"7.5"`
+	RatingCount int64        `json:"rating_count" example:"12"`
+	Version     int          `json:"version" example:"1"`
+	CreatedAt   string       `json:"created_at" example:"2021-01-01 00:00:00"`
+	UpdatedAt   string       `json:"updated_at" example:"2021-01-01 00:00:00"`
 }
 
 // domainFilmToItemFilm is a method to convert domain Film to Item Film.
@@ -127,8 +138,12 @@ func domainFilmToItemFilm(item *models.Film) ItemFilm {
 		Director:    item.Director.Name,
 		Genres:      convertGenresToStrings(item.Genres),
 		ReleaseDate: item.ReleaseDate.Format(time.DateOnly),
-		Casts:       convertCastsToStrings(item.Casts),
+		Casts:       convertCastsToStrings(item.Credits),
+		Credits:     domainCreditsToItemCredits(item.Credits),
 		Synopsis:    item.Synopsis,
+		Rating:      item.Rating,
+		RatingCount: item.RatingCount,
+		Version:     item.Version,
 		CreatedAt:   time.Unix(item.CreatedAt, 0).Format(time.DateTime),
 		UpdatedAt:   time.Unix(item.UpdatedAt, 0).Format(time.DateTime),
 	}
@@ -0,0 +1,93 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// MakeAddGenreEndpoint is an endpoint for AddGenre.
+func MakeAddGenreEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(AddGenreRequest)
+		if !ok {
+			return AddGenreResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return AddGenreResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return AddGenreResponse{Err: err}, nil
+		}
+
+		// Prepare a genre model
+		model := &models.Genre{
+			Name: strings.ToLower(reqForm.Name),
+		}
+
+		// Add genre
+		if errAddGenre := s.AddGenre(ctx, model, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errAddGenre != nil {
+			return AddGenreResponse{Err: errAddGenre}, nil
+		}
+
+		return AddGenreResponse{
+			Item: domainGenreToItemGenre(*model),
+		}, nil
+	}
+}
+
+// AddGenreRequest is a request for Add genre.
+type AddGenreRequest struct {
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Name string `json:"name" validate:"required,min=3,max=100" example:"western"`
+}
+
+// Validate is a method to validate form.
+func (r *AddGenreRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// AddGenreResponse is a response for AddGenre.
+type AddGenreResponse struct {
+	Item ItemGenre `json:"item,omitempty"`
+	Err  error     `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r AddGenreResponse) Failed() error { return r.Err }
+
+// ItemGenre is a response item for genre.
+type ItemGenre struct {
+	ID         uint   `json:"id" example:"1"`
+	Name       string `json:"name" example:"western"`
+	FilmsCount int64  `json:"films_count" example:"12"`
+}
+
+// domainGenreToItemGenre is a method to convert domain Genre to Item Genre.
+func domainGenreToItemGenre(item models.Genre) ItemGenre {
+	return ItemGenre{
+		ID:         item.ID,
+		Name:       item.Name,
+		FilmsCount: item.FilmsCount,
+	}
+}
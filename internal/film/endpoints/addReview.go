@@ -0,0 +1,108 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MakeAddReviewEndpoint is an endpoint for AddReview.
+func MakeAddReviewEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(AddReviewRequest)
+		if !ok {
+			return AddReviewResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return AddReviewResponse{Err: errValidate}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return AddReviewResponse{Err: err}, nil
+		}
+
+		// Parse author UUID
+		parseAuthorUUID, err := uuid.Parse(reqForm.AuthorID)
+		if err != nil {
+			return AddReviewResponse{Err: err}, nil
+		}
+
+		// Prepare a review model
+		model := &models.Review{
+			FilmID:   parseFilmUUID,
+			AuthorID: parseAuthorUUID,
+			Text:     reqForm.Text,
+		}
+
+		// Add review
+		if errAddReview := s.AddReview(ctx, model); errAddReview != nil {
+			return AddReviewResponse{Err: errAddReview}, nil
+		}
+
+		return AddReviewResponse{
+			Item: domainReviewToItemReview(*model),
+		}, nil
+	}
+}
+
+// AddReviewRequest is a request for Add review.
+type AddReviewRequest struct {
+	FilmID   string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+	AuthorID string `json:"authorID" validate:"required,uuid4" swaggerignore:"true"`
+
+	Text string `json:"text" validate:"required,min=3,max=5000" example:"A great film with a brilliant cast."`
+}
+
+// Validate is a method to validate form.
+func (r *AddReviewRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// AddReviewResponse is a response for AddReview.
+type AddReviewResponse struct {
+	Item ItemReview `json:"item,omitempty"`
+	Err  error      `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r AddReviewResponse) Failed() error { return r.Err }
+
+// ItemReview is a response for review.
+type ItemReview struct {
+	UUID      uuid.UUID `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FilmUUID  uuid.UUID `json:"film_uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AuthorID  uuid.UUID `json:"author_uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Text      string    `json:"text" example:"A great film with a brilliant cast."`
+	Hidden    bool      `json:"hidden" example:"false"`
+	CreatedAt string    `json:"created_at" example:"2021-01-01 00:00:00"`
+	UpdatedAt string    `json:"updated_at" example:"2021-01-01 00:00:00"`
+}
+
+// domainReviewToItemReview is a method to convert domain Review to Item Review.
+func domainReviewToItemReview(item models.Review) ItemReview {
+	return ItemReview{
+		UUID:      item.UUID,
+		FilmUUID:  item.FilmID,
+		AuthorID:  item.AuthorID,
+		Text:      item.Text,
+		Hidden:    item.Hidden,
+		CreatedAt: time.Unix(item.CreatedAt, 0).Format(time.DateTime),
+		UpdatedAt: time.Unix(item.UpdatedAt, 0).Format(time.DateTime),
+	}
+}
@@ -0,0 +1,93 @@
+package endpoints
+
+import (
+	"bytes"
+	"film-management/internal/film/domain/models"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// CreditForm is a form for person credited in a film.
+// A plain string is accepted too and means an actor with this name.
+type CreditForm struct {
+	Name         string `json:"name" validate:"required,min=3,max=100" example:"Tim Robbins"`
+	Character    string `json:"character" validate:"omitempty,max=100" example:"Andy Dufresne"`
+	Department   string `json:"department" validate:"omitempty,oneof=cast writer producer composer" example:"cast"`
+	BillingOrder int    `json:"billing_order" validate:"omitempty,min=1,max=1000" example:"1"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler to accept a name as a string or a credit as an object.
+func (c *CreditForm) UnmarshalJSON(data []byte) error {
+	// Old format, only the name of actor
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return jsoniter.Unmarshal(data, &c.Name)
+	}
+
+	// Use an alias to avoid recursion
+	type creditForm CreditForm
+
+	return jsoniter.Unmarshal(data, (*creditForm)(c))
+}
+
+// ItemCredit is a response item for person credited in a film.
+type ItemCredit struct {
+	Name         string `json:"name" example:"Tim Robbins"`
+	Character    string `json:"character,omitempty" example:"Andy Dufresne"`
+	Department   string `json:"department" example:"cast"`
+	BillingOrder int    `json:"billing_order" example:"1"`
+}
+
+// creditFormsToDomainCredits is a function to convert credit forms to domain credits.
+// Department is cast by default and billing order is the position in the list by default.
+func creditFormsToDomainCredits(forms []CreditForm) []models.Credit {
+	credits := make([]models.Credit, 0, len(forms))
+
+	for i, form := range forms {
+		credit := models.Credit{
+			Cast:         models.Cast{Name: form.Name},
+			Character:    form.Character,
+			Department:   models.Department(form.Department),
+			BillingOrder: form.BillingOrder,
+		}
+
+		if credit.Department == "" {
+			credit.Department = models.DepartmentCast
+		}
+
+		if credit.BillingOrder == 0 {
+			credit.BillingOrder = i + 1
+		}
+
+		credits = append(credits, credit)
+	}
+
+	return credits
+}
+
+// domainCreditsToItemCredits is a function to convert domain credits to item credits.
+func domainCreditsToItemCredits(credits []models.Credit) []ItemCredit {
+	items := make([]ItemCredit, 0, len(credits))
+
+	for _, credit := range credits {
+		items = append(items, ItemCredit{
+			Name:         credit.Cast.Name,
+			Character:    credit.Character,
+			Department:   string(credit.Department),
+			BillingOrder: credit.BillingOrder,
+		})
+	}
+
+	return items
+}
+
+// convertCastsToStrings is a function to convert credits of actors to names.
+func convertCastsToStrings(credits []models.Credit) []string {
+	castNames := make([]string, 0, len(credits))
+
+	for _, credit := range credits {
+		if credit.Department == models.DepartmentCast {
+			castNames = append(castNames, credit.Cast.Name)
+		}
+	}
+
+	return castNames
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"film-management/internal/film/domain"
 	"film-management/pkg/errors"
+	"film-management/pkg/policy"
 	"film-management/pkg/validation"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
@@ -34,7 +35,7 @@ func MakeDeleteFilmEndpoint(s domain.Service) endpoint.Endpoint {
 		}
 
 		// Delete a film
-		if errDeleteFilm := s.DeleteFilm(ctx, parseUUID, parseCreatorUUID); errDeleteFilm != nil {
+		if errDeleteFilm := s.DeleteFilm(ctx, parseUUID, reqForm.Version, policy.NewActor(parseCreatorUUID, policy.Role(reqForm.CreatorRole))); errDeleteFilm != nil {
 			return DeleteFilmResponse{Err: errDeleteFilm}, nil
 		}
 
@@ -44,8 +45,10 @@ func MakeDeleteFilmEndpoint(s domain.Service) endpoint.Endpoint {
 
 // DeleteFilmRequest is a request for Delete Film.
 type DeleteFilmRequest struct {
-	UUID      string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
-	CreatorID string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
+	UUID        string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorID   string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorRole string `json:"creatorRole" swaggerignore:"true"`
+	Version     int    `json:"-" validate:"omitempty,min=1|eq=-1" swaggerignore:"true"`
 }
 
 // Validate is a method to validate form.
@@ -0,0 +1,75 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"strconv"
+)
+
+// MakeDeleteGenreEndpoint is an endpoint for DeleteGenre.
+func MakeDeleteGenreEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(DeleteGenreRequest)
+		if !ok {
+			return DeleteGenreResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return DeleteGenreResponse{Err: errValidate}, nil
+		}
+
+		// Parse genre ID
+		parseID, err := strconv.ParseUint(reqForm.ID, 10, 64)
+		if err != nil {
+			return DeleteGenreResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return DeleteGenreResponse{Err: err}, nil
+		}
+
+		// Delete a genre
+		if errDeleteGenre := s.DeleteGenre(ctx, uint(parseID), reqForm.Cascade == "true", policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errDeleteGenre != nil {
+			return DeleteGenreResponse{Err: errDeleteGenre}, nil
+		}
+
+		return DeleteGenreResponse{}, nil
+	}
+}
+
+// DeleteGenreRequest is a request for Delete genre.
+type DeleteGenreRequest struct {
+	ID       string `json:"id" validate:"required,numeric" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Cascade string `json:"cascade" validate:"omitempty,oneof=true false" example:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *DeleteGenreRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// DeleteGenreResponse is a response for DeleteGenre.
+type DeleteGenreResponse struct {
+	Err error `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r DeleteGenreResponse) Failed() error { return r.Err }
@@ -0,0 +1,79 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeDeleteReviewEndpoint is an endpoint for DeleteReview.
+func MakeDeleteReviewEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(DeleteReviewRequest)
+		if !ok {
+			return DeleteReviewResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return DeleteReviewResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return DeleteReviewResponse{Err: err}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return DeleteReviewResponse{Err: err}, nil
+		}
+
+		// Parse author UUID
+		parseAuthorUUID, err := uuid.Parse(reqForm.AuthorID)
+		if err != nil {
+			return DeleteReviewResponse{Err: err}, nil
+		}
+
+		// Delete a review
+		if errDeleteReview := s.DeleteReview(ctx, parseFilmUUID, parseUUID, policy.NewActor(parseAuthorUUID, policy.Role(reqForm.AuthorRole))); errDeleteReview != nil {
+			return DeleteReviewResponse{Err: errDeleteReview}, nil
+		}
+
+		return DeleteReviewResponse{}, nil
+	}
+}
+
+// DeleteReviewRequest is a request for Delete review.
+type DeleteReviewRequest struct {
+	UUID       string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	FilmID     string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+	AuthorID   string `json:"authorID" validate:"required,uuid4" swaggerignore:"true"`
+	AuthorRole string `json:"authorRole" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *DeleteReviewRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// DeleteReviewResponse is a response for DeleteReview.
+type DeleteReviewResponse struct {
+	Err error `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r DeleteReviewResponse) Failed() error { return r.Err }
@@ -0,0 +1,94 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeDiffRevisionsEndpoint is an endpoint for DiffRevisions.
+func MakeDiffRevisionsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(DiffRevisionsRequest)
+		if !ok {
+			return DiffRevisionsResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return DiffRevisionsResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return DiffRevisionsResponse{Err: err}, nil
+		}
+
+		if changes, errDiffRevisions := s.DiffRevisions(ctx, parseUUID, reqForm.From, reqForm.To); errDiffRevisions != nil {
+			return DiffRevisionsResponse{Err: errDiffRevisions}, nil
+		} else {
+			return DiffRevisionsResponse{
+				From:    reqForm.From,
+				To:      reqForm.To,
+				Changes: domainRevisionChangesToItemChanges(changes),
+			}, nil
+		}
+	}
+}
+
+// DiffRevisionsRequest is a request for DiffRevisions.
+type DiffRevisionsRequest struct {
+	UUID string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	From int    `json:"from" validate:"required,min=1" example:"1"`
+	To   int    `json:"to" validate:"required,min=1" example:"3"`
+}
+
+// Validate is a method to validate form.
+func (r *DiffRevisionsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// DiffRevisionsResponse is a response for DiffRevisions.
+type DiffRevisionsResponse struct {
+	From    int               `json:"from" example:"1"`
+	To      int               `json:"to" example:"3"`
+	Changes []ItemFieldChange `json:"changes"`
+	Err     error             `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r DiffRevisionsResponse) Failed() error { return r.Err }
+
+// ItemFieldChange is a response item for change of film field between revisions.
+type ItemFieldChange struct {
+	Field string      `json:"field" example:"title"`
+	From  interface{} `json:"from" swaggertype:"string" example:"Shawshank"`
+	To    interface{} `json:"to" swaggertype:"string" example:"The Shawshank Redemption"`
+}
+
+// domainRevisionChangesToItemChanges is a function to convert domain revision changes to item changes.
+func domainRevisionChangesToItemChanges(changes []models.RevisionChange) []ItemFieldChange {
+	items := make([]ItemFieldChange, 0, len(changes))
+
+	for _, change := range changes {
+		items = append(items, ItemFieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return items
+}
@@ -8,11 +8,29 @@ import (
 
 // SetEndpoints collects all the endpoints that compose an ad service.
 type SetEndpoints struct {
-	AddFilmEndpoint      endpoint.Endpoint
-	UpdateFilmEndpoint   endpoint.Endpoint
-	ViewFilmEndpoint     endpoint.Endpoint
-	ViewAllFilmsEndpoint endpoint.Endpoint
-	DeleteFilmEndpoint   endpoint.Endpoint
+	AddFilmEndpoint          endpoint.Endpoint
+	UpdateFilmEndpoint       endpoint.Endpoint
+	PatchFilmEndpoint        endpoint.Endpoint
+	ImportFilmsEndpoint      endpoint.Endpoint
+	ViewFilmEndpoint         endpoint.Endpoint
+	ViewAllFilmsEndpoint     endpoint.Endpoint
+	ExportFilmsEndpoint      endpoint.Endpoint
+	DeleteFilmEndpoint       endpoint.Endpoint
+	ViewTrashEndpoint        endpoint.Endpoint
+	RestoreFilmEndpoint      endpoint.Endpoint
+	ViewAllRevisionsEndpoint endpoint.Endpoint
+	DiffRevisionsEndpoint    endpoint.Endpoint
+	RollbackFilmEndpoint     endpoint.Endpoint
+	RateFilmEndpoint         endpoint.Endpoint
+	AddReviewEndpoint        endpoint.Endpoint
+	UpdateReviewEndpoint     endpoint.Endpoint
+	HideReviewEndpoint       endpoint.Endpoint
+	ViewAllReviewsEndpoint   endpoint.Endpoint
+	DeleteReviewEndpoint     endpoint.Endpoint
+	AddGenreEndpoint         endpoint.Endpoint
+	UpdateGenreEndpoint      endpoint.Endpoint
+	DeleteGenreEndpoint      endpoint.Endpoint
+	ViewAllGenresEndpoint    endpoint.Endpoint
 }
 
 // NewEndpoints returns a SetEndpoints that wraps the provided server, and wires in all the provided middlewares.
@@ -29,6 +47,18 @@ func NewEndpoints(s domain.Service, logger *zap.Logger) SetEndpoints {
 		updateFilmEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "UpdateFilm")))(updateFilmEndpoint)
 	}
 
+	var patchFilmEndpoint endpoint.Endpoint
+	{
+		patchFilmEndpoint = MakePatchFilmEndpoint(s)
+		patchFilmEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "PatchFilm")))(patchFilmEndpoint)
+	}
+
+	var importFilmsEndpoint endpoint.Endpoint
+	{
+		importFilmsEndpoint = MakeImportFilmsEndpoint(s)
+		importFilmsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ImportFilms")))(importFilmsEndpoint)
+	}
+
 	var viewFilmEndpoint endpoint.Endpoint
 	{
 		viewFilmEndpoint = MakeViewFilmEndpoint(s)
@@ -41,17 +71,131 @@ func NewEndpoints(s domain.Service, logger *zap.Logger) SetEndpoints {
 		viewAllFilmsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewAllFilms")))(viewAllFilmsEndpoint)
 	}
 
+	var exportFilmsEndpoint endpoint.Endpoint
+	{
+		exportFilmsEndpoint = MakeExportFilmsEndpoint(s)
+		exportFilmsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ExportFilms")))(exportFilmsEndpoint)
+	}
+
 	var deleteFilmEndpoint endpoint.Endpoint
 	{
 		deleteFilmEndpoint = MakeDeleteFilmEndpoint(s)
 		deleteFilmEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "DeleteFilm")))(deleteFilmEndpoint)
 	}
 
+	var viewTrashEndpoint endpoint.Endpoint
+	{
+		viewTrashEndpoint = MakeViewTrashEndpoint(s)
+		viewTrashEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewTrash")))(viewTrashEndpoint)
+	}
+
+	var restoreFilmEndpoint endpoint.Endpoint
+	{
+		restoreFilmEndpoint = MakeRestoreFilmEndpoint(s)
+		restoreFilmEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "RestoreFilm")))(restoreFilmEndpoint)
+	}
+
+	var viewAllRevisionsEndpoint endpoint.Endpoint
+	{
+		viewAllRevisionsEndpoint = MakeViewAllRevisionsEndpoint(s)
+		viewAllRevisionsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewAllRevisions")))(viewAllRevisionsEndpoint)
+	}
+
+	var diffRevisionsEndpoint endpoint.Endpoint
+	{
+		diffRevisionsEndpoint = MakeDiffRevisionsEndpoint(s)
+		diffRevisionsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "DiffRevisions")))(diffRevisionsEndpoint)
+	}
+
+	var rollbackFilmEndpoint endpoint.Endpoint
+	{
+		rollbackFilmEndpoint = MakeRollbackFilmEndpoint(s)
+		rollbackFilmEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "RollbackFilm")))(rollbackFilmEndpoint)
+	}
+
+	var rateFilmEndpoint endpoint.Endpoint
+	{
+		rateFilmEndpoint = MakeRateFilmEndpoint(s)
+		rateFilmEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "RateFilm")))(rateFilmEndpoint)
+	}
+
+	var addReviewEndpoint endpoint.Endpoint
+	{
+		addReviewEndpoint = MakeAddReviewEndpoint(s)
+		addReviewEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "AddReview")))(addReviewEndpoint)
+	}
+
+	var updateReviewEndpoint endpoint.Endpoint
+	{
+		updateReviewEndpoint = MakeUpdateReviewEndpoint(s)
+		updateReviewEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "UpdateReview")))(updateReviewEndpoint)
+	}
+
+	var hideReviewEndpoint endpoint.Endpoint
+	{
+		hideReviewEndpoint = MakeHideReviewEndpoint(s)
+		hideReviewEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "HideReview")))(hideReviewEndpoint)
+	}
+
+	var viewAllReviewsEndpoint endpoint.Endpoint
+	{
+		viewAllReviewsEndpoint = MakeViewAllReviewsEndpoint(s)
+		viewAllReviewsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewAllReviews")))(viewAllReviewsEndpoint)
+	}
+
+	var deleteReviewEndpoint endpoint.Endpoint
+	{
+		deleteReviewEndpoint = MakeDeleteReviewEndpoint(s)
+		deleteReviewEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "DeleteReview")))(deleteReviewEndpoint)
+	}
+
+	var addGenreEndpoint endpoint.Endpoint
+	{
+		addGenreEndpoint = MakeAddGenreEndpoint(s)
+		addGenreEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "AddGenre")))(addGenreEndpoint)
+	}
+
+	var updateGenreEndpoint endpoint.Endpoint
+	{
+		updateGenreEndpoint = MakeUpdateGenreEndpoint(s)
+		updateGenreEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "UpdateGenre")))(updateGenreEndpoint)
+	}
+
+	var deleteGenreEndpoint endpoint.Endpoint
+	{
+		deleteGenreEndpoint = MakeDeleteGenreEndpoint(s)
+		deleteGenreEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "DeleteGenre")))(deleteGenreEndpoint)
+	}
+
+	var viewAllGenresEndpoint endpoint.Endpoint
+	{
+		viewAllGenresEndpoint = MakeViewAllGenresEndpoint(s)
+		viewAllGenresEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewAllGenres")))(viewAllGenresEndpoint)
+	}
+
 	return SetEndpoints{
-		AddFilmEndpoint:      addFilmEndpoint,
-		UpdateFilmEndpoint:   updateFilmEndpoint,
-		ViewFilmEndpoint:     viewFilmEndpoint,
-		ViewAllFilmsEndpoint: viewAllFilmsEndpoint,
-		DeleteFilmEndpoint:   deleteFilmEndpoint,
+		AddFilmEndpoint:          addFilmEndpoint,
+		UpdateFilmEndpoint:       updateFilmEndpoint,
+		PatchFilmEndpoint:        patchFilmEndpoint,
+		ImportFilmsEndpoint:      importFilmsEndpoint,
+		ViewFilmEndpoint:         viewFilmEndpoint,
+		ViewAllFilmsEndpoint:     viewAllFilmsEndpoint,
+		ExportFilmsEndpoint:      exportFilmsEndpoint,
+		DeleteFilmEndpoint:       deleteFilmEndpoint,
+		ViewTrashEndpoint:        viewTrashEndpoint,
+		RestoreFilmEndpoint:      restoreFilmEndpoint,
+		ViewAllRevisionsEndpoint: viewAllRevisionsEndpoint,
+		DiffRevisionsEndpoint:    diffRevisionsEndpoint,
+		RollbackFilmEndpoint:     rollbackFilmEndpoint,
+		RateFilmEndpoint:         rateFilmEndpoint,
+		AddReviewEndpoint:        addReviewEndpoint,
+		UpdateReviewEndpoint:     updateReviewEndpoint,
+		HideReviewEndpoint:       hideReviewEndpoint,
+		ViewAllReviewsEndpoint:   viewAllReviewsEndpoint,
+		DeleteReviewEndpoint:     deleteReviewEndpoint,
+		AddGenreEndpoint:         addGenreEndpoint,
+		UpdateGenreEndpoint:      updateGenreEndpoint,
+		DeleteGenreEndpoint:      deleteGenreEndpoint,
+		ViewAllGenresEndpoint:    viewAllGenresEndpoint,
 	}
 }
@@ -0,0 +1,127 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/export"
+	"film-management/pkg/query"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"io"
+	"time"
+)
+
+// exportBatchSize is a number of films read from db at once during export.
+const exportBatchSize = 500
+
+// exportColumns is a list of columns of export.
+var exportColumns = []string{
+	"uuid", "title", "director", "genres", "release_date", "casts", "synopsis", "rating", "rating_count", "created_at", "updated_at",
+}
+
+// MakeExportFilmsEndpoint is an endpoint for ExportFilms.
+// The films are not read in the endpoint, they are streamed when the response is written.
+func MakeExportFilmsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ExportFilmsRequest)
+		if !ok {
+			return ExportFilmsResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ExportFilmsResponse{Err: errValidate}, nil
+		}
+
+		// Get sort, relevance is available for full-text search only
+		sortFields, sortByDefault := []string{"title", "release_date", "rating"}, "release_date.desc"
+		if reqForm.Q != "" {
+			sortFields, sortByDefault = append(sortFields, "relevance"), "relevance.desc"
+		}
+
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, sortFields, sortByDefault)
+		if err != nil {
+			return ExportFilmsResponse{Err: err}, nil
+		}
+
+		// Get filters, they are the same as filters of all films
+		myFilters, err := getFilterOptions(reqForm.FilmFilters)
+		if err != nil {
+			return ExportFilmsResponse{Err: err}, nil
+		}
+
+		// Build FilterSortLimit, the limit is a size of batch
+		filterSortLimit := query.NewFilterSortLimitBuilder().
+			SetSort(sortOption).
+			SetFilter(myFilters).
+			SetLimit(exportBatchSize).
+			Build()
+
+		return ExportFilmsResponse{
+			Format: reqForm.Format,
+			Write: func(w io.Writer) error {
+				writer, errWriter := export.NewWriter(reqForm.Format, w, exportColumns)
+				if errWriter != nil {
+					return errWriter
+				}
+
+				if errExport := s.ExportFilms(ctx, filterSortLimit, func(film models.Film) error {
+					return writer.Write(domainFilmToExportRow(film))
+				}); errExport != nil {
+					return errExport
+				}
+
+				return writer.Close()
+			},
+		}, nil
+	}
+}
+
+// ExportFilmsRequest is a request for ExportFilms.
+type ExportFilmsRequest struct {
+	Format string `json:"format" validate:"required,oneof=csv jsonl xlsx" example:"csv"`
+	Sort   string `json:"sort" validate:"omitempty,min=3,max=30" example:"title.asc"`
+	FilmFilters
+}
+
+// Validate is a method to validate form.
+func (r *ExportFilmsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ExportFilmsResponse is a response for ExportFilms.
+type ExportFilmsResponse struct {
+	Format string                  `json:"-"`
+	Write  func(w io.Writer) error `json:"-"`
+	Err    error                   `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ExportFilmsResponse) Failed() error { return r.Err }
+
+// domainFilmToExportRow is a function to convert domain film to a row of export in order of the columns.
+func domainFilmToExportRow(film models.Film) []interface{} {
+	return []interface{}{
+		film.UUID.String(),
+		film.Title,
+		film.Director.Name,
+		convertGenresToStrings(film.Genres),
+		film.ReleaseDate.Format(time.DateOnly),
+		convertCastsToStrings(film.Credits),
+		film.Synopsis,
+		film.Rating,
+		film.RatingCount,
+		time.Unix(film.CreatedAt, 0).Format(time.DateTime),
+		time.Unix(film.UpdatedAt, 0).Format(time.DateTime),
+	}
+}
@@ -0,0 +1,92 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeHideReviewEndpoint is an endpoint for HideReview.
+func MakeHideReviewEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(HideReviewRequest)
+		if !ok {
+			return HideReviewResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return HideReviewResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return HideReviewResponse{Err: err}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return HideReviewResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return HideReviewResponse{Err: err}, nil
+		}
+
+		// Prepare a review model
+		model := &models.Review{
+			UUID:   parseUUID,
+			FilmID: parseFilmUUID,
+			Hidden: reqForm.Hidden,
+		}
+
+		// Hide or show review
+		if errHideReview := s.HideReview(ctx, model, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errHideReview != nil {
+			return HideReviewResponse{Err: errHideReview}, nil
+		}
+
+		return HideReviewResponse{
+			Item: domainReviewToItemReview(*model),
+		}, nil
+	}
+}
+
+// HideReviewRequest is a request for Hide review.
+type HideReviewRequest struct {
+	UUID     string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	FilmID   string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Hidden bool `json:"hidden" example:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *HideReviewRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// HideReviewResponse is a response for HideReview.
+type HideReviewResponse struct {
+	Item ItemReview `json:"item,omitempty"`
+	Err  error      `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r HideReviewResponse) Failed() error { return r.Err }
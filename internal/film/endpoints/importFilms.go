@@ -0,0 +1,348 @@
+package endpoints
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"fmt"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// ImportFormatCSV is a format of import with a header and a film per row, lists are separated by "|".
+	ImportFormatCSV = "csv"
+	// ImportFormatJSONL is a format of import with a film per line in the form of adding film.
+	ImportFormatJSONL = "jsonl"
+
+	// maxImportRows is a maximum number of rows in one import.
+	maxImportRows = 10000
+	// maxImportLineSize is a maximum size of one line of JSONL import.
+	maxImportLineSize = 1024 * 1024
+	// importListSeparator is a separator of genres and casts in CSV import.
+	importListSeparator = "|"
+)
+
+// importCSVColumns is a list of columns of CSV import.
+var importCSVColumns = []string{"title", "director", "genres", "release_date", "casts", "synopsis"}
+
+// importRowForm is a form of film from a row of import.
+type importRowForm struct {
+	Row  int
+	Form AddFilmRequest
+	Err  error
+}
+
+// MakeImportFilmsEndpoint is an endpoint for ImportFilms.
+func MakeImportFilmsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ImportFilmsRequest)
+		if !ok {
+			return ImportFilmsResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ImportFilmsResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return ImportFilmsResponse{Err: err}, nil
+		}
+
+		// Parse rows of the file
+		forms, err := parseImportRows(reqForm.Format, reqForm.Data)
+		if err != nil {
+			return ImportFilmsResponse{Err: customError.ValidationError{Field: "file", Err: err}}, nil
+		}
+
+		// Validate rows with the rules of adding film, failed rows are reported and not imported
+		results := make([]models.ImportResult, 0, len(forms))
+		rows := make([]models.ImportRow, 0, len(forms))
+
+		for _, form := range forms {
+			if form.Err != nil {
+				results = append(results, models.NewImportResultFailed(form.Row, form.Form.Title, form.Err.Error()))
+
+				continue
+			}
+
+			form.Form.CreatorID = reqForm.UserID
+			form.Form.CreatorRole = reqForm.UserRole
+
+			if errValidate := form.Form.Validate(); errValidate != nil {
+				results = append(results, models.NewImportResultFailed(form.Row, form.Form.Title, validationErrorReason(errValidate)))
+
+				continue
+			}
+
+			model, errModel := form.Form.toDomainFilm()
+			if errModel != nil {
+				results = append(results, models.NewImportResultFailed(form.Row, form.Form.Title, errModel.Error()))
+
+				continue
+			}
+
+			rows = append(rows, models.ImportRow{Row: form.Row, Film: *model})
+		}
+
+		// Import films
+		imported, err := s.ImportFilms(ctx, rows, reqForm.DryRun == "true", policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole)))
+		if err != nil {
+			return ImportFilmsResponse{Err: err}, nil
+		}
+
+		results = append(results, imported...)
+		sort.SliceStable(results, func(i, j int) bool { return results[i].Row < results[j].Row })
+
+		return domainImportResultsToResponse(results, reqForm.DryRun == "true"), nil
+	}
+}
+
+// ImportFilmsRequest is a request for ImportFilms.
+type ImportFilmsRequest struct {
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+	Format   string `json:"format" validate:"required,oneof=csv jsonl" example:"csv"`
+	DryRun   string `json:"dry_run" validate:"omitempty,oneof=true false" example:"true"`
+	Data     []byte `json:"-" validate:"required" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *ImportFilmsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ImportFilmsResponse is a response for ImportFilms.
+type ImportFilmsResponse struct {
+	DryRun       bool            `json:"dry_run" example:"false"`
+	CreatedCount int             `json:"created" example:"98"`
+	SkippedCount int             `json:"skipped" example:"1"`
+	FailedCount  int             `json:"failed" example:"1"`
+	Rows         []ItemImportRow `json:"rows"`
+	Err          error           `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ImportFilmsResponse) Failed() error { return r.Err }
+
+// ItemImportRow is a response item for result of import of one row.
+type ItemImportRow struct {
+	Row    int    `json:"row" example:"2"`
+	Title  string `json:"title" example:"Garry Potter"`
+	Status string `json:"status" example:"failed"`
+	Reason string `json:"reason,omitempty" example:"Synopsis must be at least 10 characters in length"`
+	UUID   string `json:"uuid,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// domainImportResultsToResponse is a function to convert domain import results to the response with counts of statuses.
+func domainImportResultsToResponse(results []models.ImportResult, dryRun bool) ImportFilmsResponse {
+	resp := ImportFilmsResponse{
+		DryRun: dryRun,
+		Rows:   make([]ItemImportRow, 0, len(results)),
+	}
+
+	for _, result := range results {
+		item := ItemImportRow{
+			Row:    result.Row,
+			Title:  result.Title,
+			Status: string(result.Status),
+			Reason: result.Reason,
+		}
+
+		if result.FilmID != uuid.Nil {
+			item.UUID = result.FilmID.String()
+		}
+
+		switch result.Status {
+		case models.ImportStatusCreated:
+			resp.CreatedCount++
+		case models.ImportStatusSkipped:
+			resp.SkippedCount++
+		case models.ImportStatusFailed:
+			resp.FailedCount++
+		}
+
+		resp.Rows = append(resp.Rows, item)
+	}
+
+	return resp
+}
+
+// validationErrorReason is a function to get a readable reason of a row from validation errors.
+func validationErrorReason(err error) string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err.Error()
+	}
+
+	reasons := make([]string, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		reasons = append(reasons, fieldError.Translate(validation.GetTranslator()))
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// parseImportRows is a function to parse rows of the file of the format, a row is numbered by its line in the file.
+func parseImportRows(format string, data []byte) ([]importRowForm, error) {
+	var (
+		forms []importRowForm
+		err   error
+	)
+
+	switch format {
+	case ImportFormatCSV:
+		forms, err = parseImportCSV(data)
+	case ImportFormatJSONL:
+		forms, err = parseImportJSONL(data)
+	default:
+		return nil, fmt.Errorf("unknown format %s", format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(forms) > maxImportRows {
+		return nil, domain.ErrImportTooManyRows
+	}
+
+	return forms, nil
+}
+
+// parseImportCSV is a function to parse rows of CSV file with a header.
+func parseImportCSV(data []byte) ([]importRowForm, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	// Read columns of the header
+	header, err := reader.Read()
+	if err != nil {
+		return nil, domain.ErrImportHeader
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !containsString(importCSVColumns, column) {
+			return nil, errors.Wrap(domain.ErrImportColumn, column)
+		}
+
+		columns[column] = i
+	}
+
+	var forms []importRowForm
+
+	for {
+		record, errRead := reader.Read()
+		if errRead == io.EOF {
+			break
+		}
+
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		// Get value of the column in the row
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ""
+		}
+
+		form := AddFilmRequest{
+			Title:       value("title"),
+			Director:    value("director"),
+			Genres:      splitImportList(value("genres")),
+			ReleaseDate: value("release_date"),
+			Synopsis:    value("synopsis"),
+		}
+
+		for _, name := range splitImportList(value("casts")) {
+			form.Casts = append(form.Casts, CreditForm{Name: name})
+		}
+
+		forms = append(forms, importRowForm{Row: line, Form: form})
+	}
+
+	return forms, nil
+}
+
+// parseImportJSONL is a function to parse rows of JSONL file, empty lines are skipped.
+func parseImportJSONL(data []byte) ([]importRowForm, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	var forms []importRowForm
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		form := importRowForm{Row: line}
+		if err := jsoniter.Unmarshal(text, &form.Form); err != nil {
+			form.Err = domain.ErrImportRowWrongJSON
+		}
+
+		forms = append(forms, form)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return forms, nil
+}
+
+// splitImportList is a function to split a list of CSV import, empty items are skipped.
+func splitImportList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, importListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// containsString is a function to check that the slice contains the string.
+func containsString(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+
+	return false
+}
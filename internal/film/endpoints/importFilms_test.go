@@ -0,0 +1,307 @@
+package endpoints_test
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/internal/film/endpoints"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	querySort "film-management/pkg/query/sort"
+	"film-management/repositories/storage/memory"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"strings"
+	"testing"
+	"time"
+)
+
+const importCSVHeader = "title,director,genres,release_date,casts,synopsis\n"
+
+func TestImportFilmsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	userID := uuid.New().String()
+
+	type in struct {
+		format string
+		dryRun string
+		data   string
+		role   policy.Role
+	}
+
+	type out struct {
+		rows   []endpoints.ItemImportRow
+		titles []string
+		err    error
+	}
+
+	tests := []struct {
+		name string
+		in   in
+		out  out
+	}{
+		{
+			name: "CSV",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data: "\xef\xbb\xbf" + importCSVHeader +
+					"Alpha, John Doe, Drama|comedy, 2020-01-01, Jane Roe|Joe Bloggs, Synopsis of Alpha\n" +
+					"\"Bravo, the film\",John Doe,drama,2021-02-03,Jane Roe,\"Synopsis of Bravo\non two lines\"\n" +
+					"Charlie,John Doe,drama,2022-03-04,Jane Roe,Synopsis of Charlie\n",
+			},
+			out: out{
+				rows: []endpoints.ItemImportRow{
+					{Row: 2, Title: "Alpha", Status: "created"},
+					{Row: 3, Title: "Bravo, the film", Status: "created"},
+					{Row: 5, Title: "Charlie", Status: "created"},
+				},
+				titles: []string{"Alpha", "Bravo, the film", "Charlie", "Existing film"},
+			},
+		},
+		{
+			name: "CSV with columns in any order",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data: "Synopsis,Title,Casts,Genres,Release_Date,Director\n" +
+					"Synopsis of Alpha,Alpha,Jane Roe,drama,2020-01-01,John Doe\n",
+			},
+			out: out{
+				rows:   []endpoints.ItemImportRow{{Row: 2, Title: "Alpha", Status: "created"}},
+				titles: []string{"Alpha", "Existing film"},
+			},
+		},
+		{
+			name: "JSONL",
+			in: in{
+				format: endpoints.ImportFormatJSONL,
+				data: `{"title":"Alpha","director":"John Doe","releaseDate":"2020-01-01","genres":["drama"],"casts":[{"name":"Jane Roe"}],"synopsis":"Synopsis of Alpha"}` + "\n" +
+					"\n" +
+					`{"title":"Bravo","director":"John Doe","releaseDate":"2021-01-01","genres":["comedy"],"casts":[{"name":"Jane Roe","character":"Herself"}],"synopsis":"Synopsis of Bravo"}`,
+			},
+			out: out{
+				rows: []endpoints.ItemImportRow{
+					{Row: 1, Title: "Alpha", Status: "created"},
+					{Row: 3, Title: "Bravo", Status: "created"},
+				},
+				titles: []string{"Alpha", "Bravo", "Existing film"},
+			},
+		},
+		{
+			name: "Bad rows",
+			in: in{
+				format: endpoints.ImportFormatJSONL,
+				data: `{"title":"Alpha","director":"John Doe","releaseDate":"2020-01-01","genres":["drama"],"casts":[{"name":"Jane Roe"}],"synopsis":"Short"}` + "\n" +
+					`{"title":` + "\n" +
+					`{"title":"Bravo","director":"John Doe","releaseDate":"2021-13-01","genres":["drama"],"casts":[{"name":"Jane Roe"}],"synopsis":"Synopsis of Bravo"}` + "\n" +
+					`{"title":"Charlie","director":"John Doe","releaseDate":"2021-01-01","genres":["western"],"casts":[{"name":"Jane Roe"}],"synopsis":"Synopsis of Charlie"}` + "\n" +
+					`{"title":"Delta","director":"John Doe","releaseDate":"2021-01-01","genres":["drama"],"casts":[{"name":"Jane Roe"}],"synopsis":"Synopsis of Delta"}`,
+			},
+			out: out{
+				rows: []endpoints.ItemImportRow{
+					{Row: 1, Title: "Alpha", Status: "failed", Reason: "Synopsis must be at least 10 characters in length"},
+					{Row: 2, Status: "failed", Reason: domain.ErrImportRowWrongJSON.Error()},
+					{Row: 3, Title: "Bravo", Status: "failed", Reason: "ReleaseDate must be valid (YYYY-MM-DD)"},
+					{Row: 4, Title: "Charlie", Status: "failed", Reason: "field genres: err genre western does not exist in the database"},
+					{Row: 5, Title: "Delta", Status: "created"},
+				},
+				titles: []string{"Delta", "Existing film"},
+			},
+		},
+		{
+			name: "Duplicate titles",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data: importCSVHeader +
+					"Alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n" +
+					"alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n" +
+					"Existing Film,John Doe,drama,2020-01-01,Jane Roe,Synopsis of the film\n",
+			},
+			out: out{
+				rows: []endpoints.ItemImportRow{
+					{Row: 2, Title: "Alpha", Status: "created"},
+					{Row: 3, Title: "alpha", Status: "skipped", Reason: domain.ErrFilmExistsWithTitle.Error()},
+					{Row: 4, Title: "Existing Film", Status: "skipped", Reason: domain.ErrFilmExistsWithTitle.Error()},
+				},
+				titles: []string{"Alpha", "Existing film"},
+			},
+		},
+		{
+			name: "Dry run",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				dryRun: "true",
+				data: importCSVHeader +
+					"Alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n" +
+					"Existing film,John Doe,drama,2020-01-01,Jane Roe,Synopsis of the film\n",
+			},
+			out: out{
+				rows: []endpoints.ItemImportRow{
+					{Row: 2, Title: "Alpha", Status: "created"},
+					{Row: 3, Title: "Existing film", Status: "skipped", Reason: domain.ErrFilmExistsWithTitle.Error()},
+				},
+				titles: []string{"Existing film"},
+			},
+		},
+		{
+			name: "CSV without header",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data:   "",
+			},
+			out: out{
+				titles: []string{"Existing film"},
+				err:    customError.ValidationError{Field: "file", Err: domain.ErrImportHeader},
+			},
+		},
+		{
+			name: "CSV with unknown column",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data:   "title,budget\nAlpha,100\n",
+			},
+			out: out{
+				titles: []string{"Existing film"},
+				err:    customError.ValidationError{Field: "file", Err: errors.Wrap(domain.ErrImportColumn, "budget")},
+			},
+		},
+		{
+			name: "Too many rows",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data:   importCSVHeader + strings.Repeat("Alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n", 10001),
+			},
+			out: out{
+				titles: []string{"Existing film"},
+				err:    customError.ValidationError{Field: "file", Err: domain.ErrImportTooManyRows},
+			},
+		},
+		{
+			name: "Viewer",
+			in: in{
+				format: endpoints.ImportFormatCSV,
+				data:   importCSVHeader + "Alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n",
+				role:   policy.RoleViewer,
+			},
+			out: out{
+				titles: []string{"Existing film"},
+				err:    customError.PermissionError{Err: domain.ErrFilmNotCreatePermission},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			requireAssert := require.New(t)
+
+			role := test.in.role
+			if role == "" {
+				role = policy.RoleEditor
+			}
+
+			service := newFilmService(t)
+
+			response, err := endpoints.MakeImportFilmsEndpoint(service)(ctx, endpoints.ImportFilmsRequest{
+				UserID:   userID,
+				UserRole: string(role),
+				Format:   test.in.format,
+				DryRun:   test.in.dryRun,
+				Data:     []byte(test.in.data),
+			})
+			requireAssert.NoError(err)
+
+			resp, ok := response.(endpoints.ImportFilmsResponse)
+			requireAssert.True(ok)
+
+			if test.out.err != nil {
+				requireAssert.EqualError(resp.Failed(), test.out.err.Error())
+			} else {
+				requireAssert.NoError(resp.Failed())
+				requireAssert.Equal(test.in.dryRun == "true", resp.DryRun)
+				requireAssert.Equal(test.out.rows, withoutUUIDs(t, resp.Rows, test.in.dryRun == "true"))
+
+				var created, skipped, failed int
+
+				for _, row := range test.out.rows {
+					switch row.Status {
+					case "created":
+						created++
+					case "skipped":
+						skipped++
+					case "failed":
+						failed++
+					}
+				}
+
+				requireAssert.Equal(created, resp.CreatedCount)
+				requireAssert.Equal(skipped, resp.SkippedCount)
+				requireAssert.Equal(failed, resp.FailedCount)
+			}
+
+			sortOption, err := querySort.GetSortOptions("title.asc", []string{"title"}, "title.asc")
+			requireAssert.NoError(err)
+
+			films, _, err := service.ViewAllFilms(ctx, query.FilterSortLimit{Sort: sortOption, Limit: 100})
+			requireAssert.NoError(err)
+
+			titles := make([]string, 0, len(films))
+			for _, film := range films {
+				titles = append(titles, film.Title)
+			}
+
+			requireAssert.Equal(test.out.titles, titles)
+		})
+	}
+}
+
+// withoutUUIDs is a function to check that created rows have UUIDs of films unless it is a dry run and clear them.
+func withoutUUIDs(t *testing.T, rows []endpoints.ItemImportRow, dryRun bool) []endpoints.ItemImportRow {
+	t.Helper()
+
+	result := make([]endpoints.ItemImportRow, 0, len(rows))
+
+	for _, row := range rows {
+		if row.Status == "created" && !dryRun {
+			_, err := uuid.Parse(row.UUID)
+			require.NoError(t, err)
+		} else {
+			require.Empty(t, row.UUID)
+		}
+
+		row.UUID = ""
+		result = append(result, row)
+	}
+
+	return result
+}
+
+// newFilmService is a function to create a service of films in memory with genres drama and comedy and a film titled "Existing film".
+func newFilmService(t *testing.T) domain.Service {
+	t.Helper()
+
+	ctx := context.TODO()
+	admin := policy.NewActor(uuid.New(), policy.RoleAdmin)
+	service := domain.NewService(memory.NewFilmRepository(memory.NewStore(), zap.NewNop()))
+
+	require.NoError(t, service.AddGenre(ctx, &models.Genre{Name: "drama"}, admin))
+	require.NoError(t, service.AddGenre(ctx, &models.Genre{Name: "comedy"}, admin))
+
+	film := models.Film{
+		Title:       "Existing film",
+		Director:    models.Director{Name: "John Doe"},
+		Genres:      []models.Genre{{Name: "drama"}},
+		Credits:     []models.Credit{{Cast: models.Cast{Name: "Jane Roe"}, Department: models.DepartmentCast}},
+		Synopsis:    "Synopsis of the film",
+		ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, service.AddFilm(ctx, &film, admin))
+
+	return service
+}
@@ -0,0 +1,233 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/patch"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// strictJSON is a JSON config to decode patched film, fields unknown to the form can not be patched.
+var strictJSON = jsoniter.Config{DisallowUnknownFields: true}.Froze()
+
+// MakePatchFilmEndpoint is an endpoint for PatchFilm.
+func MakePatchFilmEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(PatchFilmRequest)
+		if !ok {
+			return PatchFilmResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return PatchFilmResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return PatchFilmResponse{Err: err}, nil
+		}
+
+		// Parse creator UUID
+		parseCreatorUUID, err := uuid.Parse(reqForm.CreatorID)
+		if err != nil {
+			return PatchFilmResponse{Err: err}, nil
+		}
+
+		// Get the current film to apply the patch to its fields
+		filmFromDB, err := s.ViewFilm(ctx, parseUUID)
+		if err != nil {
+			return PatchFilmResponse{Err: err}, nil
+		}
+
+		currentForm := domainFilmToFilmPatchForm(filmFromDB)
+
+		// Apply the patch
+		patchedForm, err := applyFilmPatch(currentForm, reqForm.ContentType, reqForm.Patch)
+		if err != nil {
+			return PatchFilmResponse{Err: customError.ValidationError{Field: "patch", Err: err}}, nil
+		}
+
+		// Validate only the changed fields
+		changedFields := currentForm.changedFields(patchedForm)
+		if len(changedFields) > 0 {
+			if errValidate := patchedForm.Validate(changedFields...); errValidate != nil {
+				return PatchFilmResponse{Err: errValidate}, nil
+			}
+		}
+
+		// Prepare a film patch model
+		model, err := patchedForm.toDomainFilmPatch(changedFields)
+		if err != nil {
+			return PatchFilmResponse{Err: err}, nil
+		}
+
+		model.UUID = parseUUID
+		model.Version = reqForm.Version
+
+		if item, errPatchFilm := s.PatchFilm(ctx, model, policy.NewActor(parseCreatorUUID, policy.Role(reqForm.CreatorRole))); errPatchFilm != nil {
+			return PatchFilmResponse{Err: errPatchFilm}, nil
+		} else {
+			return PatchFilmResponse{
+				Item: domainFilmToItemViewFilm(item),
+			}, nil
+		}
+	}
+}
+
+// PatchFilmRequest is a request for PatchFilm.
+type PatchFilmRequest struct {
+	UUID        string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorID   string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorRole string `json:"creatorRole" swaggerignore:"true"`
+	Version     int    `json:"-" validate:"omitempty,min=1|eq=-1" swaggerignore:"true"`
+	ContentType string `json:"-" validate:"required" swaggerignore:"true"`
+	Patch       []byte `json:"-" validate:"required" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *PatchFilmRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// FilmPatchForm is a form of film fields which the patch is applied to.
+type FilmPatchForm struct {
+	Title       string       `json:"title" validate:"required,min=3,max=100" example:"Garry Potter"`
+	Director    string       `json:"director" validate:"required,min=3,max=40" example:"John Doe"`
+	Genres      []string     `json:"genres" validate:"required,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
+	ReleaseDate string       `json:"releaseDate" validate:"required,customDate" example:"2021-01-01"`
+	Casts       []CreditForm `json:"casts" validate:"required,min=1,max=50,dive"`
+	Synopsis    string       `json:"synopsis" validate:"required,min=10,max=1000" example:"This is a synopsis."`
+}
+
+// Validate is a method to validate only the fields of form.
+func (r *FilmPatchForm) Validate(fields ...string) error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.ValidatePartial(r, fields...)
+}
+
+// changedFields returns names of the form fields changed in the patched form.
+func (r *FilmPatchForm) changedFields(patched FilmPatchForm) []string {
+	current := reflect.ValueOf(*r)
+	changed := reflect.ValueOf(patched)
+	fields := make([]string, 0, current.NumField())
+
+	for i := 0; i < current.NumField(); i++ {
+		if !reflect.DeepEqual(current.Field(i).Interface(), changed.Field(i).Interface()) {
+			fields = append(fields, current.Type().Field(i).Name)
+		}
+	}
+
+	return fields
+}
+
+// toDomainFilmPatch is a method to convert the changed fields of form to domain FilmPatch.
+func (r *FilmPatchForm) toDomainFilmPatch(fields []string) (models.FilmPatch, error) {
+	var filmPatch models.FilmPatch
+
+	for _, field := range fields {
+		switch field {
+		case "Title":
+			filmPatch.Title = &r.Title
+		case "Director":
+			filmPatch.Director = &models.Director{Name: r.Director}
+		case "Genres":
+			filmPatch.Genres = make([]models.Genre, 0, len(r.Genres))
+			for _, genreName := range r.Genres {
+				filmPatch.Genres = append(filmPatch.Genres, models.Genre{Name: strings.ToLower(genreName)})
+			}
+		case "ReleaseDate":
+			parseDate, err := time.Parse(time.DateOnly, r.ReleaseDate)
+			if err != nil {
+				return models.FilmPatch{}, err
+			}
+
+			filmPatch.ReleaseDate = &parseDate
+		case "Casts":
+			filmPatch.Credits = creditFormsToDomainCredits(r.Casts)
+		case "Synopsis":
+			filmPatch.Synopsis = &r.Synopsis
+		}
+	}
+
+	return filmPatch, nil
+}
+
+// domainFilmToFilmPatchForm is a function to convert domain Film to FilmPatchForm.
+func domainFilmToFilmPatchForm(film models.Film) FilmPatchForm {
+	form := FilmPatchForm{
+		Title:       film.Title,
+		Director:    film.Director.Name,
+		Genres:      convertGenresToStrings(film.Genres),
+		ReleaseDate: film.ReleaseDate.Format(time.DateOnly),
+		Casts:       make([]CreditForm, 0, len(film.Credits)),
+		Synopsis:    film.Synopsis,
+	}
+
+	for _, credit := range film.Credits {
+		form.Casts = append(form.Casts, CreditForm{
+			Name:         credit.Cast.Name,
+			Character:    credit.Character,
+			Department:   string(credit.Department),
+			BillingOrder: credit.BillingOrder,
+		})
+	}
+
+	return form
+}
+
+// applyFilmPatch is a function to apply the patch of the content type to the film form.
+func applyFilmPatch(form FilmPatchForm, contentType string, filmPatch []byte) (FilmPatchForm, error) {
+	doc, err := jsoniter.Marshal(form)
+	if err != nil {
+		return FilmPatchForm{}, err
+	}
+
+	patchedDoc, err := patch.Apply(contentType, doc, filmPatch)
+	if err != nil {
+		return FilmPatchForm{}, err
+	}
+
+	var patchedForm FilmPatchForm
+	if err = strictJSON.Unmarshal(patchedDoc, &patchedForm); err != nil {
+		return FilmPatchForm{}, domain.ErrFilmPatchWrong
+	}
+
+	return patchedForm, nil
+}
+
+// PatchFilmResponse is a response for PatchFilm.
+type PatchFilmResponse struct {
+	Item ItemViewFilm `json:"item,omitempty"`
+	Err  error        `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r PatchFilmResponse) Failed() error { return r.Err }
+
+// Headers implements http.Headerer to return version of the film as ETag.
+func (r PatchFilmResponse) Headers() http.Header { return filmETagHeaders(r.Item.Version) }
@@ -0,0 +1,97 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeRateFilmEndpoint is an endpoint for RateFilm.
+func MakeRateFilmEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(RateFilmRequest)
+		if !ok {
+			return RateFilmResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return RateFilmResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return RateFilmResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return RateFilmResponse{Err: err}, nil
+		}
+
+		// Prepare a rating model
+		model := &models.Rating{
+			FilmID: parseUUID,
+			UserID: parseUserUUID,
+			Score:  reqForm.Score,
+		}
+
+		// Rate a film
+		film, errRateFilm := s.RateFilm(ctx, model)
+		if errRateFilm != nil {
+			return RateFilmResponse{Err: errRateFilm}, nil
+		}
+
+		return RateFilmResponse{
+			Item: ItemRating{
+				FilmUUID:    film.UUID,
+				Score:       model.Score,
+				Rating:      film.Rating,
+				RatingCount: film.RatingCount,
+			},
+		}, nil
+	}
+}
+
+// RateFilmRequest is a request for Rate film.
+type RateFilmRequest struct {
+	UUID   string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	UserID string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+
+	Score int `json:"score" validate:"required,min=1,max=10" example:"8"`
+}
+
+// Validate is a method to validate form.
+func (r *RateFilmRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// RateFilmResponse is a response for RateFilm.
+type RateFilmResponse struct {
+	Item ItemRating `json:"item,omitempty"`
+	Err  error      `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r RateFilmResponse) Failed() error { return r.Err }
+
+// ItemRating is a response for RateFilm.
+type ItemRating struct {
+	FilmUUID    uuid.UUID `json:"film_uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Score       int       `json:"score" example:"8"`
+	Rating      float64   `json:"rating" example:"7.5"`
+	RatingCount int64     `json:"rating_count" example:"12"`
+}
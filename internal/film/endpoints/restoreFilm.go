@@ -0,0 +1,79 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+// MakeRestoreFilmEndpoint is an endpoint for RestoreFilm.
+func MakeRestoreFilmEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(RestoreFilmRequest)
+		if !ok {
+			return RestoreFilmResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return RestoreFilmResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return RestoreFilmResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return RestoreFilmResponse{Err: err}, nil
+		}
+
+		// Restore a film
+		if item, errRestoreFilm := s.RestoreFilm(ctx, parseUUID, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errRestoreFilm != nil {
+			return RestoreFilmResponse{Err: errRestoreFilm}, nil
+		} else {
+			return RestoreFilmResponse{
+				Item: domainFilmToItemViewFilm(item),
+			}, nil
+		}
+	}
+}
+
+// RestoreFilmRequest is a request for RestoreFilm.
+type RestoreFilmRequest struct {
+	UUID     string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *RestoreFilmRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// RestoreFilmResponse is a response for RestoreFilm.
+type RestoreFilmResponse struct {
+	Item ItemViewFilm `json:"item,omitempty"`
+	Err  error        `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r RestoreFilmResponse) Failed() error { return r.Err }
+
+// Headers implements http.Headerer to return version of the film as ETag.
+func (r RestoreFilmResponse) Headers() http.Header { return filmETagHeaders(r.Item.Version) }
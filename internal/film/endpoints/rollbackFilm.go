@@ -0,0 +1,87 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"net/http"
+	"strconv"
+)
+
+// MakeRollbackFilmEndpoint is an endpoint for RollbackFilm.
+func MakeRollbackFilmEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(RollbackFilmRequest)
+		if !ok {
+			return RollbackFilmResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return RollbackFilmResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return RollbackFilmResponse{Err: err}, nil
+		}
+
+		// Parse revision number
+		parseNumber, err := strconv.Atoi(reqForm.Number)
+		if err != nil {
+			return RollbackFilmResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return RollbackFilmResponse{Err: err}, nil
+		}
+
+		// Rollback a film
+		if item, errRollbackFilm := s.RollbackFilm(ctx, parseUUID, parseNumber, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errRollbackFilm != nil {
+			return RollbackFilmResponse{Err: errRollbackFilm}, nil
+		} else {
+			return RollbackFilmResponse{
+				Item: domainFilmToItemViewFilm(item),
+			}, nil
+		}
+	}
+}
+
+// RollbackFilmRequest is a request for RollbackFilm.
+type RollbackFilmRequest struct {
+	UUID     string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	Number   string `json:"number" validate:"required,numeric" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *RollbackFilmRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// RollbackFilmResponse is a response for RollbackFilm.
+type RollbackFilmResponse struct {
+	Item ItemViewFilm `json:"item,omitempty"`
+	Err  error        `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r RollbackFilmResponse) Failed() error { return r.Err }
+
+// Headers implements http.Headerer to return version of the film as ETag.
+func (r RollbackFilmResponse) Headers() http.Header { return filmETagHeaders(r.Item.Version) }
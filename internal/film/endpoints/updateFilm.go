@@ -5,9 +5,11 @@ import (
 	"film-management/internal/film/domain"
 	"film-management/internal/film/domain/models"
 	"film-management/pkg/errors"
+	"film-management/pkg/policy"
 	"film-management/pkg/validation"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -50,13 +52,6 @@ func MakeUpdateFilmEndpoint(s domain.Service) endpoint.Endpoint {
 			genres = append(genres, genre)
 		}
 
-		// Prepare casts
-		var casts []models.Cast
-		for _, castName := range reqForm.Casts {
-			cast := models.Cast{Name: castName}
-			casts = append(casts, cast)
-		}
-
 		// Prepare a film model
 		model := &models.Film{
 			UUID:        parseUUID,
@@ -64,12 +59,13 @@ func MakeUpdateFilmEndpoint(s domain.Service) endpoint.Endpoint {
 			Title:       reqForm.Title,
 			Director:    models.Director{Name: reqForm.Director},
 			ReleaseDate: parseDate,
-			Casts:       casts,
+			Credits:     creditFormsToDomainCredits(reqForm.Casts),
 			Synopsis:    reqForm.Synopsis,
 			Genres:      genres,
+			Version:     reqForm.Version,
 		}
 
-		if errUpdateFilm := s.UpdateFilm(ctx, model); errUpdateFilm != nil {
+		if errUpdateFilm := s.UpdateFilm(ctx, model, policy.NewActor(parseCreatorUUID, policy.Role(reqForm.CreatorRole))); errUpdateFilm != nil {
 			return UpdateFilmResponse{Err: errUpdateFilm}, nil
 		}
 
@@ -81,15 +77,17 @@ func MakeUpdateFilmEndpoint(s domain.Service) endpoint.Endpoint {
 
 // UpdateFilmRequest is a request for Update Film.
 type UpdateFilmRequest struct {
-	UUID      string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
-	CreatorID string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
-
-	Title       string   `json:"title" validate:"required,min=3,max=100" example:"Garry Potter"`
-	Director    string   `json:"director" validate:"required,min=3,max=40" example:"John Doe"`
-	Genres      []string `json:"genres" validate:"required,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
-	ReleaseDate string   `json:"releaseDate" validate:"required,customDate" example:"2021-01-01"`
-	Casts       []string `json:"casts" validate:"required,min=1,max=10,dive,min=3,max=100" example:"John Doe, Jane Doe, Foo Bar, Baz Quux"`
-	Synopsis    string   `json:"synopsis" validate:"required,min=10,max=1000" example:"This is a synopsis."`
+	UUID        string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorID   string `json:"creatorID" validate:"required,uuid4" swaggerignore:"true"`
+	CreatorRole string `json:"creatorRole" swaggerignore:"true"`
+	Version     int    `json:"-" validate:"omitempty,min=1|eq=-1" swaggerignore:"true"`
+
+	Title       string       `json:"title" validate:"required,min=3,max=100" example:"Garry Potter"`
+	Director    string       `json:"director" validate:"required,min=3,max=40" example:"John Doe"`
+	Genres      []string     `json:"genres" validate:"required,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
+	ReleaseDate string       `json:"releaseDate" validate:"required,customDate" example:"2021-01-01"`
+	Casts       []CreditForm `json:"casts" validate:"required,min=1,max=50,dive"`
+	Synopsis    string       `json:"synopsis" validate:"required,min=10,max=1000" example:"This is a synopsis."`
 }
 
 // Validate is a method to validate form.
@@ -112,3 +110,6 @@ type UpdateFilmResponse struct {
 
 // Failed implements response.Failed.
 func (r UpdateFilmResponse) Failed() error { return r.Err }
+
+// Headers implements http.Headerer to return version of the film as ETag.
+func (r UpdateFilmResponse) Headers() http.Header { return filmETagHeaders(r.Item.Version) }
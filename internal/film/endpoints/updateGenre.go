@@ -0,0 +1,86 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+)
+
+// MakeUpdateGenreEndpoint is an endpoint for UpdateGenre.
+func MakeUpdateGenreEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(UpdateGenreRequest)
+		if !ok {
+			return UpdateGenreResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return UpdateGenreResponse{Err: errValidate}, nil
+		}
+
+		// Parse genre ID
+		parseID, err := strconv.ParseUint(reqForm.ID, 10, 64)
+		if err != nil {
+			return UpdateGenreResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return UpdateGenreResponse{Err: err}, nil
+		}
+
+		// Prepare a genre model
+		model := &models.Genre{
+			ID:   uint(parseID),
+			Name: strings.ToLower(reqForm.Name),
+		}
+
+		// Update genre
+		if errUpdateGenre := s.UpdateGenre(ctx, model, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole))); errUpdateGenre != nil {
+			return UpdateGenreResponse{Err: errUpdateGenre}, nil
+		}
+
+		return UpdateGenreResponse{
+			Item: domainGenreToItemGenre(*model),
+		}, nil
+	}
+}
+
+// UpdateGenreRequest is a request for Update genre.
+type UpdateGenreRequest struct {
+	ID       string `json:"id" validate:"required,numeric" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Name string `json:"name" validate:"required,min=3,max=100" example:"western"`
+}
+
+// Validate is a method to validate form.
+func (r *UpdateGenreRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// UpdateGenreResponse is a response for UpdateGenre.
+type UpdateGenreResponse struct {
+	Item ItemGenre `json:"item,omitempty"`
+	Err  error     `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r UpdateGenreResponse) Failed() error { return r.Err }
@@ -0,0 +1,93 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeUpdateReviewEndpoint is an endpoint for UpdateReview.
+func MakeUpdateReviewEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(UpdateReviewRequest)
+		if !ok {
+			return UpdateReviewResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return UpdateReviewResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return UpdateReviewResponse{Err: err}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return UpdateReviewResponse{Err: err}, nil
+		}
+
+		// Parse author UUID
+		parseAuthorUUID, err := uuid.Parse(reqForm.AuthorID)
+		if err != nil {
+			return UpdateReviewResponse{Err: err}, nil
+		}
+
+		// Prepare a review model
+		model := &models.Review{
+			UUID:     parseUUID,
+			FilmID:   parseFilmUUID,
+			AuthorID: parseAuthorUUID,
+			Text:     reqForm.Text,
+		}
+
+		// Update review
+		if errUpdateReview := s.UpdateReview(ctx, model, policy.NewActor(parseAuthorUUID, policy.Role(reqForm.AuthorRole))); errUpdateReview != nil {
+			return UpdateReviewResponse{Err: errUpdateReview}, nil
+		}
+
+		return UpdateReviewResponse{
+			Item: domainReviewToItemReview(*model),
+		}, nil
+	}
+}
+
+// UpdateReviewRequest is a request for Update review.
+type UpdateReviewRequest struct {
+	UUID       string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+	FilmID     string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+	AuthorID   string `json:"authorID" validate:"required,uuid4" swaggerignore:"true"`
+	AuthorRole string `json:"authorRole" swaggerignore:"true"`
+
+	Text string `json:"text" validate:"required,min=3,max=5000" example:"A great film with a brilliant cast."`
+}
+
+// Validate is a method to validate form.
+func (r *UpdateReviewRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// UpdateReviewResponse is a response for UpdateReview.
+type UpdateReviewResponse struct {
+	Item ItemReview `json:"item,omitempty"`
+	Err  error      `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r UpdateReviewResponse) Failed() error { return r.Err }
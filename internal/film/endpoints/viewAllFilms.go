@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -30,59 +31,52 @@ func MakeViewAllFilmsEndpoint(s domain.Service) endpoint.Endpoint {
 		}
 
 		// Build FilterSortLimit
-		builder := query.NewFilterSortLimitBuilder()
-
-		// Get sort
-		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"title", "release_date"}, "release_date.desc")
+		filterSortLimit, err := reqForm.FilterSortLimit()
 		if err != nil {
 			return ViewAllFilmsResponse{Err: err}, nil
 		}
 
-		// Get limit and offset
-		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
-		if err != nil {
-			return ViewAllFilmsResponse{Err: err}, err
-		}
-
-		// Get offset from HTTP request
-		offset, err := pagination.GetOffsetOption(reqForm.Offset)
-		if err != nil {
-			return ViewAllFilmsResponse{Err: err}, err
+		items, p, errViewAllFilms := s.ViewAllFilms(ctx, filterSortLimit)
+		if errViewAllFilms != nil {
+			return ViewAllFilmsResponse{Err: errViewAllFilms}, nil
 		}
 
-		// Get filters from HTTP request
-		myFilters, err := getFilterOptions(reqForm)
-		if err != nil {
-			return ViewAllFilmsResponse{Err: err}, nil
+		// Count facets with the same filters
+		counts, errFacets := s.ViewFilmFacets(ctx, filterSortLimit.Filter, reqForm.Facets)
+		if errFacets != nil {
+			return ViewAllFilmsResponse{Err: errFacets}, nil
 		}
 
-		// Build FilterSortLimit
-		filterSortLimit := builder.
-			SetSort(sortOption).
-			SetFilter(myFilters).
-			SetLimit(limit).
-			SetOffset(offset).
-			Build()
-
-		if items, p, errViewAllFilms := s.ViewAllFilms(ctx, filterSortLimit); errViewAllFilms != nil {
-			return ViewAllFilmsResponse{Err: errViewAllFilms}, nil
-		} else {
-			return ViewAllFilmsResponse{
-				Items:      domainAllFilmItemsToAllItemFilms(items),
-				Pagination: p,
-			}, nil
-		}
+		return ViewAllFilmsResponse{
+			Items:      domainAllFilmItemsToAllItemFilms(items),
+			Pagination: p,
+			Facets:     domainFacetCountsToItemFacets(counts, reqForm.Facets),
+		}, nil
 	}
 }
 
 // ViewAllFilmsRequest is a request for ViewAllFilms.
 type ViewAllFilmsRequest struct {
-	Sort        string   `json:"sort" validate:"omitempty,min=3,max=30" example:"title.asc"`
-	Limit       int      `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
-	Offset      int      `json:"offset" validate:"omitempty,min=0" example:"0"`
+	Sort      string   `json:"sort" validate:"omitempty,min=3,max=30" example:"title.asc"`
+	Limit     int      `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset    int      `json:"offset" validate:"omitempty,min=0" example:"0"`
+	Cursor    string   `json:"cursor" validate:"omitempty,max=500" example:"eyJzIjoicmF0aW5nLmRlc2MiLCJ2Ijo3LjUsImlkIjoiNTUwZTg0MDAtZTI5Yi00MWQ0LWE3MTYtNDQ2NjU1NDQwMDAwIn0"`
+	WithCount string   `json:"with_count" validate:"omitempty,oneof=true false" example:"true"`
+	Facets    []string `json:"facets" validate:"omitempty,max=3,unique,dive,oneof=genres decade director" example:"genres,decade,director"`
+	FilmFilters
+}
+
+// FilmFilters is a form of filters of films.
+type FilmFilters struct {
+	Q           string   `json:"q" validate:"omitempty,min=2,max=100" example:"shawshank prison"`
 	Title       string   `json:"title" validate:"omitempty,min=3,max=30" example:"Garry Potter"`
 	ReleaseDate string   `json:"release_date" validate:"omitempty,customRangeDate,customRangeDateCorrect" example:"2021-01-01,2021-12-31:2022-01-01"`
-	Genres      []string `json:"genres" validate:"omitempty,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
+	Year        string   `json:"year" validate:"omitempty,datetime=2006" example:"2021"`
+	Genres      []string `json:"genres" validate:"required_with=GenresMode,omitempty,min=1,max=5,dive,min=3,max=100" example:"action,adventure,sci-fi"`
+	GenresMode  string   `json:"genres_mode" validate:"omitempty,oneof=all any none" example:"all"`
+	Director    string   `json:"director" validate:"omitempty,min=2,max=100" example:"Nolan"`
+	Cast        string   `json:"cast" validate:"omitempty,min=2,max=100" example:"Hanks"`
+	CreatorID   string   `json:"creator_id" validate:"omitempty,uuid4" example:"550e8400-e29b-41d4-a716-446655440000"`
 }
 
 // Validate is a method to validate form.
@@ -97,11 +91,89 @@ func (r *ViewAllFilmsRequest) Validate() error {
 	return customValidator.Validate(r)
 }
 
+// FilterSortLimit is a method to build sort, filters and pagination of the validated form.
+func (r *ViewAllFilmsRequest) FilterSortLimit() (query.FilterSortLimit, error) {
+	// Get sort, relevance is available for full-text search only
+	sortFields, sortByDefault := []string{"title", "release_date", "rating"}, "release_date.desc"
+	if r.Q != "" {
+		sortFields, sortByDefault = append(sortFields, "relevance"), "relevance.desc"
+	}
+
+	sortOption, err := sort.GetSortOptions(r.Sort, sortFields, sortByDefault)
+	if err != nil {
+		return query.FilterSortLimit{}, err
+	}
+
+	// Get limit and offset
+	limit, err := pagination.GetLimitOption(r.Limit, 20)
+	if err != nil {
+		return query.FilterSortLimit{}, err
+	}
+
+	offset, err := pagination.GetOffsetOption(r.Offset)
+	if err != nil {
+		return query.FilterSortLimit{}, err
+	}
+
+	// Get cursor, it replaces offset
+	cursor, err := pagination.GetCursorOption(r.Cursor, sortOption)
+	if err != nil {
+		return query.FilterSortLimit{}, err
+	}
+
+	if cursor != nil && r.Offset > 0 {
+		return query.FilterSortLimit{}, customError.ValidationError{Field: "offset", Err: pagination.ErrCursorWithOffset}
+	}
+
+	// Count films by default in offset mode only
+	withCount := r.WithCount == "true" || (r.WithCount == "" && cursor == nil)
+
+	// Get filters
+	myFilters, err := getFilterOptions(r.FilmFilters)
+	if err != nil {
+		return query.FilterSortLimit{}, err
+	}
+
+	return query.NewFilterSortLimitBuilder().
+		SetSort(sortOption).
+		SetFilter(myFilters).
+		SetLimit(limit).
+		SetOffset(offset).
+		SetCursor(cursor).
+		SetWithCount(withCount).
+		Build(), nil
+}
+
 // ViewAllFilmsResponse is a response for ViewAllFilms.
 type ViewAllFilmsResponse struct {
-	Items      []ItemAllFilms        `json:"items"`
-	Pagination pagination.Pagination `json:"pagination,omitempty"`
-	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+	Items      []ItemAllFilms         `json:"items"`
+	Pagination pagination.Pagination  `json:"pagination,omitempty"`
+	Facets     map[string][]ItemFacet `json:"facets,omitempty"`
+	Err        error                  `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// ItemFacet is a response item for count of films with a value of a facet.
+type ItemFacet struct {
+	Value string `json:"value" example:"drama"`
+	Count int64  `json:"count" example:"12"`
+}
+
+// domainFacetCountsToItemFacets is a function to group domain facet counts by facets, every requested facet is present.
+func domainFacetCountsToItemFacets(counts []models.FacetCount, facets []string) map[string][]ItemFacet {
+	if len(facets) == 0 {
+		return nil
+	}
+
+	items := make(map[string][]ItemFacet, len(facets))
+	for _, facet := range facets {
+		items[facet] = make([]ItemFacet, 0)
+	}
+
+	for _, count := range counts {
+		items[count.Facet] = append(items[count.Facet], ItemFacet{Value: count.Value, Count: count.Count})
+	}
+
+	return items
 }
 
 // Failed implements response.Failed.
@@ -109,15 +181,20 @@ func (r ViewAllFilmsResponse) Failed() error { return r.Err }
 
 // ItemAllFilms is a response for ViewAllFilms.
 type ItemAllFilms struct {
-	UUID        uuid.UUID `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Title       string    `json:"title" example:"Garry Potter"`
-	Director    string    `json:"director" example:"John Doe"`
-	Genres      []string  `json:"genres" example:"action,adventure,sci-fi"`
-	ReleaseDate string    `json:"release_date" example:"2021-01-01"`
-	Casts       []string  `json:"casts" example:"John Doe,Jane Doe,Foo Bar"`
-	Synopsis    string    `json:"synopsis" example:"This is a synopsis."`
-	CreatedAt   string    `json:"created_at" example:"2021-01-01 00:00:00"`
-	UpdatedAt   string    `json:"updated_at" example:"2021-01-01 00:00:00"`
+	UUID        uuid.UUID    `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string       `json:"title" example:"Garry Potter"`
+	Director    string       `json:"director" example:"John Doe"`
+	Genres      []string     `json:"genres" example:"action,adventure,sci-fi"`
+	ReleaseDate string       `json:"release_date" example:"2021-01-01"`
+	Casts       []string     `json:"casts" example:"John Doe,Jane Doe,Foo Bar"`
+	Credits     []ItemCredit `json:"credits"`
+	Synopsis    string       `json:"synopsis" example:"This is a synopsis."`
+	Rating      float64      `json:"rating" example:"7.5"`
+	RatingCount int64        `json:"rating_count" example:"12"`
+	CreatedAt   string       `json:"created_at" example:"2021-01-01 00:00:00"`
+	UpdatedAt   string       `json:"updated_at" example:"2021-01-01 00:00:00"`
+	Relevance   float64      `json:"relevance,omitempty" example:"0.6"`
+	Highlight   string       `json:"highlight,omitempty" example:"The <mark>Shawshank</mark> Redemption. Two imprisoned men bond"`
 }
 
 // domainAllFilmItemsToAllItemFilms is a function to convert domain film items to all item films.
@@ -125,22 +202,32 @@ func domainAllFilmItemsToAllItemFilms(items []models.Film) []ItemAllFilms {
 	films := make([]ItemAllFilms, 0, len(items))
 
 	for _, item := range items {
-		films = append(films, ItemAllFilms{
-			UUID:        item.UUID,
-			Title:       item.Title,
-			Director:    item.Director.Name,
-			Genres:      convertGenresToStrings(item.Genres),
-			ReleaseDate: item.ReleaseDate.Format(time.DateOnly),
-			Casts:       convertCastsToStrings(item.Casts),
-			Synopsis:    item.Synopsis,
-			CreatedAt:   time.Unix(item.CreatedAt, 0).Format(time.DateTime),
-			UpdatedAt:   time.Unix(item.UpdatedAt, 0).Format(time.DateTime),
-		})
+		films = append(films, DomainFilmToItemAllFilms(item))
 	}
 
 	return films
 }
 
+// DomainFilmToItemAllFilms is a function to convert domain film to item of all films.
+func DomainFilmToItemAllFilms(item models.Film) ItemAllFilms {
+	return ItemAllFilms{
+		UUID:        item.UUID,
+		Title:       item.Title,
+		Director:    item.Director.Name,
+		Genres:      convertGenresToStrings(item.Genres),
+		ReleaseDate: item.ReleaseDate.Format(time.DateOnly),
+		Casts:       convertCastsToStrings(item.Credits),
+		Credits:     domainCreditsToItemCredits(item.Credits),
+		Synopsis:    item.Synopsis,
+		Rating:      item.Rating,
+		RatingCount: item.RatingCount,
+		CreatedAt:   time.Unix(item.CreatedAt, 0).Format(time.DateTime),
+		UpdatedAt:   time.Unix(item.UpdatedAt, 0).Format(time.DateTime),
+		Relevance:   item.Relevance,
+		Highlight:   item.Highlight,
+	}
+}
+
 // convertGenresToStrings is a function to convert genres to strings.
 func convertGenresToStrings(genres []models.Genre) []string {
 	genreNames := make([]string, len(genres))
@@ -152,19 +239,8 @@ func convertGenresToStrings(genres []models.Genre) []string {
 	return genreNames
 }
 
-// convertCastsToStrings is a function to convert casts to strings.
-func convertCastsToStrings(casts []models.Cast) []string {
-	castNames := make([]string, len(casts))
-
-	for i, cast := range casts {
-		castNames[i] = cast.Name
-	}
-
-	return castNames
-}
-
 // getFilterOptions is a function to get filter options.
-func getFilterOptions(reqForm ViewAllFilmsRequest) (query.Filter, error) {
+func getFilterOptions(reqForm FilmFilters) (query.Filter, error) {
 	myFilter := make(query.Filter)
 
 	// set release_date
@@ -181,14 +257,56 @@ func getFilterOptions(reqForm ViewAllFilmsRequest) (query.Filter, error) {
 		}
 	}
 
+	// set full-text search
+	if reqForm.Q != "" {
+		myFilter["q"] = reqForm.Q
+	}
+
 	// set title
 	if reqForm.Title != "" {
 		myFilter["title"] = reqForm.Title
 	}
 
-	// set genres
+	// set release year
+	if reqForm.Year != "" {
+		year, err := strconv.Atoi(reqForm.Year)
+		if err != nil {
+			return nil, customError.ValidationError{Field: "year", Err: err}
+		}
+
+		myFilter["year"] = year
+	}
+
+	// set genres, they match any of genres by default
 	if len(reqForm.Genres) > 0 {
-		myFilter["genres"] = reqForm.Genres
+		switch reqForm.GenresMode {
+		case "all":
+			myFilter["genres_all"] = reqForm.Genres
+		case "none":
+			myFilter["genres_none"] = reqForm.Genres
+		default:
+			myFilter["genres"] = reqForm.Genres
+		}
+	}
+
+	// set director
+	if reqForm.Director != "" {
+		myFilter["director"] = reqForm.Director
+	}
+
+	// set cast
+	if reqForm.Cast != "" {
+		myFilter["cast"] = reqForm.Cast
+	}
+
+	// set creator
+	if reqForm.CreatorID != "" {
+		creatorID, err := uuid.Parse(reqForm.CreatorID)
+		if err != nil {
+			return nil, customError.ValidationError{Field: "creator_id", Err: err}
+		}
+
+		myFilter["creator_id"] = creatorID
 	}
 
 	return myFilter, nil
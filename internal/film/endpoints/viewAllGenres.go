@@ -0,0 +1,114 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeViewAllGenresEndpoint is an endpoint for ViewAllGenres.
+func MakeViewAllGenresEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewAllGenresRequest)
+		if !ok {
+			return ViewAllGenresResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewAllGenresResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return ViewAllGenresResponse{Err: err}, nil
+		}
+
+		// Get sort
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"name", "films_count"}, "name.asc")
+		if err != nil {
+			return ViewAllGenresResponse{Err: err}, nil
+		}
+
+		// Get limit
+		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
+		if err != nil {
+			return ViewAllGenresResponse{Err: err}, nil
+		}
+
+		// Get offset
+		offset, err := pagination.GetOffsetOption(reqForm.Offset)
+		if err != nil {
+			return ViewAllGenresResponse{Err: err}, nil
+		}
+
+		// Build FilterSortLimit
+		filterSortLimit := query.NewFilterSortLimitBuilder().
+			SetSort(sortOption).
+			SetFilter(make(query.Filter)).
+			SetLimit(limit).
+			SetOffset(offset).
+			Build()
+
+		if items, p, errViewAllGenres := s.ViewAllGenres(ctx, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole)), filterSortLimit); errViewAllGenres != nil {
+			return ViewAllGenresResponse{Err: errViewAllGenres}, nil
+		} else {
+			return ViewAllGenresResponse{
+				Items:      domainGenresToItemGenres(items),
+				Pagination: p,
+			}, nil
+		}
+	}
+}
+
+// ViewAllGenresRequest is a request for ViewAllGenres.
+type ViewAllGenresRequest struct {
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Sort   string `json:"sort" validate:"omitempty,min=3,max=30" example:"name.asc"`
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset int    `json:"offset" validate:"omitempty,min=0" example:"0"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewAllGenresRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewAllGenresResponse is a response for ViewAllGenres.
+type ViewAllGenresResponse struct {
+	Items      []ItemGenre           `json:"items"`
+	Pagination pagination.Pagination `json:"pagination,omitempty"`
+	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewAllGenresResponse) Failed() error { return r.Err }
+
+// domainGenresToItemGenres is a function to convert domain genres to item genres.
+func domainGenresToItemGenres(items []models.Genre) []ItemGenre {
+	genres := make([]ItemGenre, 0, len(items))
+
+	for _, item := range items {
+		genres = append(genres, domainGenreToItemGenre(item))
+	}
+
+	return genres
+}
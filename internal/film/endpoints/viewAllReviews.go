@@ -0,0 +1,121 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeViewAllReviewsEndpoint is an endpoint for ViewAllReviews.
+func MakeViewAllReviewsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewAllReviewsRequest)
+		if !ok {
+			return ViewAllReviewsResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewAllReviewsResponse{Err: errValidate}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return ViewAllReviewsResponse{Err: err}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return ViewAllReviewsResponse{Err: err}, nil
+		}
+
+		// Get sort
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"created_at", "updated_at"}, "created_at.desc")
+		if err != nil {
+			return ViewAllReviewsResponse{Err: err}, nil
+		}
+
+		// Get limit
+		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
+		if err != nil {
+			return ViewAllReviewsResponse{Err: err}, nil
+		}
+
+		// Get offset
+		offset, err := pagination.GetOffsetOption(reqForm.Offset)
+		if err != nil {
+			return ViewAllReviewsResponse{Err: err}, nil
+		}
+
+		// Build FilterSortLimit
+		filterSortLimit := query.NewFilterSortLimitBuilder().
+			SetSort(sortOption).
+			SetFilter(make(query.Filter)).
+			SetLimit(limit).
+			SetOffset(offset).
+			Build()
+
+		if items, p, errViewAllReviews := s.ViewAllReviews(ctx, parseFilmUUID, policy.NewActor(parseUserUUID, policy.Role(reqForm.UserRole)), filterSortLimit); errViewAllReviews != nil {
+			return ViewAllReviewsResponse{Err: errViewAllReviews}, nil
+		} else {
+			return ViewAllReviewsResponse{
+				Items:      domainReviewsToItemReviews(items),
+				Pagination: p,
+			}, nil
+		}
+	}
+}
+
+// ViewAllReviewsRequest is a request for ViewAllReviews.
+type ViewAllReviewsRequest struct {
+	FilmID   string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+	UserID   string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	UserRole string `json:"userRole" swaggerignore:"true"`
+
+	Sort   string `json:"sort" validate:"omitempty,min=3,max=30" example:"created_at.desc"`
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset int    `json:"offset" validate:"omitempty,min=0" example:"0"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewAllReviewsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewAllReviewsResponse is a response for ViewAllReviews.
+type ViewAllReviewsResponse struct {
+	Items      []ItemReview          `json:"items"`
+	Pagination pagination.Pagination `json:"pagination,omitempty"`
+	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewAllReviewsResponse) Failed() error { return r.Err }
+
+// domainReviewsToItemReviews is a function to convert domain reviews to item reviews.
+func domainReviewsToItemReviews(items []models.Review) []ItemReview {
+	reviews := make([]ItemReview, 0, len(items))
+
+	for _, item := range items {
+		reviews = append(reviews, domainReviewToItemReview(item))
+	}
+
+	return reviews
+}
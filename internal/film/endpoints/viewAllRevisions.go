@@ -0,0 +1,148 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MakeViewAllRevisionsEndpoint is an endpoint for ViewAllRevisions.
+func MakeViewAllRevisionsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewAllRevisionsRequest)
+		if !ok {
+			return ViewAllRevisionsResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewAllRevisionsResponse{Err: errValidate}, nil
+		}
+
+		// Parse UUID
+		parseUUID, err := uuid.Parse(reqForm.UUID)
+		if err != nil {
+			return ViewAllRevisionsResponse{Err: err}, nil
+		}
+
+		// Get sort
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"number"}, "number.desc")
+		if err != nil {
+			return ViewAllRevisionsResponse{Err: err}, nil
+		}
+
+		// Get limit
+		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
+		if err != nil {
+			return ViewAllRevisionsResponse{Err: err}, nil
+		}
+
+		// Get offset
+		offset, err := pagination.GetOffsetOption(reqForm.Offset)
+		if err != nil {
+			return ViewAllRevisionsResponse{Err: err}, nil
+		}
+
+		// Build FilterSortLimit
+		filterSortLimit := query.NewFilterSortLimitBuilder().
+			SetSort(sortOption).
+			SetFilter(make(query.Filter)).
+			SetLimit(limit).
+			SetOffset(offset).
+			Build()
+
+		if items, p, errViewAllRevisions := s.ViewAllRevisions(ctx, parseUUID, filterSortLimit); errViewAllRevisions != nil {
+			return ViewAllRevisionsResponse{Err: errViewAllRevisions}, nil
+		} else {
+			return ViewAllRevisionsResponse{
+				Items:      domainRevisionsToItemRevisions(items),
+				Pagination: p,
+			}, nil
+		}
+	}
+}
+
+// ViewAllRevisionsRequest is a request for ViewAllRevisions.
+type ViewAllRevisionsRequest struct {
+	UUID string `json:"uuid" validate:"required,uuid4" swaggerignore:"true"`
+
+	Sort   string `json:"sort" validate:"omitempty,min=3,max=30" example:"number.desc"`
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset int    `json:"offset" validate:"omitempty,min=0" example:"0"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewAllRevisionsRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewAllRevisionsResponse is a response for ViewAllRevisions.
+type ViewAllRevisionsResponse struct {
+	Items      []ItemRevision        `json:"items"`
+	Pagination pagination.Pagination `json:"pagination,omitempty"`
+	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewAllRevisionsResponse) Failed() error { return r.Err }
+
+// ItemRevision is a response item for film revision.
+type ItemRevision struct {
+	Number      int          `json:"number" example:"2"`
+	Action      string       `json:"action" example:"update"`
+	EditorID    uuid.UUID    `json:"editor_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string       `json:"title" example:"The Shawshank Redemption"`
+	Director    string       `json:"director" example:"Frank Darabont"`
+	Genres      []string     `json:"genres" example:"drama,crime"`
+	Credits     []ItemCredit `json:"credits"`
+	Synopsis    string       `json:"synopsis" example:"This is a synopsis."`
+	ReleaseDate string       `json:"release_date" example:"1994-09-23"`
+	CreatedAt   string       `json:"created_at" example:"2021-01-01 00:00:00"`
+}
+
+// domainRevisionsToItemRevisions is a function to convert domain revisions to item revisions.
+func domainRevisionsToItemRevisions(revisions []models.Revision) []ItemRevision {
+	items := make([]ItemRevision, 0, len(revisions))
+
+	for _, revision := range revisions {
+		credits := make([]ItemCredit, 0, len(revision.Snapshot.Credits))
+		for _, credit := range revision.Snapshot.Credits {
+			credits = append(credits, ItemCredit{
+				Name:         credit.Name,
+				Character:    credit.Character,
+				Department:   string(credit.Department),
+				BillingOrder: credit.BillingOrder,
+			})
+		}
+
+		items = append(items, ItemRevision{
+			Number:      revision.Number,
+			Action:      string(revision.Action),
+			EditorID:    revision.EditorID,
+			Title:       revision.Snapshot.Title,
+			Director:    revision.Snapshot.Director,
+			Genres:      revision.Snapshot.Genres,
+			Credits:     credits,
+			Synopsis:    revision.Snapshot.Synopsis,
+			ReleaseDate: revision.Snapshot.ReleaseDate,
+			CreatedAt:   time.Unix(revision.CreatedAt, 0).Format(time.DateTime),
+		})
+	}
+
+	return items
+}
@@ -8,6 +8,8 @@ import (
 	"film-management/pkg/validation"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/google/uuid"
+	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -66,18 +68,25 @@ type ViewFilmResponse struct {
 // Failed implements response.Failed.
 func (r ViewFilmResponse) Failed() error { return r.Err }
 
+// Headers implements http.Headerer to return version of the film as ETag.
+func (r ViewFilmResponse) Headers() http.Header { return filmETagHeaders(r.Item.Version) }
+
 // ItemViewFilm is a response for ViewFilm.
 type ItemViewFilm struct {
-	UUID        uuid.UUID   `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Title       string      `json:"title" example:"The Shawshank Redemption"`
-	Director    string      `json:"director" example:"Frank Darabont"`
-	Genres      []string    `json:"genres" example:"drama,crime"`
-	ReleaseDate string      `json:"release_date" example:"1994-09-23"`
-	Casts       []string    `json:"casts" example:"Tim Robbins,Morgan Freeman"`
-	Synopsis    string      `json:"synopsis" example:"This is a synopsis."`
-	CreatedAt   string      `json:"created_at" example:"2021-01-01 00:00:00"`
-	UpdatedAt   string      `json:"updated_at" example:"2021-01-01 00:00:00"`
-	Creator     ItemCreator `json:"creator"`
+	UUID        uuid.UUID    `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string       `json:"title" example:"The Shawshank Redemption"`
+	Director    string       `json:"director" example:"Frank Darabont"`
+	Genres      []string     `json:"genres" example:"drama,crime"`
+	ReleaseDate string       `json:"release_date" example:"1994-09-23"`
+	Casts       []string     `json:"casts" example:"Tim Robbins,Morgan Freeman"`
+	Credits     []ItemCredit `json:"credits"`
+	Synopsis    string       `json:"synopsis" example:"This is a synopsis."`
+	Rating      float64      `json:"rating" example:"7.5"`
+	RatingCount int64        `json:"rating_count" example:"12"`
+	Version     int          `json:"version" example:"1"`
+	CreatedAt   string       `json:"created_at" example:"2021-01-01 00:00:00"`
+	UpdatedAt   string       `json:"updated_at" example:"2021-01-01 00:00:00"`
+	Creator     ItemCreator  `json:"creator"`
 }
 
 // ItemCreator is a response for ViewFilm.
@@ -94,8 +103,12 @@ func domainFilmToItemViewFilm(item models.Film) ItemViewFilm {
 		Director:    item.Director.Name,
 		Genres:      convertGenresToStrings(item.Genres),
 		ReleaseDate: item.ReleaseDate.Format(time.DateOnly),
-		Casts:       convertCastsToStrings(item.Casts),
+		Casts:       convertCastsToStrings(item.Credits),
+		Credits:     domainCreditsToItemCredits(item.Credits),
 		Synopsis:    item.Synopsis,
+		Rating:      item.Rating,
+		RatingCount: item.RatingCount,
+		Version:     item.Version,
 		CreatedAt:   time.Unix(item.CreatedAt, 0).Format(time.DateTime),
 		UpdatedAt:   time.Unix(item.UpdatedAt, 0).Format(time.DateTime),
 		Creator: ItemCreator{
@@ -104,3 +117,13 @@ func domainFilmToItemViewFilm(item models.Film) ItemViewFilm {
 		},
 	}
 }
+
+// filmETagHeaders returns headers with ETag of the film version, it is passed back in If-Match to update or delete the film.
+func filmETagHeaders(version int) http.Header {
+	headers := http.Header{}
+	if version > 0 {
+		headers.Set("ETag", strconv.Quote(strconv.Itoa(version)))
+	}
+
+	return headers
+}
@@ -0,0 +1,122 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MakeViewTrashEndpoint is an endpoint for ViewTrash.
+func MakeViewTrashEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewTrashRequest)
+		if !ok {
+			return ViewTrashResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewTrashResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return ViewTrashResponse{Err: err}, nil
+		}
+
+		// Get sort
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"title", "deleted_at"}, "deleted_at.desc")
+		if err != nil {
+			return ViewTrashResponse{Err: err}, nil
+		}
+
+		// Get limit
+		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
+		if err != nil {
+			return ViewTrashResponse{Err: err}, nil
+		}
+
+		// Get offset
+		offset, err := pagination.GetOffsetOption(reqForm.Offset)
+		if err != nil {
+			return ViewTrashResponse{Err: err}, nil
+		}
+
+		// Build FilterSortLimit
+		filterSortLimit := query.NewFilterSortLimitBuilder().
+			SetSort(sortOption).
+			SetFilter(make(query.Filter)).
+			SetLimit(limit).
+			SetOffset(offset).
+			Build()
+
+		if items, p, errViewTrash := s.ViewTrash(ctx, parseUserUUID, filterSortLimit); errViewTrash != nil {
+			return ViewTrashResponse{Err: errViewTrash}, nil
+		} else {
+			return ViewTrashResponse{
+				Items:      domainFilmsToItemTrashFilms(items),
+				Pagination: p,
+			}, nil
+		}
+	}
+}
+
+// ViewTrashRequest is a request for ViewTrash.
+type ViewTrashRequest struct {
+	UserID string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+
+	Sort   string `json:"sort" validate:"omitempty,min=3,max=30" example:"deleted_at.desc"`
+	Limit  int    `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset int    `json:"offset" validate:"omitempty,min=0" example:"0"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewTrashRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewTrashResponse is a response for ViewTrash.
+type ViewTrashResponse struct {
+	Items      []ItemTrashFilm       `json:"items"`
+	Pagination pagination.Pagination `json:"pagination,omitempty"`
+	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewTrashResponse) Failed() error { return r.Err }
+
+// ItemTrashFilm is a response item for deleted film.
+type ItemTrashFilm struct {
+	ItemAllFilms
+	DeletedAt string `json:"deleted_at" example:"2021-01-01 00:00:00"`
+}
+
+// domainFilmsToItemTrashFilms is a function to convert deleted domain films to trash items.
+func domainFilmsToItemTrashFilms(items []models.Film) []ItemTrashFilm {
+	films := make([]ItemTrashFilm, 0, len(items))
+
+	for _, item := range items {
+		films = append(films, ItemTrashFilm{
+			ItemAllFilms: DomainFilmToItemAllFilms(item),
+			DeletedAt:    item.DeletedAt.Time.Format(time.DateTime),
+		})
+	}
+
+	return films
+}
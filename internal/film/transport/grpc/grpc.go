@@ -0,0 +1,401 @@
+package grpc
+
+import (
+	"context"
+	"film-management/internal/film/endpoints"
+	"film-management/internal/film/transport/grpc/pb"
+	grpcTransport "film-management/pkg/transport/grpc"
+	"film-management/pkg/transport/http/middlewares/auth"
+	"film-management/pkg/utils"
+	kitGRPCTransport "github.com/go-kit/kit/transport/grpc"
+	"go.uber.org/zap"
+)
+
+// grpcServer is a gRPC server of films, it serves the same endpoints as HTTP handlers.
+type grpcServer struct {
+	pb.UnimplementedFilmServiceServer
+
+	addFilm      kitGRPCTransport.Handler
+	updateFilm   kitGRPCTransport.Handler
+	viewFilm     kitGRPCTransport.Handler
+	viewAllFilms kitGRPCTransport.Handler
+	deleteFilm   kitGRPCTransport.Handler
+}
+
+// NewGRPCServer is a function that returns a gRPC server of films that makes a set of endpoints available as methods.
+func NewGRPCServer(endpoints endpoints.SetEndpoints, logger *zap.Logger) pb.FilmServiceServer {
+	options := []kitGRPCTransport.ServerOption{
+		kitGRPCTransport.ServerErrorHandler(grpcTransport.NewLogErrorHandler(logger)),
+	}
+
+	return &grpcServer{
+		// Add a film
+		addFilm: kitGRPCTransport.NewServer(
+			endpoints.AddFilmEndpoint,
+			decodeGRPCAddFilmRequest,
+			encodeGRPCAddFilmResponse,
+			options...,
+		),
+		// Update a film
+		updateFilm: kitGRPCTransport.NewServer(
+			endpoints.UpdateFilmEndpoint,
+			decodeGRPCUpdateFilmRequest,
+			encodeGRPCUpdateFilmResponse,
+			options...,
+		),
+		// View a film
+		viewFilm: kitGRPCTransport.NewServer(
+			endpoints.ViewFilmEndpoint,
+			decodeGRPCViewFilmRequest,
+			encodeGRPCViewFilmResponse,
+			options...,
+		),
+		// View all films
+		viewAllFilms: kitGRPCTransport.NewServer(
+			endpoints.ViewAllFilmsEndpoint,
+			decodeGRPCViewAllFilmsRequest,
+			encodeGRPCViewAllFilmsResponse,
+			options...,
+		),
+		// Delete a film
+		deleteFilm: kitGRPCTransport.NewServer(
+			endpoints.DeleteFilmEndpoint,
+			decodeGRPCDeleteFilmRequest,
+			encodeGRPCDeleteFilmResponse,
+			options...,
+		),
+	}
+}
+
+func (s *grpcServer) AddFilm(ctx context.Context, req *pb.AddFilmRequest) (*pb.AddFilmResponse, error) {
+	_, resp, err := s.addFilm.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.AddFilmResponse), nil
+}
+
+func (s *grpcServer) UpdateFilm(ctx context.Context, req *pb.UpdateFilmRequest) (*pb.UpdateFilmResponse, error) {
+	_, resp, err := s.updateFilm.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.UpdateFilmResponse), nil
+}
+
+func (s *grpcServer) ViewFilm(ctx context.Context, req *pb.ViewFilmRequest) (*pb.ViewFilmResponse, error) {
+	_, resp, err := s.viewFilm.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.ViewFilmResponse), nil
+}
+
+func (s *grpcServer) ViewAllFilms(ctx context.Context, req *pb.ViewAllFilmsRequest) (*pb.ViewAllFilmsResponse, error) {
+	_, resp, err := s.viewAllFilms.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.ViewAllFilmsResponse), nil
+}
+
+func (s *grpcServer) DeleteFilm(ctx context.Context, req *pb.DeleteFilmRequest) (*pb.DeleteFilmResponse, error) {
+	_, resp, err := s.deleteFilm.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.DeleteFilmResponse), nil
+}
+
+// decodeGRPCAddFilmRequest is a function to decode a gRPC request of AddFilm.
+func decodeGRPCAddFilmRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.AddFilmRequest)
+
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints.AddFilmRequest{
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Title:       req.GetTitle(),
+		Director:    req.GetDirector(),
+		ReleaseDate: req.GetReleaseDate(),
+		Genres:      req.GetGenres(),
+		Casts:       pbCreditFormsToCreditForms(req.GetCasts()),
+		Synopsis:    req.GetSynopsis(),
+	}, nil
+}
+
+// encodeGRPCAddFilmResponse is a function to encode a response of AddFilm to gRPC.
+func encodeGRPCAddFilmResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.AddFilmResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return &pb.AddFilmResponse{Film: itemFilmToPBFilm(resp.Item)}, nil
+}
+
+// decodeGRPCUpdateFilmRequest is a function to decode a gRPC request of UpdateFilm.
+func decodeGRPCUpdateFilmRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UpdateFilmRequest)
+
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints.UpdateFilmRequest{
+		UUID:        req.GetUuid(),
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Version:     int(req.GetVersion()),
+		Title:       req.GetTitle(),
+		Director:    req.GetDirector(),
+		ReleaseDate: req.GetReleaseDate(),
+		Genres:      req.GetGenres(),
+		Casts:       pbCreditFormsToCreditForms(req.GetCasts()),
+		Synopsis:    req.GetSynopsis(),
+	}, nil
+}
+
+// encodeGRPCUpdateFilmResponse is a function to encode a response of UpdateFilm to gRPC.
+func encodeGRPCUpdateFilmResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.UpdateFilmResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return &pb.UpdateFilmResponse{Film: itemFilmToPBFilm(resp.Item)}, nil
+}
+
+// decodeGRPCViewFilmRequest is a function to decode a gRPC request of ViewFilm.
+func decodeGRPCViewFilmRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ViewFilmRequest)
+
+	return endpoints.ViewFilmRequest{UUID: req.GetUuid()}, nil
+}
+
+// encodeGRPCViewFilmResponse is a function to encode a response of ViewFilm to gRPC.
+func encodeGRPCViewFilmResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.ViewFilmResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	item := resp.Item
+
+	return &pb.ViewFilmResponse{Film: &pb.Film{
+		Uuid:        item.UUID.String(),
+		Title:       item.Title,
+		Director:    item.Director,
+		Genres:      item.Genres,
+		ReleaseDate: item.ReleaseDate,
+		Casts:       item.Casts,
+		Credits:     itemCreditsToPBCredits(item.Credits),
+		Synopsis:    item.Synopsis,
+		Rating:      item.Rating,
+		RatingCount: item.RatingCount,
+		Version:     int32(item.Version),
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+		Creator: &pb.Creator{
+			Uuid:     item.Creator.UUID.String(),
+			Username: item.Creator.Username,
+		},
+	}}, nil
+}
+
+// decodeGRPCViewAllFilmsRequest is a function to decode a gRPC request of ViewAllFilms.
+func decodeGRPCViewAllFilmsRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ViewAllFilmsRequest)
+
+	request := endpoints.ViewAllFilmsRequest{
+		Sort:   req.GetSort(),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+		Cursor: req.GetCursor(),
+		Facets: req.GetFacets(),
+		FilmFilters: endpoints.FilmFilters{
+			Q:           req.GetQ(),
+			Title:       req.GetTitle(),
+			ReleaseDate: req.GetReleaseDate(),
+			Year:        req.GetYear(),
+			Genres:      req.GetGenres(),
+			GenresMode:  req.GetGenresMode(),
+			Director:    req.GetDirector(),
+			Cast:        req.GetCast(),
+			CreatorID:   req.GetCreatorId(),
+		},
+	}
+
+	if req.GetWithCount() {
+		request.WithCount = "true"
+	}
+
+	if request.CreatorID == "me" {
+		userID, err := utils.GetValueFromContext(ctx, auth.ContextKeyUserID)
+		if err != nil {
+			return nil, grpcTransport.ErrContextUserID
+		}
+
+		request.CreatorID = userID
+	}
+
+	return request, nil
+}
+
+// encodeGRPCViewAllFilmsResponse is a function to encode a response of ViewAllFilms to gRPC.
+func encodeGRPCViewAllFilmsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.ViewAllFilmsResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	films := make([]*pb.Film, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		films = append(films, &pb.Film{
+			Uuid:        item.UUID.String(),
+			Title:       item.Title,
+			Director:    item.Director,
+			Genres:      item.Genres,
+			ReleaseDate: item.ReleaseDate,
+			Casts:       item.Casts,
+			Credits:     itemCreditsToPBCredits(item.Credits),
+			Synopsis:    item.Synopsis,
+			Rating:      item.Rating,
+			RatingCount: item.RatingCount,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+			Relevance:   item.Relevance,
+			Highlight:   item.Highlight,
+		})
+	}
+
+	var facets map[string]*pb.FacetValues
+	if len(resp.Facets) > 0 {
+		facets = make(map[string]*pb.FacetValues, len(resp.Facets))
+		for facet, items := range resp.Facets {
+			values := make([]*pb.FacetValue, 0, len(items))
+			for _, item := range items {
+				values = append(values, &pb.FacetValue{Value: item.Value, Count: item.Count})
+			}
+
+			facets[facet] = &pb.FacetValues{Values: values}
+		}
+	}
+
+	return &pb.ViewAllFilmsResponse{
+		Films: films,
+		Pagination: &pb.Pagination{
+			Page:       int32(resp.Pagination.Page),
+			TotalPages: int32(resp.Pagination.TotalPages),
+			PageSize:   int32(resp.Pagination.PageSize),
+			TotalCount: int32(resp.Pagination.TotalCount),
+			NextCursor: resp.Pagination.NextCursor,
+		},
+		Facets: facets,
+	}, nil
+}
+
+// decodeGRPCDeleteFilmRequest is a function to decode a gRPC request of DeleteFilm.
+func decodeGRPCDeleteFilmRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.DeleteFilmRequest)
+
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints.DeleteFilmRequest{
+		UUID:        req.GetUuid(),
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Version:     int(req.GetVersion()),
+	}, nil
+}
+
+// encodeGRPCDeleteFilmResponse is a function to encode a response of DeleteFilm to gRPC.
+func encodeGRPCDeleteFilmResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.DeleteFilmResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return &pb.DeleteFilmResponse{}, nil
+}
+
+// userFromContext is a function to get user ID and role set by the auth interceptor.
+func userFromContext(ctx context.Context) (userID string, userRole string, err error) {
+	userID, errUserID := utils.GetValueFromContext(ctx, auth.ContextKeyUserID)
+	if errUserID != nil {
+		return "", "", grpcTransport.ErrContextUserID
+	}
+
+	userRole, errUserRole := utils.GetValueFromContext(ctx, auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return "", "", grpcTransport.ErrContextUserRole
+	}
+
+	return userID, userRole, nil
+}
+
+// pbCreditFormsToCreditForms is a function to convert gRPC credit forms to credit forms of endpoints.
+func pbCreditFormsToCreditForms(casts []*pb.CreditForm) []endpoints.CreditForm {
+	if casts == nil {
+		return nil
+	}
+
+	forms := make([]endpoints.CreditForm, 0, len(casts))
+	for _, cast := range casts {
+		forms = append(forms, endpoints.CreditForm{
+			Name:         cast.GetName(),
+			Character:    cast.GetCharacter(),
+			Department:   cast.GetDepartment(),
+			BillingOrder: int(cast.GetBillingOrder()),
+		})
+	}
+
+	return forms
+}
+
+// itemCreditsToPBCredits is a function to convert credit items to gRPC credits.
+func itemCreditsToPBCredits(items []endpoints.ItemCredit) []*pb.Credit {
+	credits := make([]*pb.Credit, 0, len(items))
+	for _, item := range items {
+		credits = append(credits, &pb.Credit{
+			Name:         item.Name,
+			Character:    item.Character,
+			Department:   item.Department,
+			BillingOrder: int32(item.BillingOrder),
+		})
+	}
+
+	return credits
+}
+
+// itemFilmToPBFilm is a function to convert a film item to gRPC film.
+func itemFilmToPBFilm(item endpoints.ItemFilm) *pb.Film {
+	return &pb.Film{
+		Uuid:        item.UUID.String(),
+		Title:       item.Title,
+		Director:    item.Director,
+		Genres:      item.Genres,
+		ReleaseDate: item.ReleaseDate,
+		Casts:       item.Casts,
+		Credits:     itemCreditsToPBCredits(item.Credits),
+		Synopsis:    item.Synopsis,
+		Rating:      item.Rating,
+		RatingCount: item.RatingCount,
+		Version:     int32(item.Version),
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
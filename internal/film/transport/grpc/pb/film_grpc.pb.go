@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: film.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FilmService_AddFilm_FullMethodName      = "/film.v1.FilmService/AddFilm"
+	FilmService_UpdateFilm_FullMethodName   = "/film.v1.FilmService/UpdateFilm"
+	FilmService_ViewFilm_FullMethodName     = "/film.v1.FilmService/ViewFilm"
+	FilmService_ViewAllFilms_FullMethodName = "/film.v1.FilmService/ViewAllFilms"
+	FilmService_DeleteFilm_FullMethodName   = "/film.v1.FilmService/DeleteFilm"
+)
+
+// FilmServiceClient is the client API for FilmService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FilmServiceClient interface {
+	// AddFilm adds a film, viewers can not add films.
+	AddFilm(ctx context.Context, in *AddFilmRequest, opts ...grpc.CallOption) (*AddFilmResponse, error)
+	// UpdateFilm updates a film, editors can update only own films.
+	UpdateFilm(ctx context.Context, in *UpdateFilmRequest, opts ...grpc.CallOption) (*UpdateFilmResponse, error)
+	// ViewFilm returns a film with its creator.
+	ViewFilm(ctx context.Context, in *ViewFilmRequest, opts ...grpc.CallOption) (*ViewFilmResponse, error)
+	// ViewAllFilms returns a page of films with filters and sort.
+	ViewAllFilms(ctx context.Context, in *ViewAllFilmsRequest, opts ...grpc.CallOption) (*ViewAllFilmsResponse, error)
+	// DeleteFilm moves a film to trash, editors can delete only own films.
+	DeleteFilm(ctx context.Context, in *DeleteFilmRequest, opts ...grpc.CallOption) (*DeleteFilmResponse, error)
+}
+
+type filmServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFilmServiceClient(cc grpc.ClientConnInterface) FilmServiceClient {
+	return &filmServiceClient{cc}
+}
+
+func (c *filmServiceClient) AddFilm(ctx context.Context, in *AddFilmRequest, opts ...grpc.CallOption) (*AddFilmResponse, error) {
+	out := new(AddFilmResponse)
+	err := c.cc.Invoke(ctx, FilmService_AddFilm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmServiceClient) UpdateFilm(ctx context.Context, in *UpdateFilmRequest, opts ...grpc.CallOption) (*UpdateFilmResponse, error) {
+	out := new(UpdateFilmResponse)
+	err := c.cc.Invoke(ctx, FilmService_UpdateFilm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmServiceClient) ViewFilm(ctx context.Context, in *ViewFilmRequest, opts ...grpc.CallOption) (*ViewFilmResponse, error) {
+	out := new(ViewFilmResponse)
+	err := c.cc.Invoke(ctx, FilmService_ViewFilm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmServiceClient) ViewAllFilms(ctx context.Context, in *ViewAllFilmsRequest, opts ...grpc.CallOption) (*ViewAllFilmsResponse, error) {
+	out := new(ViewAllFilmsResponse)
+	err := c.cc.Invoke(ctx, FilmService_ViewAllFilms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmServiceClient) DeleteFilm(ctx context.Context, in *DeleteFilmRequest, opts ...grpc.CallOption) (*DeleteFilmResponse, error) {
+	out := new(DeleteFilmResponse)
+	err := c.cc.Invoke(ctx, FilmService_DeleteFilm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FilmServiceServer is the server API for FilmService service.
+// All implementations must embed UnimplementedFilmServiceServer
+// for forward compatibility
+type FilmServiceServer interface {
+	// AddFilm adds a film, viewers can not add films.
+	AddFilm(context.Context, *AddFilmRequest) (*AddFilmResponse, error)
+	// UpdateFilm updates a film, editors can update only own films.
+	UpdateFilm(context.Context, *UpdateFilmRequest) (*UpdateFilmResponse, error)
+	// ViewFilm returns a film with its creator.
+	ViewFilm(context.Context, *ViewFilmRequest) (*ViewFilmResponse, error)
+	// ViewAllFilms returns a page of films with filters and sort.
+	ViewAllFilms(context.Context, *ViewAllFilmsRequest) (*ViewAllFilmsResponse, error)
+	// DeleteFilm moves a film to trash, editors can delete only own films.
+	DeleteFilm(context.Context, *DeleteFilmRequest) (*DeleteFilmResponse, error)
+	mustEmbedUnimplementedFilmServiceServer()
+}
+
+// UnimplementedFilmServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFilmServiceServer struct {
+}
+
+func (UnimplementedFilmServiceServer) AddFilm(context.Context, *AddFilmRequest) (*AddFilmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFilm not implemented")
+}
+func (UnimplementedFilmServiceServer) UpdateFilm(context.Context, *UpdateFilmRequest) (*UpdateFilmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFilm not implemented")
+}
+func (UnimplementedFilmServiceServer) ViewFilm(context.Context, *ViewFilmRequest) (*ViewFilmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewFilm not implemented")
+}
+func (UnimplementedFilmServiceServer) ViewAllFilms(context.Context, *ViewAllFilmsRequest) (*ViewAllFilmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewAllFilms not implemented")
+}
+func (UnimplementedFilmServiceServer) DeleteFilm(context.Context, *DeleteFilmRequest) (*DeleteFilmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFilm not implemented")
+}
+func (UnimplementedFilmServiceServer) mustEmbedUnimplementedFilmServiceServer() {}
+
+// UnsafeFilmServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FilmServiceServer will
+// result in compilation errors.
+type UnsafeFilmServiceServer interface {
+	mustEmbedUnimplementedFilmServiceServer()
+}
+
+func RegisterFilmServiceServer(s grpc.ServiceRegistrar, srv FilmServiceServer) {
+	s.RegisterService(&FilmService_ServiceDesc, srv)
+}
+
+func _FilmService_AddFilm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFilmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmServiceServer).AddFilm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilmService_AddFilm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmServiceServer).AddFilm(ctx, req.(*AddFilmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilmService_UpdateFilm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFilmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmServiceServer).UpdateFilm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilmService_UpdateFilm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmServiceServer).UpdateFilm(ctx, req.(*UpdateFilmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilmService_ViewFilm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewFilmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmServiceServer).ViewFilm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilmService_ViewFilm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmServiceServer).ViewFilm(ctx, req.(*ViewFilmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilmService_ViewAllFilms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewAllFilmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmServiceServer).ViewAllFilms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilmService_ViewAllFilms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmServiceServer).ViewAllFilms(ctx, req.(*ViewAllFilmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilmService_DeleteFilm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmServiceServer).DeleteFilm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilmService_DeleteFilm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmServiceServer).DeleteFilm(ctx, req.(*DeleteFilmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FilmService_ServiceDesc is the grpc.ServiceDesc for FilmService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FilmService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "film.v1.FilmService",
+	HandlerType: (*FilmServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddFilm",
+			Handler:    _FilmService_AddFilm_Handler,
+		},
+		{
+			MethodName: "UpdateFilm",
+			Handler:    _FilmService_UpdateFilm_Handler,
+		},
+		{
+			MethodName: "ViewFilm",
+			Handler:    _FilmService_ViewFilm_Handler,
+		},
+		{
+			MethodName: "ViewAllFilms",
+			Handler:    _FilmService_ViewAllFilms_Handler,
+		},
+		{
+			MethodName: "DeleteFilm",
+			Handler:    _FilmService_DeleteFilm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "film.proto",
+}
@@ -4,23 +4,35 @@ import (
 	"context"
 	"film-management/config"
 	httpCommon "film-management/internal/common/transport/http"
+	"film-management/internal/film/domain/models"
 	"film-management/internal/film/endpoints"
+	"film-management/pkg/export"
+	"film-management/pkg/patch"
 	httpTransport "film-management/pkg/transport/http"
 	"film-management/pkg/transport/http/middlewares/auth"
 	"film-management/pkg/transport/http/middlewares/cors"
 	"film-management/pkg/transport/http/middlewares/recovery"
 	"film-management/pkg/transport/http/response"
 	"film-management/pkg/utils"
+	"fmt"
 	httpKitTransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"io"
+	"mime"
 	"net/http"
 	"strings"
+	"time"
 )
 
 const (
-	APIPath = httpCommon.APIPath + "films/"
+	APIPath       = httpCommon.APIPath + "films/"
+	GenresAPIPath = httpCommon.APIPath + "genres/"
+
+	// maxImportBodySize is a maximum size of a file of import.
+	maxImportBodySize = 32 << 20
 )
 
 // NewHTTPHandlers is a function that returns a http.Handler that makes a set of endpoints available on predefined paths.
@@ -45,6 +57,20 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service,
 		response.EncodeHTTPResponse,
 		options...,
 	)
+	// Patch the film
+	patchFilmHandler := httpKitTransport.NewServer(
+		endpoints.PatchFilmEndpoint,
+		decodeHTTPPatchFilmRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Import films
+	importFilmsHandler := httpKitTransport.NewServer(
+		endpoints.ImportFilmsEndpoint,
+		decodeHTTPImportFilmsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
 	// View the film
 	viewAdHandler := httpKitTransport.NewServer(
 		endpoints.ViewFilmEndpoint,
@@ -59,6 +85,13 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service,
 		response.EncodeHTTPResponse,
 		options...,
 	)
+	// Export films
+	exportFilmsHandler := httpKitTransport.NewServer(
+		endpoints.ExportFilmsEndpoint,
+		decodeHTTPExportFilmsRequest,
+		encodeHTTPExportFilmsResponse(logger),
+		options...,
+	)
 	// Delete the film
 	deleteAdHandler := httpKitTransport.NewServer(
 		endpoints.DeleteFilmEndpoint,
@@ -67,6 +100,113 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service,
 		options...,
 	)
 
+	// Rate the film
+	rateFilmHandler := httpKitTransport.NewServer(
+		endpoints.RateFilmEndpoint,
+		decodeHTTPRateFilmRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
+	// Add a review of the film
+	addReviewHandler := httpKitTransport.NewServer(
+		endpoints.AddReviewEndpoint,
+		decodeHTTPAddReviewRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Update a review of the film
+	updateReviewHandler := httpKitTransport.NewServer(
+		endpoints.UpdateReviewEndpoint,
+		decodeHTTPUpdateReviewRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Hide a review of the film
+	hideReviewHandler := httpKitTransport.NewServer(
+		endpoints.HideReviewEndpoint,
+		decodeHTTPHideReviewRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// View all reviews of the film
+	viewAllReviewsHandler := httpKitTransport.NewServer(
+		endpoints.ViewAllReviewsEndpoint,
+		decodeHTTPViewAllReviewsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Delete a review of the film
+	deleteReviewHandler := httpKitTransport.NewServer(
+		endpoints.DeleteReviewEndpoint,
+		decodeHTTPDeleteReviewRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// View deleted films of the user
+	viewTrashHandler := httpKitTransport.NewServer(
+		endpoints.ViewTrashEndpoint,
+		decodeHTTPViewTrashRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Restore a film from trash
+	restoreFilmHandler := httpKitTransport.NewServer(
+		endpoints.RestoreFilmEndpoint,
+		decodeHTTPRestoreFilmRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// View all revisions of the film
+	viewAllRevisionsHandler := httpKitTransport.NewServer(
+		endpoints.ViewAllRevisionsEndpoint,
+		decodeHTTPViewAllRevisionsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Diff two revisions of the film
+	diffRevisionsHandler := httpKitTransport.NewServer(
+		endpoints.DiffRevisionsEndpoint,
+		decodeHTTPDiffRevisionsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Rollback the film to a revision
+	rollbackFilmHandler := httpKitTransport.NewServer(
+		endpoints.RollbackFilmEndpoint,
+		decodeHTTPRollbackFilmRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Add a genre
+	addGenreHandler := httpKitTransport.NewServer(
+		endpoints.AddGenreEndpoint,
+		decodeHTTPAddGenreRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Rename a genre
+	updateGenreHandler := httpKitTransport.NewServer(
+		endpoints.UpdateGenreEndpoint,
+		decodeHTTPUpdateGenreRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Delete a genre
+	deleteGenreHandler := httpKitTransport.NewServer(
+		endpoints.DeleteGenreEndpoint,
+		decodeHTTPDeleteGenreRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// View all genres
+	viewAllGenresHandler := httpKitTransport.NewServer(
+		endpoints.ViewAllGenresEndpoint,
+		decodeHTTPViewAllGenresRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
 	r := mux.NewRouter()
 
 	// CORS
@@ -77,22 +217,64 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service,
 	r.Use(recovery.Middleware(logger))
 
 	// AUTH
-	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService))
+	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService, logger))
 
 	// Routes
 
 	// Film
 	//
+	// View trash, it is registered before a film to not match the film UUID
+	r.Handle(APIPath+"trash", viewTrashHandler).Methods(http.MethodGet)
+	// Export films, it is registered before a film to not match the film UUID
+	r.Handle(APIPath+"export", exportFilmsHandler).Methods(http.MethodGet)
 	// Add a film
 	r.Handle(APIPath, addAdHandler).Methods(http.MethodPost)
+	// Import films
+	r.Handle(APIPath+"import", importFilmsHandler).Methods(http.MethodPost)
 	// Update a film
 	r.Handle(APIPath+"{id}", updateAdHandler).Methods(http.MethodPut)
+	// Patch a film
+	r.Handle(APIPath+"{id}", patchFilmHandler).Methods(http.MethodPatch)
 	// View a film
 	r.Handle(APIPath+"{id}", viewAdHandler).Methods(http.MethodGet)
 	// View all films
 	r.Handle(APIPath, viewAllFilmsHandler).Methods(http.MethodGet)
 	// Delete a film
 	r.Handle(APIPath+"{id}", deleteAdHandler).Methods(http.MethodDelete)
+	// Restore a film
+	r.Handle(APIPath+"{id}/restore", restoreFilmHandler).Methods(http.MethodPost)
+	// View all revisions of a film
+	r.Handle(APIPath+"{id}/revisions", viewAllRevisionsHandler).Methods(http.MethodGet)
+	// Diff two revisions of a film
+	r.Handle(APIPath+"{id}/revisions/diff", diffRevisionsHandler).Methods(http.MethodGet)
+	// Rollback a film to a revision
+	r.Handle(APIPath+"{id}/revisions/{number}/rollback", rollbackFilmHandler).Methods(http.MethodPost)
+	// Rate a film
+	r.Handle(APIPath+"{id}/rating", rateFilmHandler).Methods(http.MethodPost)
+
+	// Review
+	//
+	// Add a review
+	r.Handle(APIPath+"{id}/reviews", addReviewHandler).Methods(http.MethodPost)
+	// View all reviews
+	r.Handle(APIPath+"{id}/reviews", viewAllReviewsHandler).Methods(http.MethodGet)
+	// Update a review
+	r.Handle(APIPath+"{id}/reviews/{review_id}", updateReviewHandler).Methods(http.MethodPut)
+	// Delete a review
+	r.Handle(APIPath+"{id}/reviews/{review_id}", deleteReviewHandler).Methods(http.MethodDelete)
+	// Hide or show a review
+	r.Handle(APIPath+"{id}/reviews/{review_id}/visibility", hideReviewHandler).Methods(http.MethodPut)
+
+	// Genre
+	//
+	// Add a genre
+	r.Handle(GenresAPIPath, addGenreHandler).Methods(http.MethodPost)
+	// View all genres
+	r.Handle(GenresAPIPath, viewAllGenresHandler).Methods(http.MethodGet)
+	// Rename a genre
+	r.Handle(GenresAPIPath+"{id}", updateGenreHandler).Methods(http.MethodPut)
+	// Delete a genre
+	r.Handle(GenresAPIPath+"{id}", deleteGenreHandler).Methods(http.MethodDelete)
 
 	// Set custom error handlers
 	response.SetErrorHandlers(r)
@@ -102,7 +284,7 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service,
 
 // AddFilm godoc
 // @Summary Add a film
-// @Description Add a film
+// @Description Add a film. Viewers can not add films.
 // @Tags Film
 // @Security ApiKeyAuth
 // @Accept  json
@@ -111,6 +293,7 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service,
 // @Success 200 {object} response.SuccessResponse{data=endpoints.AddFilmResponse} "Success"
 // @Failure 400 {object} response.ErrorResponse	"Bad Request"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
 // @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Router /films/ [post] .
@@ -123,31 +306,42 @@ func decodeHTTPAddFilmRequest(_ context.Context, r *http.Request) (request inter
 		return nil, httpTransport.ErrContextUserID
 	}
 
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
 	// Decode JSON
 	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
 		return nil, httpTransport.ErrJSONDecode
 	}
 
-	// Set CreatorID
+	// Set CreatorID and CreatorRole
 	reqForm.CreatorID = userID
+	reqForm.CreatorRole = userRole
 
 	return reqForm, nil
 }
 
 // UpdateFilm godoc
 // @Summary Update a film
-// @Description Update a film
+// @Description Update a film. Editors can update only own films, admins can update any film. Pass ETag of the film in If-Match to not overwrite changes made by others.
 // @Tags Film
 // @Security ApiKeyAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "Film UUID"
+// @Param If-Match header string false "ETag of the film from view, * changes any version" example("3")
 // @Param form body endpoints.UpdateFilmRequest true "Update film form"
 // @Success 200 {object} response.SuccessResponse{data=endpoints.UpdateFilmResponse} "Success"
+// @Header 200 {string} ETag "Version of the film"
 // @Failure 400 {object} response.ErrorResponse	"Bad Request"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 403 {object} response.ErrorResponse "Forbidden"
 // @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 412 {object} response.ErrorResponse "Precondition Failed"
+// @Failure 428 {object} response.ErrorResponse "Precondition Required"
 // @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Router /films/{id} [put] .
@@ -166,18 +360,176 @@ func decodeHTTPUpdateFilmRequest(_ context.Context, r *http.Request) (request in
 		return nil, httpTransport.ErrContextUserID
 	}
 
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Get version of the film from If-Match header
+	var version int
+	if errVersion := httpTransport.GetVersionFromIfMatch(r, &version, models.VersionAny); errVersion != nil {
+		return nil, errVersion
+	}
+
 	// Decode JSON
 	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
 		return nil, httpTransport.ErrJSONDecode
 	}
 
-	// Set UUID and CreatorID
+	// Set UUID, CreatorID, CreatorRole and Version
 	reqForm.UUID = uuidFromPath
 	reqForm.CreatorID = userID
+	reqForm.CreatorRole = userRole
+	reqForm.Version = version
 
 	return reqForm, nil
 }
 
+// PatchFilm godoc
+// @Summary Patch a film
+// @Description Update only the passed fields of a film with JSON Merge Patch or JSON Patch of the form fields, only the changed fields are validated. Plain JSON is a merge patch. Editors can update only own films, admins can update any film.
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  application/merge-patch+json,application/json-patch+json,json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param If-Match header string false "ETag of the film from view, * changes any version" example("3")
+// @Param form body endpoints.FilmPatchForm true "Merge patch with the changed fields, or JSON Patch operations on them"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.PatchFilmResponse} "Success"
+// @Header 200 {string} ETag "Version of the film"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 412 {object} response.ErrorResponse "Precondition Failed"
+// @Failure 415 {object} response.ErrorResponse "Unsupported Media Type"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 428 {object} response.ErrorResponse "Precondition Required"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id} [patch] .
+func decodeHTTPPatchFilmRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get UUID from path
+	uuidFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Get version of the film from If-Match header
+	var version int
+	if errVersion := httpTransport.GetVersionFromIfMatch(r, &version, models.VersionAny); errVersion != nil {
+		return nil, errVersion
+	}
+
+	// Get content type of the patch, plain JSON is a merge patch
+	contentType, _, errContentType := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case errContentType != nil:
+		return nil, httpTransport.ErrUnsupportedMediaType
+	case contentType == "application/json":
+		contentType = patch.ContentTypeMergePatch
+	case contentType != patch.ContentTypeMergePatch && contentType != patch.ContentTypeJSONPatch:
+		return nil, httpTransport.ErrUnsupportedMediaType
+	}
+
+	// Read the patch
+	body, errRead := io.ReadAll(r.Body)
+	if errRead != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	return endpoints.PatchFilmRequest{
+		UUID:        uuidFromPath,
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Version:     version,
+		ContentType: contentType,
+		Patch:       body,
+	}, nil
+}
+
+// ImportFilms godoc
+// @Summary Import films
+// @Description Import films from CSV with a header of columns title, director, genres, release_date, casts and synopsis, where genres and casts are separated by "|", or from JSON Lines with a film per line in the form of adding a film. Every row is validated like adding a film, rows with a title of an existing film are skipped. With dry_run nothing is saved. Viewers can not import films.
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  text/csv,application/x-ndjson
+// @Produce  json
+// @Param format query string false "Format of the file, by default it is got from Content-Type" Enums(csv, jsonl)
+// @Param dry_run query string false "Validate rows without saving films" Enums(true, false)
+// @Param file body string true "CSV or JSON Lines file"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ImportFilmsResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 413 {object} response.ErrorResponse "Request Entity Too Large"
+// @Failure 415 {object} response.ErrorResponse "Unsupported Media Type"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/import [post] .
+func decodeHTTPImportFilmsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Get format of the file from query or from content type
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		contentType, _, errContentType := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if errContentType != nil {
+			return nil, httpTransport.ErrUnsupportedMediaType
+		}
+
+		switch contentType {
+		case "text/csv":
+			format = endpoints.ImportFormatCSV
+		case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+			format = endpoints.ImportFormatJSONL
+		default:
+			return nil, httpTransport.ErrUnsupportedMediaType
+		}
+	}
+
+	// Read the file
+	body, errRead := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxImportBodySize))
+	if errRead != nil {
+		var errMaxBytes *http.MaxBytesError
+		if errors.As(errRead, &errMaxBytes) {
+			return nil, errors.Wrapf(httpTransport.ErrRequestEntityTooLarge, "file is larger than %d MB", maxImportBodySize>>20)
+		}
+
+		return nil, httpTransport.ErrBadRouting
+	}
+
+	return endpoints.ImportFilmsRequest{
+		UserID:   userID,
+		UserRole: userRole,
+		Format:   format,
+		DryRun:   r.URL.Query().Get("dry_run"),
+		Data:     body,
+	}, nil
+}
+
 // ViewFilm godoc
 // @Summary View a film
 // @Description View a film
@@ -187,6 +539,7 @@ func decodeHTTPUpdateFilmRequest(_ context.Context, r *http.Request) (request in
 // @Produce  json
 // @Param id path string true "Film UUID"
 // @Success 200 {object} response.SuccessResponse{data=endpoints.ViewFilmResponse} "Success"
+// @Header 200 {string} ETag "Version of the film"
 // @Failure 400 {object} response.ErrorResponse	"Bad Request"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 404 {object} response.ErrorResponse "Not Found"
@@ -209,12 +562,21 @@ func decodeHTTPViewFilmRequest(_ context.Context, r *http.Request) (request inte
 // @Security ApiKeyAuth
 // @Accept  json
 // @Produce  json
+// @Param q query string false "full-text search over title, synopsis, director and cast" example(star wars)
 // @Param title query string false "title" example(Star Wars)
 // @Param release_date query string false "date" example(2023-12-11 or 2023-10-11:2023-12-11)
 // @Param genres query string false "genres" example(action,adventure)
-// @Param sort query string false "sort" example(title.asc or title.desc or release_date.asc or release_date.desc)
+// @Param genres_mode query string false "films have any, all or none of genres, any by default" example(any or all or none)
+// @Param year query string false "release year" example(2023)
+// @Param director query string false "part of director name" example(Nolan)
+// @Param cast query string false "part of actor name" example(Hanks)
+// @Param creator_id query string false "creator UUID, me is the current user" example(me)
+// @Param sort query string false "sort, relevance is available with q only" example(title.asc or title.desc or release_date.asc or release_date.desc or rating.asc or rating.desc or relevance.desc)
 // @Param limit query string false "limit" example(10)
 // @Param offset query string false "offset" example(1)
+// @Param cursor query string false "next_cursor of the previous page, replaces offset"
+// @Param with_count query string false "count films, true by default without cursor" example(true or false)
+// @Param facets query string false "count films with the filters by genre, release decade and director" example(genres,decade,director)
 // @Success 200 {object} response.SuccessResponse{data=endpoints.ViewAllFilmsResponse} "Success"
 // @Failure 400 {object} response.ErrorResponse	"Bad Request"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -237,30 +599,172 @@ func decodeHTTPViewAllFilmsRequest(_ context.Context, r *http.Request) (request
 		return nil, err
 	}
 
+	// Get cursor from HTTP request
+	req.Cursor = r.URL.Query().Get("cursor")
+	req.WithCount = r.URL.Query().Get("with_count")
+
+	// Get facets to count from HTTP request
+	if facets := r.URL.Query().Get("facets"); facets != "" {
+		req.Facets = strings.Split(facets, ",")
+	}
+
+	// Get filters from HTTP request
+	if err := decodeHTTPFilmFilters(r, &req.FilmFilters); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ExportFilms godoc
+// @Summary Export films
+// @Description Export all films with the same filters and sort as the list of films. The films are streamed as CSV, JSON Lines or XLSX, the format is selected by the format parameter or by the Accept header, CSV is by default. Genres and casts are separated by "|" in CSV and XLSX.
+// @Tags Film
+// @Security ApiKeyAuth
+// @Produce  text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "format, replaces the Accept header" example(csv or jsonl or xlsx)
+// @Param q query string false "full-text search over title, synopsis, director and cast" example(star wars)
+// @Param title query string false "title" example(Star Wars)
+// @Param release_date query string false "date" example(2023-12-11 or 2023-10-11:2023-12-11)
+// @Param genres query string false "genres" example(action,adventure)
+// @Param genres_mode query string false "films have any, all or none of genres, any by default" example(any or all or none)
+// @Param year query string false "release year" example(2023)
+// @Param director query string false "part of director name" example(Nolan)
+// @Param cast query string false "part of actor name" example(Hanks)
+// @Param creator_id query string false "creator UUID, me is the current user" example(me)
+// @Param sort query string false "sort, relevance is available with q only" example(title.asc or title.desc or release_date.asc or release_date.desc or rating.asc or rating.desc or relevance.desc)
+// @Success 200 {file} file "Films"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 406 {object} response.ErrorResponse "Not Acceptable"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/export [get] .
+func decodeHTTPExportFilmsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ExportFilmsRequest
+
+	// Get format from HTTP request or from Accept header
+	req.Format = r.URL.Query().Get("format")
+	if req.Format == "" {
+		format, ok := export.FormatFromAccept(r.Header.Get("Accept"))
+		if !ok {
+			return nil, httpTransport.ErrNotAcceptable
+		}
+
+		req.Format = format
+	}
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
 	// Get filters from HTTP request
-	req.Title = r.URL.Query().Get("title")
-	req.ReleaseDate = r.URL.Query().Get("release_date")
+	if err := decodeHTTPFilmFilters(r, &req.FilmFilters); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// encodeHTTPExportFilmsResponse is a function to stream the export of films to the HTTP response.
+func encodeHTTPExportFilmsResponse(logger *zap.Logger) httpKitTransport.EncodeResponseFunc {
+	return func(ctx context.Context, w http.ResponseWriter, resp interface{}) error {
+		exportResp, ok := resp.(endpoints.ExportFilmsResponse)
+		if !ok || exportResp.Err != nil {
+			return response.EncodeHTTPResponse(ctx, w, resp)
+		}
+
+		// Export of all films may take longer than the write timeout of the server
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+		writer := &exportResponseWriter{ResponseWriter: w, format: exportResp.Format}
+		if err := exportResp.Write(writer); err != nil {
+			// An error before the first write is encoded as usual
+			if !writer.written {
+				return err
+			}
+
+			// The status is already sent, so the export is cut off
+			logger.Error("filmTransport.ExportFilms.Write", zap.Error(err))
+
+			return nil
+		}
+
+		writer.writeHeader()
+
+		return nil
+	}
+}
+
+// exportResponseWriter is a http.ResponseWriter of export, headers are sent on the first write.
+type exportResponseWriter struct {
+	http.ResponseWriter
+	format  string
+	written bool
+}
+
+// Write sends headers of export on the first write.
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	e.writeHeader()
+
+	return e.ResponseWriter.Write(p)
+}
+
+// writeHeader sends Content-Type and Content-Disposition of export once.
+func (e *exportResponseWriter) writeHeader() {
+	if e.written {
+		return
+	}
+
+	e.written = true
+
+	e.Header().Set("Content-Type", export.ContentType(e.format))
+	e.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="films.%s"`, e.format))
+	e.ResponseWriter.WriteHeader(http.StatusOK)
+}
+
+// decodeHTTPFilmFilters is a function to get filters of films from HTTP request, creator "me" is the current user.
+func decodeHTTPFilmFilters(r *http.Request, filters *endpoints.FilmFilters) error {
+	filters.Q = r.URL.Query().Get("q")
+	filters.Title = r.URL.Query().Get("title")
+	filters.ReleaseDate = r.URL.Query().Get("release_date")
+	filters.Year = r.URL.Query().Get("year")
+	filters.GenresMode = r.URL.Query().Get("genres_mode")
+	filters.Director = r.URL.Query().Get("director")
+	filters.Cast = r.URL.Query().Get("cast")
+	filters.CreatorID = r.URL.Query().Get("creator_id")
 
 	if genres := r.URL.Query().Get("genres"); genres != "" {
-		req.Genres = strings.Split(genres, ",")
+		filters.Genres = strings.Split(genres, ",")
 	}
 
-	return req, nil
+	if filters.CreatorID == "me" {
+		userID, err := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+		if err != nil {
+			return httpTransport.ErrContextUserID
+		}
+
+		filters.CreatorID = userID
+	}
+
+	return nil
 }
 
 // DeleteFilm godoc
 // @Summary Delete a film
-// @Description Delete a film
+// @Description Move a film to trash. Editors can delete only own films, admins can delete any film. The film can be restored until it is purged after the retention period.
 // @Tags Film
 // @Security ApiKeyAuth
 // @Accept  json
 // @Produce  json
 // @Param id path string true "Film UUID"
+// @Param If-Match header string false "ETag of the film from view, * changes any version" example("3")
 // @Success 200 {object} response.SuccessResponse{data=endpoints.DeleteFilmResponse} "Success"
 // @Failure 400 {object} response.ErrorResponse	"Bad Request"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 403 {object} response.ErrorResponse "Forbidden"
 // @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 412 {object} response.ErrorResponse "Precondition Failed"
+// @Failure 428 {object} response.ErrorResponse "Precondition Required"
 // @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
 // @Failure 500 {object} response.ErrorResponse "Internal Server Error"
 // @Router /films/{id} [delete] .
@@ -277,5 +781,730 @@ func decodeHTTPDeleteFilmRequest(_ context.Context, r *http.Request) (request in
 		return nil, httpTransport.ErrContextUserID
 	}
 
-	return endpoints.DeleteFilmRequest{UUID: uuidFromPath, CreatorID: userID}, nil
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Get version of the film from If-Match header
+	var version int
+	if errVersion := httpTransport.GetVersionFromIfMatch(r, &version, models.VersionAny); errVersion != nil {
+		return nil, errVersion
+	}
+
+	return endpoints.DeleteFilmRequest{UUID: uuidFromPath, CreatorID: userID, CreatorRole: userRole, Version: version}, nil
+}
+
+// ViewTrash godoc
+// @Summary View trash
+// @Description View own deleted films, they are purged after the retention period
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param sort query string false "sort" example(deleted_at.desc or title.asc)
+// @Param limit query string false "limit" example(10)
+// @Param offset query string false "offset" example(1)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewTrashResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/trash [get] .
+func decodeHTTPViewTrashRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ViewTrashRequest
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
+	// Get limit from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("limit", r, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	// Get offset from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("offset", r, &req.Offset); err != nil {
+		return nil, err
+	}
+
+	// Set UserID
+	req.UserID = userID
+
+	return req, nil
+}
+
+// RestoreFilm godoc
+// @Summary Restore a film
+// @Description Restore a deleted film from trash. Editors can restore only own films, admins can restore any film.
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.RestoreFilmResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/restore [post] .
+func decodeHTTPRestoreFilmRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get UUID from path
+	uuidFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	return endpoints.RestoreFilmRequest{UUID: uuidFromPath, UserID: userID, UserRole: userRole}, nil
+}
+
+// ViewAllRevisions godoc
+// @Summary View all revisions of a film
+// @Description View the history of a film, every add, update, delete, restore and rollback makes a revision with the state of the film after it
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param sort query string false "sort" example(number.desc)
+// @Param limit query string false "limit" example(10)
+// @Param offset query string false "offset" example(1)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewAllRevisionsResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/revisions [get] .
+func decodeHTTPViewAllRevisionsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ViewAllRevisionsRequest
+
+	// Get UUID from path
+	uuidFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
+	// Get limit from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("limit", r, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	// Get offset from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("offset", r, &req.Offset); err != nil {
+		return nil, err
+	}
+
+	// Set UUID
+	req.UUID = uuidFromPath
+
+	return req, nil
+}
+
+// DiffRevisions godoc
+// @Summary Diff two revisions of a film
+// @Description View fields of a film changed between two revisions
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param from query int true "from revision number" example(1)
+// @Param to query int true "to revision number" example(3)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.DiffRevisionsResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/revisions/diff [get] .
+func decodeHTTPDiffRevisionsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.DiffRevisionsRequest
+
+	// Get UUID from path
+	uuidFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get from from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("from", r, &req.From); err != nil {
+		return nil, err
+	}
+
+	// Get to from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("to", r, &req.To); err != nil {
+		return nil, err
+	}
+
+	// Set UUID
+	req.UUID = uuidFromPath
+
+	return req, nil
+}
+
+// RollbackFilm godoc
+// @Summary Rollback a film to a revision
+// @Description Restore fields of a film from a past revision, it makes a new revision. Only admins can rollback films.
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param number path int true "Revision number"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.RollbackFilmResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/revisions/{number}/rollback [post] .
+func decodeHTTPRollbackFilmRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get UUID from path
+	uuidFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get revision number from path
+	numberFromPath, err := httpTransport.GetValueFromPath(r, "number")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	return endpoints.RollbackFilmRequest{UUID: uuidFromPath, Number: numberFromPath, UserID: userID, UserRole: userRole}, nil
+}
+
+// RateFilm godoc
+// @Summary Rate a film
+// @Description Rate a film from 1 to 10. A repeated rating replaces the previous one of the user.
+// @Tags Film
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param form body endpoints.RateFilmRequest true "Rate film form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.RateFilmResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/rating [post] .
+func decodeHTTPRateFilmRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.RateFilmRequest
+
+	// Get UUID from path
+	uuidFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set UUID and UserID
+	reqForm.UUID = uuidFromPath
+	reqForm.UserID = userID
+
+	return reqForm, nil
+}
+
+// AddReview godoc
+// @Summary Add a review of a film
+// @Description Add a review of a film
+// @Tags Review
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param form body endpoints.AddReviewRequest true "Add review form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.AddReviewResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/reviews [post] .
+func decodeHTTPAddReviewRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.AddReviewRequest
+
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set FilmID and AuthorID
+	reqForm.FilmID = filmUUIDFromPath
+	reqForm.AuthorID = userID
+
+	return reqForm, nil
+}
+
+// UpdateReview godoc
+// @Summary Update own review of a film
+// @Description Update own review of a film
+// @Tags Review
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param review_id path string true "Review UUID"
+// @Param form body endpoints.UpdateReviewRequest true "Update review form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.UpdateReviewResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/reviews/{review_id} [put] .
+func decodeHTTPUpdateReviewRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.UpdateReviewRequest
+
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get review UUID from path
+	reviewUUIDFromPath, err := httpTransport.GetValueFromPath(r, "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set UUID, FilmID, AuthorID and AuthorRole
+	reqForm.UUID = reviewUUIDFromPath
+	reqForm.FilmID = filmUUIDFromPath
+	reqForm.AuthorID = userID
+	reqForm.AuthorRole = userRole
+
+	return reqForm, nil
+}
+
+// HideReview godoc
+// @Summary Hide or show a review of a film
+// @Description Hide or show a review of a film. Only the film creator or an admin can moderate reviews.
+// @Tags Review
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param review_id path string true "Review UUID"
+// @Param form body endpoints.HideReviewRequest true "Hide review form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.HideReviewResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/reviews/{review_id}/visibility [put] .
+func decodeHTTPHideReviewRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.HideReviewRequest
+
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get review UUID from path
+	reviewUUIDFromPath, err := httpTransport.GetValueFromPath(r, "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set UUID, FilmID, UserID and UserRole
+	reqForm.UUID = reviewUUIDFromPath
+	reqForm.FilmID = filmUUIDFromPath
+	reqForm.UserID = userID
+	reqForm.UserRole = userRole
+
+	return reqForm, nil
+}
+
+// ViewAllReviews godoc
+// @Summary View all reviews of a film
+// @Description View all reviews of a film. Hidden reviews are visible only to the film creator and admins.
+// @Tags Review
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param sort query string false "sort" example(created_at.asc or created_at.desc or updated_at.asc or updated_at.desc)
+// @Param limit query string false "limit" example(10)
+// @Param offset query string false "offset" example(1)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewAllReviewsResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/reviews [get] .
+func decodeHTTPViewAllReviewsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ViewAllReviewsRequest
+
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
+	// Get limit from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("limit", r, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	// Get offset from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("offset", r, &req.Offset); err != nil {
+		return nil, err
+	}
+
+	// Set FilmID, UserID and UserRole
+	req.FilmID = filmUUIDFromPath
+	req.UserID = userID
+	req.UserRole = userRole
+
+	return req, nil
+}
+
+// DeleteReview godoc
+// @Summary Delete own review of a film
+// @Description Delete own review of a film. Admins can delete any review.
+// @Tags Review
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param review_id path string true "Review UUID"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.DeleteReviewResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /films/{id}/reviews/{review_id} [delete] .
+func decodeHTTPDeleteReviewRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get review UUID from path
+	reviewUUIDFromPath, err := httpTransport.GetValueFromPath(r, "review_id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, err := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if err != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	return endpoints.DeleteReviewRequest{UUID: reviewUUIDFromPath, FilmID: filmUUIDFromPath, AuthorID: userID, AuthorRole: userRole}, nil
+}
+
+// AddGenre godoc
+// @Summary Add a genre
+// @Description Add a genre. Only admins can manage genres.
+// @Tags Genre
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param form body endpoints.AddGenreRequest true "Add genre form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.AddGenreResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /genres/ [post] .
+func decodeHTTPAddGenreRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.AddGenreRequest
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set UserID and UserRole
+	reqForm.UserID = userID
+	reqForm.UserRole = userRole
+
+	return reqForm, nil
+}
+
+// UpdateGenre godoc
+// @Summary Rename a genre
+// @Description Rename a genre. Only admins can manage genres.
+// @Tags Genre
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Genre ID"
+// @Param form body endpoints.UpdateGenreRequest true "Update genre form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.UpdateGenreResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /genres/{id} [put] .
+func decodeHTTPUpdateGenreRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.UpdateGenreRequest
+
+	// Get ID from path
+	idFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set ID, UserID and UserRole
+	reqForm.ID = idFromPath
+	reqForm.UserID = userID
+	reqForm.UserRole = userRole
+
+	return reqForm, nil
+}
+
+// DeleteGenre godoc
+// @Summary Delete a genre
+// @Description Delete a genre. Only admins can manage genres. A genre used by films is deleted only with cascade=true, it is removed from the films then.
+// @Tags Genre
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Genre ID"
+// @Param cascade query string false "remove the genre from films" example(true or false)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.DeleteGenreResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /genres/{id} [delete] .
+func decodeHTTPDeleteGenreRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get ID from path
+	idFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	return endpoints.DeleteGenreRequest{
+		ID:       idFromPath,
+		UserID:   userID,
+		UserRole: userRole,
+		Cascade:  r.URL.Query().Get("cascade"),
+	}, nil
+}
+
+// ViewAllGenres godoc
+// @Summary View all genres
+// @Description View all genres with count of their films. Only admins can manage genres.
+// @Tags Genre
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param sort query string false "sort" example(name.asc or films_count.desc)
+// @Param limit query string false "limit" example(10)
+// @Param offset query string false "offset" example(1)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewAllGenresResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /genres/ [get] .
+func decodeHTTPViewAllGenresRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ViewAllGenresRequest
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get user role from context
+	userRole, errUserRole := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return nil, httpTransport.ErrContextUserRole
+	}
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
+	// Get limit from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("limit", r, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	// Get offset from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("offset", r, &req.Offset); err != nil {
+		return nil, err
+	}
+
+	// Set UserID and UserRole
+	req.UserID = userID
+	req.UserRole = userRole
+
+	return req, nil
 }
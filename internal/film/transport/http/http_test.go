@@ -0,0 +1,203 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"film-management/config"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/internal/film/endpoints"
+	filmHttp "film-management/internal/film/transport/http"
+	"film-management/pkg/auth"
+	"film-management/pkg/policy"
+	httpAuth "film-management/pkg/transport/http/middlewares/auth"
+	"film-management/repositories/storage/memory"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	ConfigPath = "../../../../config"
+)
+
+// TestImportFilmsHandler tests the import films handler.
+func TestImportFilmsHandler(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetConfig(ConfigPath)
+	log := zap.NewNop()
+
+	// Paths of keys are relative to the root of the project
+	authConfig := cfg.Services.Auth
+	authConfig.PathPrivateKeyFile = filepath.Join(ConfigPath, "ssl", "jwtRS256.key")
+	authConfig.PathPublicKeyFile = filepath.Join(ConfigPath, "ssl", "jwtRS256.key.pub")
+	authService := auth.NewAuthService(authConfig, log)
+
+	userID := uuid.New()
+	token, _, err := authService.GenerateAuthToken(userID.String(), string(policy.RoleEditor))
+	require.NoError(t, err)
+
+	type request struct {
+		contentType string
+		query       string
+		body        []byte
+	}
+
+	tests := []struct {
+		name string
+		request
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "duplicate titles in any case",
+			request: request{
+				contentType: "text/csv",
+				body: []byte("title,director,genres,release_date,casts,synopsis\n" +
+					"Alpha,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n" +
+					"ALPHA,John Doe,drama,2020-01-01,Jane Roe,Synopsis of Alpha\n"),
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `"created":1,"skipped":1,"failed":0`,
+		},
+		{
+			name: "existing title in other case",
+			request: request{
+				contentType: "text/csv",
+				body: []byte("title,director,genres,release_date,casts,synopsis\n" +
+					"EXISTING FILM,John Doe,drama,2020-01-01,Jane Roe,Synopsis of the film\n"),
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `"created":0,"skipped":1,"failed":0,"rows":[{"row":2,"title":"EXISTING FILM","status":"skipped","reason":"film already exists with the same title"}]`,
+		},
+		{
+			name: "file is too large",
+			request: request{
+				contentType: "text/csv",
+				body:        bytes.Repeat([]byte("a"), 32<<20+1),
+			},
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+			expectedResponse:   `{"code":413,"message":"file is larger than 32 MB: request body is too large"}`,
+		},
+		{
+			name: "unsupported content type",
+			request: request{
+				contentType: "application/json",
+				body:        []byte(`{}`),
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+			expectedResponse:   `{"code":415,"message":"unsupported content type"}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _ := newFilmService(t)
+			serviceEndpoints := endpoints.NewEndpoints(service, log)
+			serviceHTTPHandler := filmHttp.NewHTTPHandlers(serviceEndpoints, authService, cfg, log)
+
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, filmHttp.APIPath+"import"+test.request.query, bytes.NewReader(test.request.body))
+			req.Header.Set("Authorization", httpAuth.AuthorizationPrefix+" "+token)
+			req.Header.Set("Content-Type", test.request.contentType)
+
+			w := httptest.NewRecorder()
+			serviceHTTPHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Contains(t, strings.TrimSpace(w.Body.String()), test.expectedResponse)
+		})
+	}
+}
+
+// TestDeleteFilmHandler tests If-Match header of the delete film handler when the version is required.
+func TestDeleteFilmHandler(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetConfig(ConfigPath)
+	log := zap.NewNop()
+
+	// Paths of keys are relative to the root of the project
+	authConfig := cfg.Services.Auth
+	authConfig.PathPrivateKeyFile = filepath.Join(ConfigPath, "ssl", "jwtRS256.key")
+	authConfig.PathPublicKeyFile = filepath.Join(ConfigPath, "ssl", "jwtRS256.key.pub")
+	authService := auth.NewAuthService(authConfig, log)
+
+	token, _, err := authService.GenerateAuthToken(uuid.New().String(), string(policy.RoleAdmin))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		ifMatch            string
+		missingFilm        bool
+		expectedStatusCode int
+	}{
+		{name: "current version", ifMatch: `"1"`, expectedStatusCode: http.StatusOK},
+		{name: "any version", ifMatch: "*", expectedStatusCode: http.StatusOK},
+		{name: "any version of missing film", ifMatch: "*", missingFilm: true, expectedStatusCode: http.StatusNotFound},
+		{name: "old version", ifMatch: `"2"`, expectedStatusCode: http.StatusPreconditionFailed},
+		{name: "wrong etag", ifMatch: `"abc"`, expectedStatusCode: http.StatusPreconditionFailed},
+		{name: "no version", ifMatch: "", expectedStatusCode: http.StatusPreconditionRequired},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, film := newFilmService(t, domain.WithVersionRequired(true))
+			serviceEndpoints := endpoints.NewEndpoints(service, log)
+			serviceHTTPHandler := filmHttp.NewHTTPHandlers(serviceEndpoints, authService, cfg, log)
+
+			filmID := film.UUID
+			if test.missingFilm {
+				filmID = uuid.New()
+			}
+
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodDelete, filmHttp.APIPath+filmID.String(), nil)
+			req.Header.Set("Authorization", httpAuth.AuthorizationPrefix+" "+token)
+
+			if test.ifMatch != "" {
+				req.Header.Set("If-Match", test.ifMatch)
+			}
+
+			w := httptest.NewRecorder()
+			serviceHTTPHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code, w.Body.String())
+		})
+	}
+}
+
+// newFilmService is a function to create a service of films in memory with genre drama and a film titled "Existing film".
+func newFilmService(t *testing.T, opts ...domain.OptFunc) (domain.Service, models.Film) {
+	t.Helper()
+
+	ctx := context.Background()
+	admin := policy.NewActor(uuid.New(), policy.RoleAdmin)
+	service := domain.NewService(memory.NewFilmRepository(memory.NewStore(), zap.NewNop()), opts...)
+
+	require.NoError(t, service.AddGenre(ctx, &models.Genre{Name: "drama"}, admin))
+
+	film := models.Film{
+		Title:       "Existing film",
+		Director:    models.Director{Name: "John Doe"},
+		Genres:      []models.Genre{{Name: "drama"}},
+		Credits:     []models.Credit{{Cast: models.Cast{Name: "Jane Roe"}, Department: models.DepartmentCast}},
+		Synopsis:    "Synopsis of the film",
+		ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, service.AddFilm(ctx, &film, admin))
+
+	return service, film
+}
@@ -0,0 +1,31 @@
+package endpoints
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	graphqlGo "github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+)
+
+// Executor is an interface for GraphQL schema which executes queries.
+type Executor interface {
+	Exec(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphqlGo.Response
+}
+
+// SetEndpoints collects all the endpoints that compose a GraphQL service.
+type SetEndpoints struct {
+	ExecuteEndpoint endpoint.Endpoint
+}
+
+// NewEndpoints returns a SetEndpoints that wraps the provided server, and wires in all the provided middlewares.
+func NewEndpoints(s Executor, logger *zap.Logger) SetEndpoints {
+	var executeEndpoint endpoint.Endpoint
+	{
+		executeEndpoint = MakeExecuteEndpoint(s)
+		executeEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "Execute")))(executeEndpoint)
+	}
+
+	return SetEndpoints{
+		ExecuteEndpoint: executeEndpoint,
+	}
+}
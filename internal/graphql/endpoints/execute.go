@@ -0,0 +1,64 @@
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/graph-gophers/graphql-go/errors"
+)
+
+// MakeExecuteEndpoint is an endpoint for Execute.
+func MakeExecuteEndpoint(s Executor) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ExecuteRequest)
+		if !ok {
+			return ExecuteResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ExecuteResponse{Err: errValidate}, nil
+		}
+
+		// Errors of the query are returned in the response with data of valid fields
+		result := s.Exec(ctx, reqForm.Query, reqForm.OperationName, reqForm.Variables)
+
+		return ExecuteResponse{
+			Data:       result.Data,
+			Errors:     result.Errors,
+			Extensions: result.Extensions,
+		}, nil
+	}
+}
+
+// ExecuteRequest is a request for Execute.
+type ExecuteRequest struct {
+	Query         string                 `json:"query" validate:"required,max=20000" example:"{ films(limit: 5) { items { title director { name } } } }"`
+	OperationName string                 `json:"operationName" validate:"omitempty,max=100" example:"FilmPage"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Validate is a method to validate form.
+func (r *ExecuteRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ExecuteResponse is a response for Execute.
+type ExecuteResponse struct {
+	Errors     []*errors.QueryError   `json:"errors,omitempty" swaggertype:"array,object"`
+	Data       json.RawMessage        `json:"data,omitempty" swaggertype:"object"`
+	Extensions map[string]interface{} `json:"extensions,omitempty" swaggerignore:"true"`
+	Err        error                  `json:"-" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ExecuteResponse) Failed() error { return r.Err }
@@ -0,0 +1,109 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/dataloader"
+	"film-management/pkg/query"
+	"film-management/pkg/query/sort"
+)
+
+var (
+	ErrLoadersNotFound = errors.New("loaders not found in context")
+)
+
+// filmsPageSize is a number of films read by one query of a batch, films of a batch are read page by page.
+const filmsPageSize = 100
+
+// loadersKey is a context key of loaders of the request.
+type loadersKey struct{}
+
+// loaders is a set of loaders of the request, films of directors and people are read by one query for all fields of a level.
+type loaders struct {
+	directorFilms *dataloader.Loader[uint, []models.Film]
+	castFilms     *dataloader.Loader[uint, []models.Film]
+}
+
+// newLoaders is a function to create loaders of a request.
+func newLoaders(s domain.Service) *loaders {
+	return &loaders{
+		directorFilms: dataloader.New(loadDirectorFilms(s), dataloader.WithMaxBatch(maxParallelism)),
+		castFilms:     dataloader.New(loadCastFilms(s), dataloader.WithMaxBatch(maxParallelism)),
+	}
+}
+
+// withLoaders is a function to set loaders of the request to the context.
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+// loadersFromContext is a function to get loaders of the request from the context.
+func loadersFromContext(ctx context.Context) (*loaders, error) {
+	l, ok := ctx.Value(loadersKey{}).(*loaders)
+	if !ok {
+		return nil, ErrLoadersNotFound
+	}
+
+	return l, nil
+}
+
+// loadDirectorFilms is a function to get a batch function of films of directors.
+func loadDirectorFilms(s domain.Service) dataloader.BatchFunc[uint, []models.Film] {
+	return func(ctx context.Context, directorIDs []uint) (map[uint][]models.Film, error) {
+		films := make(map[uint][]models.Film, len(directorIDs))
+
+		err := exportFilms(ctx, s, query.Filter{"director_ids": directorIDs}, func(film models.Film) {
+			films[film.DirectorID] = append(films[film.DirectorID], film)
+		})
+
+		return films, err
+	}
+}
+
+// loadCastFilms is a function to get a batch function of films of people credited in films.
+func loadCastFilms(s domain.Service) dataloader.BatchFunc[uint, []models.Film] {
+	return func(ctx context.Context, castIDs []uint) (map[uint][]models.Film, error) {
+		films := make(map[uint][]models.Film, len(castIDs))
+
+		requested := make(map[uint]bool, len(castIDs))
+		for _, castID := range castIDs {
+			requested[castID] = true
+		}
+
+		err := exportFilms(ctx, s, query.Filter{"cast_ids": castIDs}, func(film models.Film) {
+			// A person may have several credits in a film, the film is added once
+			added := make(map[uint]bool)
+
+			for _, credit := range film.Credits {
+				if requested[credit.CastID] && !added[credit.CastID] {
+					added[credit.CastID] = true
+					films[credit.CastID] = append(films[credit.CastID], film)
+				}
+			}
+		})
+
+		return films, err
+	}
+}
+
+// exportFilms is a function to read all films with the filter, the latest first.
+func exportFilms(ctx context.Context, s domain.Service, filter query.Filter, fn func(models.Film)) error {
+	sortOption, err := sort.GetSortOptions("release_date.desc", []string{"release_date"}, "release_date.desc")
+	if err != nil {
+		return err
+	}
+
+	filterSortLimit := query.NewFilterSortLimitBuilder().
+		SetSort(sortOption).
+		SetFilter(filter).
+		SetLimit(filmsPageSize).
+		Build()
+
+	return s.ExportFilms(ctx, filterSortLimit, func(film models.Film) error {
+		fn(film)
+
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+package resolvers
+
+import (
+	"context"
+	filmEndpoints "film-management/internal/film/endpoints"
+	graphqlTransport "film-management/pkg/transport/graphql"
+	"github.com/google/uuid"
+	graphqlGo "github.com/graph-gophers/graphql-go"
+)
+
+// filmInput is an input of a film.
+type filmInput struct {
+	Title       string
+	Director    string
+	ReleaseDate string
+	Genres      []string
+	Casts       []creditInput
+	Synopsis    string
+}
+
+// creditInput is an input of a person credited in a film.
+type creditInput struct {
+	Name         string
+	Character    *string
+	Department   *string
+	BillingOrder *int32
+}
+
+// AddFilm is a resolver to add a film by the current user.
+func (r *Resolver) AddFilm(ctx context.Context, args struct{ Input filmInput }) (*filmResolver, error) {
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := callEndpoint(ctx, r.films.AddFilmEndpoint, filmEndpoints.AddFilmRequest{
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Title:       args.Input.Title,
+		Director:    args.Input.Director,
+		ReleaseDate: args.Input.ReleaseDate,
+		Genres:      args.Input.Genres,
+		Casts:       creditInputsToCreditForms(args.Input.Casts),
+		Synopsis:    args.Input.Synopsis,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.viewFilm(ctx, response.(filmEndpoints.AddFilmResponse).Item.UUID)
+}
+
+// UpdateFilm is a resolver to update a film by the current user.
+func (r *Resolver) UpdateFilm(ctx context.Context, args struct {
+	UUID    graphqlGo.ID
+	Version *int32
+	Input   filmInput
+}) (*filmResolver, error) {
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := callEndpoint(ctx, r.films.UpdateFilmEndpoint, filmEndpoints.UpdateFilmRequest{
+		UUID:        string(args.UUID),
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Version:     int(int32Value(args.Version)),
+		Title:       args.Input.Title,
+		Director:    args.Input.Director,
+		ReleaseDate: args.Input.ReleaseDate,
+		Genres:      args.Input.Genres,
+		Casts:       creditInputsToCreditForms(args.Input.Casts),
+		Synopsis:    args.Input.Synopsis,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.viewFilm(ctx, response.(filmEndpoints.UpdateFilmResponse).Item.UUID)
+}
+
+// DeleteFilm is a resolver to delete a film to trash by the current user.
+func (r *Resolver) DeleteFilm(ctx context.Context, args struct {
+	UUID    graphqlGo.ID
+	Version *int32
+}) (bool, error) {
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := callEndpoint(ctx, r.films.DeleteFilmEndpoint, filmEndpoints.DeleteFilmRequest{
+		UUID:        string(args.UUID),
+		CreatorID:   userID,
+		CreatorRole: userRole,
+		Version:     int(int32Value(args.Version)),
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// viewFilm is a method to read the changed film with director and people, the film is read from the film service.
+func (r *Resolver) viewFilm(ctx context.Context, filmID uuid.UUID) (*filmResolver, error) {
+	film, err := r.filmService.ViewFilm(ctx, filmID)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	return newFilmResolver(film), nil
+}
+
+// creditInputsToCreditForms is a function to convert credit inputs to credit forms of endpoints.
+func creditInputsToCreditForms(inputs []creditInput) []filmEndpoints.CreditForm {
+	forms := make([]filmEndpoints.CreditForm, 0, len(inputs))
+
+	for _, input := range inputs {
+		forms = append(forms, filmEndpoints.CreditForm{
+			Name:         input.Name,
+			Character:    stringValue(input.Character),
+			Department:   stringValue(input.Department),
+			BillingOrder: int(int32Value(input.BillingOrder)),
+		})
+	}
+
+	return forms
+}
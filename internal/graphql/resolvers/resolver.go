@@ -0,0 +1,256 @@
+package resolvers
+
+import (
+	"context"
+	directorEndpoints "film-management/internal/director/endpoints"
+	"film-management/internal/film/domain"
+	filmEndpoints "film-management/internal/film/endpoints"
+	customError "film-management/pkg/errors"
+	graphqlTransport "film-management/pkg/transport/graphql"
+	"film-management/pkg/transport/http/middlewares/auth"
+	"film-management/pkg/utils"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	graphqlGo "github.com/graph-gophers/graphql-go"
+)
+
+// Resolver is a root resolver of queries and mutations.
+type Resolver struct {
+	filmService domain.Service
+	films       filmEndpoints.SetEndpoints
+	directors   directorEndpoints.SetEndpoints
+}
+
+// filmsArgs is a set of arguments of films.
+type filmsArgs struct {
+	Filter    *filmFilterInput
+	Sort      *string
+	Limit     *int32
+	Offset    *int32
+	Cursor    *string
+	WithCount *bool
+	Facets    *[]string
+}
+
+// filmFilterInput is an input of filters of films.
+type filmFilterInput struct {
+	Q           *string
+	Title       *string
+	ReleaseDate *string
+	Year        *string
+	Genres      *[]string
+	GenresMode  *string
+	Director    *string
+	Cast        *string
+	CreatorID   *string
+}
+
+// Film is a resolver of a film by UUID.
+func (r *Resolver) Film(ctx context.Context, args struct{ UUID graphqlGo.ID }) (*filmResolver, error) {
+	filmID, err := uuid.Parse(string(args.UUID))
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(customError.ValidationError{Field: "uuid", Err: err})
+	}
+
+	film, err := r.filmService.ViewFilm(ctx, filmID)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	return newFilmResolver(film), nil
+}
+
+// Films is a resolver of films with the same filters, sort and pagination as the list of films.
+func (r *Resolver) Films(ctx context.Context, args filmsArgs) (*filmConnectionResolver, error) {
+	reqForm := filmEndpoints.ViewAllFilmsRequest{
+		Sort:   stringValue(args.Sort),
+		Limit:  int(int32Value(args.Limit)),
+		Offset: int(int32Value(args.Offset)),
+		Cursor: stringValue(args.Cursor),
+	}
+
+	if args.WithCount != nil {
+		reqForm.WithCount = "false"
+		if *args.WithCount {
+			reqForm.WithCount = "true"
+		}
+	}
+
+	if args.Facets != nil {
+		reqForm.Facets = *args.Facets
+	}
+
+	if filter := args.Filter; filter != nil {
+		reqForm.FilmFilters = filmEndpoints.FilmFilters{
+			Q:           stringValue(filter.Q),
+			Title:       stringValue(filter.Title),
+			ReleaseDate: stringValue(filter.ReleaseDate),
+			Year:        stringValue(filter.Year),
+			GenresMode:  stringValue(filter.GenresMode),
+			Director:    stringValue(filter.Director),
+			Cast:        stringValue(filter.Cast),
+			CreatorID:   stringValue(filter.CreatorID),
+		}
+
+		if filter.Genres != nil {
+			reqForm.Genres = *filter.Genres
+		}
+	}
+
+	// Films of the current user
+	if reqForm.CreatorID == "me" {
+		userID, err := utils.GetValueFromContext(ctx, auth.ContextKeyUserID)
+		if err != nil {
+			return nil, graphqlTransport.EncodeError(graphqlTransport.ErrContextUserID)
+		}
+
+		reqForm.CreatorID = userID
+	}
+
+	// Validate form
+	if errValidate := reqForm.Validate(); errValidate != nil {
+		return nil, graphqlTransport.EncodeError(errValidate)
+	}
+
+	filterSortLimit, err := reqForm.FilterSortLimit()
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	films, p, err := r.filmService.ViewAllFilms(ctx, filterSortLimit)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	// Count facets with the same filters
+	counts, err := r.filmService.ViewFilmFacets(ctx, filterSortLimit.Filter, reqForm.Facets)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	return &filmConnectionResolver{
+		items:      newFilmResolvers(films),
+		pagination: p,
+		facets:     newFacetResolvers(counts, reqForm.Facets),
+	}, nil
+}
+
+// Director is a resolver of a director by ID.
+func (r *Resolver) Director(ctx context.Context, args struct{ ID graphqlGo.ID }) (*directorResolver, error) {
+	response, err := callEndpoint(ctx, r.directors.ViewDirectorEndpoint, directorEndpoints.ViewDirectorRequest{ID: string(args.ID)})
+	if err != nil {
+		return nil, err
+	}
+
+	item := response.(directorEndpoints.ViewDirectorResponse).Item.ItemDirector
+
+	return newDirectorResolver(item.ID, item.Name, &item.FilmsCount), nil
+}
+
+// Directors is a resolver of directors with the same filters, sort and pagination as the list of directors.
+func (r *Resolver) Directors(ctx context.Context, args struct {
+	Name   *string
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*directorConnectionResolver, error) {
+	response, err := callEndpoint(ctx, r.directors.ViewAllDirectorsEndpoint, directorEndpoints.ViewAllDirectorsRequest{
+		Sort:   stringValue(args.Sort),
+		Limit:  int(int32Value(args.Limit)),
+		Offset: int(int32Value(args.Offset)),
+		Name:   stringValue(args.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.(directorEndpoints.ViewAllDirectorsResponse)
+
+	items := make([]*directorResolver, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		item := item
+		items = append(items, newDirectorResolver(item.ID, item.Name, &item.FilmsCount))
+	}
+
+	return &directorConnectionResolver{items: items, pagination: resp.Pagination}, nil
+}
+
+// Genres is a resolver of genres with the same sort and pagination as the list of genres.
+func (r *Resolver) Genres(ctx context.Context, args struct {
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*genreConnectionResolver, error) {
+	userID, userRole, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := callEndpoint(ctx, r.films.ViewAllGenresEndpoint, filmEndpoints.ViewAllGenresRequest{
+		UserID:   userID,
+		UserRole: userRole,
+		Sort:     stringValue(args.Sort),
+		Limit:    int(int32Value(args.Limit)),
+		Offset:   int(int32Value(args.Offset)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response.(filmEndpoints.ViewAllGenresResponse)
+
+	items := make([]*genreResolver, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		item := item
+		items = append(items, &genreResolver{id: item.ID, name: item.Name, filmsCount: &item.FilmsCount})
+	}
+
+	return &genreConnectionResolver{items: items, pagination: resp.Pagination}, nil
+}
+
+// callEndpoint is a function to call the endpoint, an error of the response is returned as an error of the resolver.
+func callEndpoint(ctx context.Context, e endpoint.Endpoint, request interface{}) (interface{}, error) {
+	response, err := e(ctx, request)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	if f, ok := response.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, graphqlTransport.EncodeError(f.Failed())
+	}
+
+	return response, nil
+}
+
+// userFromContext is a function to get UUID and role of the current user from the context.
+func userFromContext(ctx context.Context) (userID string, userRole string, err error) {
+	userID, errUserID := utils.GetValueFromContext(ctx, auth.ContextKeyUserID)
+	if errUserID != nil {
+		return "", "", graphqlTransport.EncodeError(graphqlTransport.ErrContextUserID)
+	}
+
+	userRole, errUserRole := utils.GetValueFromContext(ctx, auth.ContextKeyUserRole)
+	if errUserRole != nil {
+		return "", "", graphqlTransport.EncodeError(graphqlTransport.ErrContextUserRole)
+	}
+
+	return userID, userRole, nil
+}
+
+// stringValue is a function to get a value of an optional argument.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// int32Value is a function to get a value of an optional argument.
+func int32Value(value *int32) int32 {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
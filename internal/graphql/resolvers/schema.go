@@ -0,0 +1,51 @@
+package resolvers
+
+import (
+	"context"
+	_ "embed"
+	directorEndpoints "film-management/internal/director/endpoints"
+	"film-management/internal/film/domain"
+	filmEndpoints "film-management/internal/film/endpoints"
+	graphqlTransport "film-management/pkg/transport/graphql"
+	graphqlGo "github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxDepth is a maximum depth of nested fields of a query.
+	maxDepth = 10
+	// maxParallelism is a maximum number of fields resolved at once, it is also a maximum size of a batch of a loader.
+	maxParallelism = 100
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+// Schema is a GraphQL schema of films, directors, genres and cast.
+type Schema struct {
+	schema      *graphqlGo.Schema
+	filmService domain.Service
+}
+
+// NewSchema is a function to create a GraphQL schema, films are read from the film service and changed by endpoints.
+func NewSchema(filmService domain.Service, films filmEndpoints.SetEndpoints, directors directorEndpoints.SetEndpoints, logger *zap.Logger) *Schema {
+	resolver := &Resolver{
+		filmService: filmService,
+		films:       films,
+		directors:   directors,
+	}
+
+	return &Schema{
+		schema: graphqlGo.MustParseSchema(schemaString, resolver,
+			graphqlGo.MaxDepth(maxDepth),
+			graphqlGo.MaxParallelism(maxParallelism),
+			graphqlGo.Logger(graphqlTransport.NewPanicLogger(logger)),
+		),
+		filmService: filmService,
+	}
+}
+
+// Exec is a method to execute the query, loaded values are shared by fields of the query only.
+func (s *Schema) Exec(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphqlGo.Response {
+	return s.schema.Exec(withLoaders(ctx, newLoaders(s.filmService)), query, operationName, variables)
+}
@@ -0,0 +1,198 @@
+package resolvers_test
+
+import (
+	"context"
+	directorEndpoints "film-management/internal/director/endpoints"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	filmEndpoints "film-management/internal/film/endpoints"
+	"film-management/internal/graphql/resolvers"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	graphqlTransport "film-management/pkg/transport/graphql"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeFilmService is a film service with films in memory, methods which are not used by the test panic.
+type fakeFilmService struct {
+	domain.Service
+
+	films []models.Film
+
+	mu      sync.Mutex
+	exports []query.Filter
+}
+
+func (s *fakeFilmService) ViewFilm(_ context.Context, filmID uuid.UUID) (models.Film, error) {
+	for _, film := range s.films {
+		if film.UUID == filmID {
+			return film, nil
+		}
+	}
+
+	return models.Film{}, customError.NotFoundError{Err: domain.ErrFilmNotFound}
+}
+
+func (s *fakeFilmService) ViewAllFilms(_ context.Context, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error) {
+	films := s.films
+	if len(films) > filterSortLimit.Limit {
+		films = films[:filterSortLimit.Limit]
+	}
+
+	return films, pagination.NewPagination(len(s.films), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+func (s *fakeFilmService) ViewFilmFacets(_ context.Context, _ query.Filter, _ []string) ([]models.FacetCount, error) {
+	return nil, nil
+}
+
+func (s *fakeFilmService) ExportFilms(_ context.Context, filterSortLimit query.FilterSortLimit, fn func(models.Film) error) error {
+	s.mu.Lock()
+	s.exports = append(s.exports, filterSortLimit.Filter)
+	s.mu.Unlock()
+
+	directorIDs, _ := filterSortLimit.Filter["director_ids"].([]uint)
+
+	for _, film := range s.films {
+		for _, directorID := range directorIDs {
+			if film.DirectorID == directorID {
+				if err := fn(film); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// newFakeFilmService is a function to create a film service with two films of one director and one film of another.
+func newFakeFilmService() *fakeFilmService {
+	nolan := models.Director{ID: 1, Name: "Christopher Nolan"}
+	villeneuve := models.Director{ID: 2, Name: "Denis Villeneuve"}
+
+	film := func(title string, director models.Director) models.Film {
+		return models.Film{
+			UUID:        uuid.New(),
+			Title:       title,
+			DirectorID:  director.ID,
+			Director:    director,
+			ReleaseDate: time.Date(2010, time.July, 16, 0, 0, 0, 0, time.UTC),
+			Genres:      []models.Genre{{ID: 1, Name: "sci-fi"}},
+			Credits: []models.Credit{
+				{CastID: 1, Cast: models.Cast{ID: 1, Name: "Tom Hardy"}, Department: models.DepartmentCast, BillingOrder: 1},
+				{CastID: 2, Cast: models.Cast{ID: 2, Name: "Hans Zimmer"}, Department: models.DepartmentComposer, BillingOrder: 2},
+			},
+		}
+	}
+
+	return &fakeFilmService{
+		films: []models.Film{
+			film("Inception", nolan),
+			film("Dunkirk", nolan),
+			film("Dune", villeneuve),
+		},
+	}
+}
+
+// exec is a function to execute the query and decode data of the response.
+func exec(t *testing.T, s *fakeFilmService, queryString string, target interface{}) []error {
+	t.Helper()
+
+	logger := zap.NewNop()
+	schema := resolvers.NewSchema(s, filmEndpoints.NewEndpoints(s, logger), directorEndpoints.SetEndpoints{}, logger)
+
+	response := schema.Exec(context.TODO(), queryString, "", nil)
+
+	if len(response.Data) > 0 && string(response.Data) != "null" {
+		require.NoError(t, jsoniter.Unmarshal(response.Data, target))
+	}
+
+	errs := make([]error, 0, len(response.Errors))
+	for _, err := range response.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func TestSchema_FilmsWithDirectorFilms(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeFilmService()
+
+	var data struct {
+		Films struct {
+			Items []struct {
+				Title    string
+				Casts    []struct{ Person struct{ Name string } }
+				Director struct {
+					Name       string
+					FilmsCount int
+					Films      []struct{ Title string }
+				}
+			}
+			Pagination struct{ TotalCount int }
+		}
+	}
+
+	errs := exec(t, s, `{ films(limit: 10) {
+		items { title casts { person { name } } director { name filmsCount films { title } } }
+		pagination { totalCount }
+	} }`, &data)
+	require.Empty(t, errs)
+
+	require.Len(t, data.Films.Items, 3)
+	assert.Equal(t, 3, data.Films.Pagination.TotalCount)
+
+	inception := data.Films.Items[0]
+	assert.Equal(t, "Inception", inception.Title)
+	require.Len(t, inception.Casts, 1)
+	assert.Equal(t, "Tom Hardy", inception.Casts[0].Person.Name)
+	assert.Equal(t, "Christopher Nolan", inception.Director.Name)
+	assert.Equal(t, 2, inception.Director.FilmsCount)
+	require.Len(t, inception.Director.Films, 2)
+	assert.Equal(t, "Dunkirk", inception.Director.Films[1].Title)
+
+	assert.Equal(t, 1, data.Films.Items[2].Director.FilmsCount)
+
+	// Films of all directors are read at once
+	require.Len(t, s.exports, 1)
+	assert.ElementsMatch(t, []uint{1, 2}, s.exports[0]["director_ids"])
+}
+
+func TestSchema_FilmNotFound(t *testing.T) {
+	t.Parallel()
+
+	var data struct{ Film *struct{ Title string } }
+
+	errs := exec(t, newFakeFilmService(), `{ film(uuid: "`+uuid.NewString()+`") { title } }`, &data)
+	require.Len(t, errs, 1)
+	assert.Nil(t, data.Film)
+
+	var graphqlErr *graphqlTransport.Error
+	require.ErrorAs(t, errs[0], &graphqlErr)
+	assert.Equal(t, graphqlTransport.CodeNotFound, graphqlErr.Extensions()["code"])
+}
+
+func TestSchema_FilmsValidation(t *testing.T) {
+	t.Parallel()
+
+	var data struct{}
+
+	errs := exec(t, newFakeFilmService(), `{ films(limit: 1000) { items { title } } }`, &data)
+	require.Len(t, errs, 1)
+
+	var graphqlErr *graphqlTransport.Error
+	require.ErrorAs(t, errs[0], &graphqlErr)
+	assert.Equal(t, graphqlTransport.CodeBadUserInput, graphqlErr.Extensions()["code"])
+	assert.Contains(t, graphqlErr.Extensions()["fields"], "limit")
+}
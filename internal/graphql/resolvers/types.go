@@ -0,0 +1,352 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query/pagination"
+	graphqlTransport "film-management/pkg/transport/graphql"
+	graphqlGo "github.com/graph-gophers/graphql-go"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrFilmsLimit = errors.New("limit must be a positive number")
+)
+
+// filmResolver is a resolver of a film, director, genres and people are read with the film.
+type filmResolver struct {
+	film models.Film
+}
+
+// newFilmResolver is a function to create a resolver of the film.
+func newFilmResolver(film models.Film) *filmResolver {
+	return &filmResolver{film: film}
+}
+
+// newFilmResolvers is a function to create resolvers of the films.
+func newFilmResolvers(films []models.Film) []*filmResolver {
+	resolvers := make([]*filmResolver, 0, len(films))
+
+	for _, film := range films {
+		resolvers = append(resolvers, newFilmResolver(film))
+	}
+
+	return resolvers
+}
+
+func (r *filmResolver) UUID() graphqlGo.ID { return graphqlGo.ID(r.film.UUID.String()) }
+
+func (r *filmResolver) Title() string { return r.film.Title }
+
+func (r *filmResolver) Director() *directorResolver {
+	// Count of films of the director is not read with the film
+	return newDirectorResolver(r.film.Director.ID, r.film.Director.Name, nil)
+}
+
+func (r *filmResolver) Genres() []*genreResolver {
+	genres := make([]*genreResolver, 0, len(r.film.Genres))
+
+	for _, genre := range r.film.Genres {
+		genres = append(genres, &genreResolver{id: genre.ID, name: genre.Name})
+	}
+
+	return genres
+}
+
+func (r *filmResolver) ReleaseDate() string { return r.film.ReleaseDate.Format(time.DateOnly) }
+
+func (r *filmResolver) Casts() []*creditResolver {
+	credits := make([]*creditResolver, 0, len(r.film.Credits))
+
+	for _, credit := range r.film.Credits {
+		if credit.Department == models.DepartmentCast {
+			credits = append(credits, &creditResolver{credit: credit})
+		}
+	}
+
+	return credits
+}
+
+func (r *filmResolver) Credits() []*creditResolver {
+	credits := make([]*creditResolver, 0, len(r.film.Credits))
+
+	for _, credit := range r.film.Credits {
+		credits = append(credits, &creditResolver{credit: credit})
+	}
+
+	return credits
+}
+
+func (r *filmResolver) Synopsis() string { return r.film.Synopsis }
+
+func (r *filmResolver) Rating() float64 { return r.film.Rating }
+
+func (r *filmResolver) RatingCount() int32 { return int32(r.film.RatingCount) }
+
+func (r *filmResolver) Version() int32 { return int32(r.film.Version) }
+
+func (r *filmResolver) CreatedAt() string {
+	return time.Unix(r.film.CreatedAt, 0).Format(time.DateTime)
+}
+
+func (r *filmResolver) UpdatedAt() string {
+	return time.Unix(r.film.UpdatedAt, 0).Format(time.DateTime)
+}
+
+func (r *filmResolver) Relevance() *float64 {
+	if r.film.Relevance == 0 {
+		return nil
+	}
+
+	return &r.film.Relevance
+}
+
+func (r *filmResolver) Highlight() *string {
+	if r.film.Highlight == "" {
+		return nil
+	}
+
+	return &r.film.Highlight
+}
+
+// creditResolver is a resolver of a person credited in a film.
+type creditResolver struct {
+	credit models.Credit
+}
+
+func (r *creditResolver) Person() *castResolver { return &castResolver{cast: r.credit.Cast} }
+
+func (r *creditResolver) Character() *string {
+	if r.credit.Character == "" {
+		return nil
+	}
+
+	return &r.credit.Character
+}
+
+func (r *creditResolver) Department() string { return string(r.credit.Department) }
+
+func (r *creditResolver) BillingOrder() int32 { return int32(r.credit.BillingOrder) }
+
+// castResolver is a resolver of a person credited in films.
+type castResolver struct {
+	cast models.Cast
+}
+
+func (r *castResolver) ID() graphqlGo.ID { return formatID(r.cast.ID) }
+
+func (r *castResolver) Name() string { return r.cast.Name }
+
+// Films is a resolver of films of the person, films of all people of a level are read at once.
+func (r *castResolver) Films(ctx context.Context, args struct{ Limit *int32 }) ([]*filmResolver, error) {
+	l, err := loadersFromContext(ctx)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	films, err := l.castFilms.Load(ctx, r.cast.ID)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	return limitFilms(films, args.Limit)
+}
+
+// directorResolver is a resolver of a director, count of films is nil if it is not known.
+type directorResolver struct {
+	id         uint
+	name       string
+	filmsCount *int64
+}
+
+// newDirectorResolver is a function to create a resolver of the director.
+func newDirectorResolver(id uint, name string, filmsCount *int64) *directorResolver {
+	return &directorResolver{id: id, name: name, filmsCount: filmsCount}
+}
+
+func (r *directorResolver) ID() graphqlGo.ID { return formatID(r.id) }
+
+func (r *directorResolver) Name() string { return r.name }
+
+// FilmsCount is a resolver of count of films of the director, films are loaded if count is not known.
+func (r *directorResolver) FilmsCount(ctx context.Context) (int32, error) {
+	if r.filmsCount != nil {
+		return int32(*r.filmsCount), nil
+	}
+
+	films, err := r.loadFilms(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(len(films)), nil
+}
+
+// Films is a resolver of films of the director, films of all directors of a level are read at once.
+func (r *directorResolver) Films(ctx context.Context, args struct{ Limit *int32 }) ([]*filmResolver, error) {
+	films, err := r.loadFilms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return limitFilms(films, args.Limit)
+}
+
+// loadFilms is a method to load films of the director.
+func (r *directorResolver) loadFilms(ctx context.Context) ([]models.Film, error) {
+	l, err := loadersFromContext(ctx)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	films, err := l.directorFilms.Load(ctx, r.id)
+	if err != nil {
+		return nil, graphqlTransport.EncodeError(err)
+	}
+
+	return films, nil
+}
+
+// genreResolver is a resolver of a genre, count of films is nil if it is not known.
+type genreResolver struct {
+	id         uint
+	name       string
+	filmsCount *int64
+}
+
+func (r *genreResolver) ID() graphqlGo.ID { return formatID(r.id) }
+
+func (r *genreResolver) Name() string { return r.name }
+
+func (r *genreResolver) FilmsCount() *int32 {
+	if r.filmsCount == nil {
+		return nil
+	}
+
+	count := int32(*r.filmsCount)
+
+	return &count
+}
+
+// paginationResolver is a resolver of pagination.
+type paginationResolver struct {
+	p pagination.Pagination
+}
+
+func (r *paginationResolver) Page() int32 { return int32(r.p.Page) }
+
+func (r *paginationResolver) TotalPages() int32 { return int32(r.p.TotalPages) }
+
+func (r *paginationResolver) PageSize() int32 { return int32(r.p.PageSize) }
+
+func (r *paginationResolver) TotalCount() int32 { return int32(r.p.TotalCount) }
+
+func (r *paginationResolver) NextCursor() *string {
+	if r.p.NextCursor == "" {
+		return nil
+	}
+
+	return &r.p.NextCursor
+}
+
+// facetResolver is a resolver of counts of films by values of a facet.
+type facetResolver struct {
+	name   string
+	values []*facetValueResolver
+}
+
+// newFacetResolvers is a function to group counts by facets, every requested facet is present.
+func newFacetResolvers(counts []models.FacetCount, facets []string) []*facetResolver {
+	resolvers := make([]*facetResolver, 0, len(facets))
+	byName := make(map[string]*facetResolver, len(facets))
+
+	for _, facet := range facets {
+		resolver := &facetResolver{name: facet, values: make([]*facetValueResolver, 0)}
+		resolvers = append(resolvers, resolver)
+		byName[facet] = resolver
+	}
+
+	for _, count := range counts {
+		if resolver, ok := byName[count.Facet]; ok {
+			resolver.values = append(resolver.values, &facetValueResolver{value: count.Value, count: count.Count})
+		}
+	}
+
+	return resolvers
+}
+
+func (r *facetResolver) Name() string { return r.name }
+
+func (r *facetResolver) Values() []*facetValueResolver { return r.values }
+
+// facetValueResolver is a resolver of count of films with a value of a facet.
+type facetValueResolver struct {
+	value string
+	count int64
+}
+
+func (r *facetValueResolver) Value() string { return r.value }
+
+func (r *facetValueResolver) Count() int32 { return int32(r.count) }
+
+// filmConnectionResolver is a resolver of a page of films.
+type filmConnectionResolver struct {
+	items      []*filmResolver
+	pagination pagination.Pagination
+	facets     []*facetResolver
+}
+
+func (r *filmConnectionResolver) Items() []*filmResolver { return r.items }
+
+func (r *filmConnectionResolver) Pagination() *paginationResolver {
+	return &paginationResolver{p: r.pagination}
+}
+
+func (r *filmConnectionResolver) Facets() []*facetResolver { return r.facets }
+
+// directorConnectionResolver is a resolver of a page of directors.
+type directorConnectionResolver struct {
+	items      []*directorResolver
+	pagination pagination.Pagination
+}
+
+func (r *directorConnectionResolver) Items() []*directorResolver { return r.items }
+
+func (r *directorConnectionResolver) Pagination() *paginationResolver {
+	return &paginationResolver{p: r.pagination}
+}
+
+// genreConnectionResolver is a resolver of a page of genres.
+type genreConnectionResolver struct {
+	items      []*genreResolver
+	pagination pagination.Pagination
+}
+
+func (r *genreConnectionResolver) Items() []*genreResolver { return r.items }
+
+func (r *genreConnectionResolver) Pagination() *paginationResolver {
+	return &paginationResolver{p: r.pagination}
+}
+
+// limitFilms is a function to keep the first films up to the limit, all films are kept without the limit.
+func limitFilms(films []models.Film, limit *int32) ([]*filmResolver, error) {
+	if limit != nil {
+		if *limit < 1 {
+			return nil, graphqlTransport.EncodeError(customError.ValidationError{Field: "limit", Err: ErrFilmsLimit})
+		}
+
+		if int(*limit) < len(films) {
+			films = films[:*limit]
+		}
+	}
+
+	return newFilmResolvers(films), nil
+}
+
+// formatID is a function to format a numeric ID.
+func formatID(id uint) graphqlGo.ID {
+	return graphqlGo.ID(strconv.FormatUint(uint64(id), 10))
+}
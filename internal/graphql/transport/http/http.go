@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"film-management/config"
+	httpCommon "film-management/internal/common/transport/http"
+	"film-management/internal/graphql/endpoints"
+	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/transport/http/middlewares/auth"
+	"film-management/pkg/transport/http/middlewares/cors"
+	"film-management/pkg/transport/http/middlewares/recovery"
+	"film-management/pkg/transport/http/response"
+	endpointKit "github.com/go-kit/kit/endpoint"
+	httpKitTransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+const (
+	APIPath = httpCommon.APIPath + "graphql"
+)
+
+// NewHTTPHandlers is a function that returns a http.Handler that makes a set of endpoints available on predefined paths.
+func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service, cfg *config.Config, logger *zap.Logger) http.Handler {
+	options := []httpKitTransport.ServerOption{
+		httpKitTransport.ServerErrorHandler(httpTransport.NewLogErrorHandler(logger)),
+		httpKitTransport.ServerErrorEncoder(response.EncodeError),
+	}
+
+	// Handlers
+	// Execute a query
+	executeHandler := httpKitTransport.NewServer(
+		endpoints.ExecuteEndpoint,
+		decodeHTTPExecuteRequest,
+		encodeHTTPExecuteResponse,
+		options...,
+	)
+
+	r := mux.NewRouter()
+
+	// CORS
+	r.Use(mux.CORSMethodMiddleware(r))
+	r.Use(cors.Middleware(cfg.HTTP.CorsAllowedOrigins, logger))
+
+	// Recovery
+	r.Use(recovery.Middleware(logger))
+
+	// AUTH
+	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService, logger))
+
+	// Routes
+
+	// GraphQL
+	//
+	// Execute a query or a mutation
+	r.Handle(APIPath, executeHandler).Methods(http.MethodPost)
+
+	// Set custom error handlers
+	response.SetErrorHandlers(r)
+
+	return r
+}
+
+// Execute godoc
+// @Summary Execute a GraphQL query
+// @Description Execute a query or a mutation over films, directors, genres and cast, the schema is in internal/graphql/resolvers/schema.graphql.
+// @Description Errors of fields are returned with status 200 in errors, the code of an error is in extensions.
+// @Tags GraphQL
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param form body endpoints.ExecuteRequest true "GraphQL Query"
+// @Success 200 {object} endpoints.ExecuteResponse "Data and errors of the query"
+// @Failure 400 {object} response.ErrorResponse "Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /graphql [post] .
+func decodeHTTPExecuteRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.ExecuteRequest
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	return reqForm, nil
+}
+
+// encodeHTTPExecuteResponse is a function to encode a response of GraphQL as is, without the common envelope of responses.
+func encodeHTTPExecuteResponse(ctx context.Context, w http.ResponseWriter, resp interface{}) error {
+	// Check if response is Failer interface and encode error
+	if f, ok := resp.(endpointKit.Failer); ok && f.Failed() != nil {
+		response.EncodeError(ctx, f.Failed(), w)
+
+		return nil
+	}
+
+	// Set Content-Type header
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	return jsoniter.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "errors"
+
+var (
+	ErrStatsFind = errors.New("failed to count statistics")
+)
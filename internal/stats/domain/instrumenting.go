@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/stats/domain/models"
+	"film-management/pkg/instrumenting"
+	"github.com/go-kit/kit/metrics"
+	"time"
+)
+
+type instrumentingMiddleware struct {
+	requestCount    metrics.Counter
+	requestDuration metrics.Histogram
+	next            Service
+}
+
+// NewInstrumentingMiddleware returns an instance of the instrumenting middleware.
+func NewInstrumentingMiddleware(requestCount metrics.Counter,
+	requestDuration metrics.Histogram) Middleware {
+	return func(next Service) Service {
+		return &instrumentingMiddleware{
+			requestCount,
+			requestDuration,
+			next,
+		}
+	}
+}
+
+func (i instrumentingMiddleware) ViewStats(ctx context.Context) (stats models.Stats, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewStats", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewStats(ctx)
+}
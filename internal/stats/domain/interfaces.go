@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/stats/domain/models"
+)
+
+// Service is an interface for domain service.
+type Service interface {
+	ViewStats(ctx context.Context) (models.Stats, error)
+}
+
+// Repository is a repository for statistics.
+type Repository interface {
+	FindStats(ctx context.Context, topLimit int) (models.Stats, error)
+}
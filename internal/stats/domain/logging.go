@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/stats/domain/models"
+	"go.uber.org/zap"
+)
+
+type loggingMiddleware struct {
+	next   Service
+	logger *zap.Logger
+}
+
+func NewLoggingMiddleware(logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return &loggingMiddleware{
+			next:   next,
+			logger: logger,
+		}
+	}
+}
+
+func (l loggingMiddleware) ViewStats(ctx context.Context) (stats models.Stats, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewStats")).
+			Debug("domain",
+				zap.Int64("films", stats.Films),
+				zap.Time("generatedAt", stats.GeneratedAt),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewStats(ctx)
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Stats is a model for statistics of the catalogue, films in trash are not counted.
+type Stats struct {
+	Films        int64
+	Directors    int64
+	Casts        int64
+	Users        int64
+	FilmsByGenre []GenreCount
+	FilmsByYear  []YearCount
+	TopDirectors []DirectorCount
+	TopCreators  []CreatorCount
+
+	// GeneratedAt is a time when the statistics were counted
+	GeneratedAt time.Time
+	// ExpiresAt is a time when the cached statistics are counted again, it is zero without cache
+	ExpiresAt time.Time
+}
+
+// GenreCount is a count of films of a genre.
+type GenreCount struct {
+	Name  string
+	Count int64
+}
+
+// YearCount is a count of films released in a year.
+type YearCount struct {
+	Year  int
+	Count int64
+}
+
+// DirectorCount is a count of films of a director.
+type DirectorCount struct {
+	ID    uint
+	Name  string
+	Count int64
+}
+
+// CreatorCount is a count of films added by a user.
+type CreatorCount struct {
+	UUID     uuid.UUID
+	Username string
+	Count    int64
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+type OptFunc func(*Opts)
+
+type Opts struct {
+	repository Repository
+	cacheTTL   time.Duration
+	topLimit   int
+}
+
+func defaultOpts(repository Repository) Opts {
+	return Opts{
+		repository: repository,
+		topLimit:   10,
+	}
+}
+
+// WithCacheTTL is an option to cache statistics for the duration, statistics are not cached if it is zero.
+func WithCacheTTL(ttl time.Duration) OptFunc {
+	return func(o *Opts) {
+		o.cacheTTL = ttl
+	}
+}
+
+// WithTopLimit is an option to set number of top directors and creators.
+func WithTopLimit(limit int) OptFunc {
+	return func(o *Opts) {
+		o.topLimit = limit
+	}
+}
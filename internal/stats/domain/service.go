@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/stats/domain/models"
+	"sync"
+	"time"
+)
+
+// service is a struct for domain service.
+type service struct {
+	Opts
+
+	// mu guards the cached statistics, it is held while statistics are counted so they are counted once at a time
+	mu     sync.Mutex
+	cached *models.Stats
+}
+
+// NewService is a constructor for domain service.
+func NewService(repository Repository, opts ...OptFunc) Service {
+	// Init default options
+	o := defaultOpts(repository)
+
+	// Apply options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &service{
+		Opts: o,
+	}
+}
+
+// ViewStats View statistics of the catalogue, they are cached for the cache TTL.
+func (s *service) ViewStats(ctx context.Context) (models.Stats, error) {
+	if s.cacheTTL <= 0 {
+		return s.findStats(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Return cached statistics until they expire
+	if s.cached != nil && time.Now().Before(s.cached.ExpiresAt) {
+		return *s.cached, nil
+	}
+
+	stats, err := s.findStats(ctx)
+	if err != nil {
+		return models.Stats{}, err
+	}
+
+	stats.ExpiresAt = stats.GeneratedAt.Add(s.cacheTTL)
+	s.cached = &stats
+
+	return stats, nil
+}
+
+// findStats is a method to count statistics in db.
+func (s *service) findStats(ctx context.Context) (models.Stats, error) {
+	stats, err := s.repository.FindStats(ctx, s.topLimit)
+	if err != nil {
+		return models.Stats{}, ErrStatsFind
+	}
+
+	stats.GeneratedAt = time.Now()
+
+	return stats, nil
+}
@@ -0,0 +1,104 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"film-management/internal/stats/domain"
+	"film-management/internal/stats/domain/models"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var errCount = errors.New("count failed")
+
+// countsRepository is a repository which counts reads of statistics, the number of films is the number of the read.
+type countsRepository struct {
+	reads    int
+	topLimit int
+	err      error
+}
+
+func (r *countsRepository) FindStats(_ context.Context, topLimit int) (models.Stats, error) {
+	r.reads++
+	r.topLimit = topLimit
+
+	if r.err != nil {
+		return models.Stats{}, r.err
+	}
+
+	return models.Stats{Films: int64(r.reads)}, nil
+}
+
+func TestViewStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	t.Run("cached until expired", func(t *testing.T) {
+		t.Parallel()
+
+		requireAssert := require.New(t)
+
+		repository := &countsRepository{}
+		service := domain.NewService(repository, domain.WithCacheTTL(50*time.Millisecond), domain.WithTopLimit(3))
+
+		first, err := service.ViewStats(ctx)
+		requireAssert.NoError(err)
+		requireAssert.Equal(int64(1), first.Films)
+		requireAssert.Equal(3, repository.topLimit)
+		requireAssert.Equal(first.GeneratedAt.Add(50*time.Millisecond), first.ExpiresAt)
+
+		// Statistics are read once until they expire
+		second, err := service.ViewStats(ctx)
+		requireAssert.NoError(err)
+		requireAssert.Equal(first, second)
+		requireAssert.Equal(1, repository.reads)
+
+		time.Sleep(60 * time.Millisecond)
+
+		third, err := service.ViewStats(ctx)
+		requireAssert.NoError(err)
+		requireAssert.Equal(int64(2), third.Films)
+		requireAssert.True(third.GeneratedAt.After(first.ExpiresAt))
+		requireAssert.Equal(2, repository.reads)
+	})
+
+	t.Run("not cached without TTL", func(t *testing.T) {
+		t.Parallel()
+
+		requireAssert := require.New(t)
+
+		repository := &countsRepository{}
+		service := domain.NewService(repository)
+
+		for i := 1; i <= 2; i++ {
+			stats, err := service.ViewStats(ctx)
+			requireAssert.NoError(err)
+			requireAssert.Equal(int64(i), stats.Films)
+			requireAssert.True(stats.ExpiresAt.IsZero())
+		}
+
+		requireAssert.Equal(2, repository.reads)
+		requireAssert.Equal(10, repository.topLimit)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		t.Parallel()
+
+		requireAssert := require.New(t)
+
+		repository := &countsRepository{err: errCount}
+		service := domain.NewService(repository, domain.WithCacheTTL(time.Hour))
+
+		_, err := service.ViewStats(ctx)
+		requireAssert.ErrorIs(err, domain.ErrStatsFind)
+
+		repository.err = nil
+
+		stats, err := service.ViewStats(ctx)
+		requireAssert.NoError(err)
+		requireAssert.Equal(int64(2), stats.Films)
+		requireAssert.Equal(2, repository.reads)
+	})
+}
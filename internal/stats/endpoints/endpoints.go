@@ -0,0 +1,25 @@
+package endpoints
+
+import (
+	"film-management/internal/stats/domain"
+	"github.com/go-kit/kit/endpoint"
+	"go.uber.org/zap"
+)
+
+// SetEndpoints collects all the endpoints that compose a stats service.
+type SetEndpoints struct {
+	ViewStatsEndpoint endpoint.Endpoint
+}
+
+// NewEndpoints returns a SetEndpoints that wraps the provided server, and wires in all the provided middlewares.
+func NewEndpoints(s domain.Service, logger *zap.Logger) SetEndpoints {
+	var viewStatsEndpoint endpoint.Endpoint
+	{
+		viewStatsEndpoint = MakeViewStatsEndpoint(s)
+		viewStatsEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewStats")))(viewStatsEndpoint)
+	}
+
+	return SetEndpoints{
+		ViewStatsEndpoint: viewStatsEndpoint,
+	}
+}
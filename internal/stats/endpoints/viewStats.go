@@ -0,0 +1,130 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/stats/domain"
+	"film-management/internal/stats/domain/models"
+	"fmt"
+	"github.com/go-kit/kit/endpoint"
+	"math"
+	"net/http"
+	"time"
+)
+
+// MakeViewStatsEndpoint is an endpoint for ViewStats.
+func MakeViewStatsEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (response interface{}, err error) {
+		stats, errViewStats := s.ViewStats(ctx)
+		if errViewStats != nil {
+			return ViewStatsResponse{Err: errViewStats}, nil
+		}
+
+		return ViewStatsResponse{
+			Item:      domainStatsToItemStats(stats),
+			expiresAt: stats.ExpiresAt,
+		}, nil
+	}
+}
+
+// ViewStatsRequest is a request for ViewStats.
+type ViewStatsRequest struct{}
+
+// ViewStatsResponse is a response for ViewStats.
+type ViewStatsResponse struct {
+	Item      ItemStats `json:"item"`
+	Err       error     `json:"err,omitempty" swaggerignore:"true"`
+	expiresAt time.Time
+}
+
+// Failed implements response.Failed.
+func (r ViewStatsResponse) Failed() error { return r.Err }
+
+// Headers implements httpKitTransport.Headerer, clients may cache statistics until they are counted again.
+func (r ViewStatsResponse) Headers() http.Header {
+	if r.expiresAt.IsZero() {
+		return http.Header{"Cache-Control": []string{"no-cache"}}
+	}
+
+	maxAge := int(math.Max(0, math.Ceil(time.Until(r.expiresAt).Seconds())))
+
+	return http.Header{"Cache-Control": []string{fmt.Sprintf("private, max-age=%d", maxAge)}}
+}
+
+// ItemStats is a response item for statistics of the catalogue.
+type ItemStats struct {
+	Totals       ItemStatsTotals     `json:"totals"`
+	FilmsByGenre []ItemGenreCount    `json:"films_by_genre"`
+	FilmsByYear  []ItemYearCount     `json:"films_by_year"`
+	TopDirectors []ItemDirectorCount `json:"top_directors"`
+	TopCreators  []ItemCreatorCount  `json:"top_creators"`
+	GeneratedAt  string              `json:"generated_at" example:"2021-01-01 00:00:00"`
+}
+
+// ItemStatsTotals is a response item for totals of the catalogue.
+type ItemStatsTotals struct {
+	Films     int64 `json:"films" example:"120"`
+	Directors int64 `json:"directors" example:"45"`
+	Casts     int64 `json:"casts" example:"380"`
+	Users     int64 `json:"users" example:"25"`
+}
+
+// ItemGenreCount is a response item for count of films of a genre.
+type ItemGenreCount struct {
+	Name  string `json:"name" example:"drama"`
+	Count int64  `json:"count" example:"30"`
+}
+
+// ItemYearCount is a response item for count of films released in a year.
+type ItemYearCount struct {
+	Year  int   `json:"year" example:"2010"`
+	Count int64 `json:"count" example:"4"`
+}
+
+// ItemDirectorCount is a response item for count of films of a director.
+type ItemDirectorCount struct {
+	ID    uint   `json:"id" example:"1"`
+	Name  string `json:"name" example:"Christopher Nolan"`
+	Count int64  `json:"count" example:"8"`
+}
+
+// ItemCreatorCount is a response item for count of films added by a user.
+type ItemCreatorCount struct {
+	UUID     string `json:"uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Username string `json:"username" example:"user1"`
+	Count    int64  `json:"count" example:"40"`
+}
+
+// domainStatsToItemStats is a function to convert domain statistics to item of statistics.
+func domainStatsToItemStats(stats models.Stats) ItemStats {
+	item := ItemStats{
+		Totals: ItemStatsTotals{
+			Films:     stats.Films,
+			Directors: stats.Directors,
+			Casts:     stats.Casts,
+			Users:     stats.Users,
+		},
+		FilmsByGenre: make([]ItemGenreCount, 0, len(stats.FilmsByGenre)),
+		FilmsByYear:  make([]ItemYearCount, 0, len(stats.FilmsByYear)),
+		TopDirectors: make([]ItemDirectorCount, 0, len(stats.TopDirectors)),
+		TopCreators:  make([]ItemCreatorCount, 0, len(stats.TopCreators)),
+		GeneratedAt:  stats.GeneratedAt.Format(time.DateTime),
+	}
+
+	for _, genre := range stats.FilmsByGenre {
+		item.FilmsByGenre = append(item.FilmsByGenre, ItemGenreCount{Name: genre.Name, Count: genre.Count})
+	}
+
+	for _, year := range stats.FilmsByYear {
+		item.FilmsByYear = append(item.FilmsByYear, ItemYearCount{Year: year.Year, Count: year.Count})
+	}
+
+	for _, director := range stats.TopDirectors {
+		item.TopDirectors = append(item.TopDirectors, ItemDirectorCount{ID: director.ID, Name: director.Name, Count: director.Count})
+	}
+
+	for _, creator := range stats.TopCreators {
+		item.TopCreators = append(item.TopCreators, ItemCreatorCount{UUID: creator.UUID.String(), Username: creator.Username, Count: creator.Count})
+	}
+
+	return item
+}
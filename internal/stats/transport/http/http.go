@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"film-management/config"
+	httpCommon "film-management/internal/common/transport/http"
+	"film-management/internal/stats/endpoints"
+	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/transport/http/middlewares/auth"
+	"film-management/pkg/transport/http/middlewares/cors"
+	"film-management/pkg/transport/http/middlewares/recovery"
+	"film-management/pkg/transport/http/response"
+	httpKitTransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+const (
+	APIPath = httpCommon.APIPath + "stats"
+)
+
+// NewHTTPHandlers is a function that returns a http.Handler that makes a set of endpoints available on predefined paths.
+func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service, cfg *config.Config, logger *zap.Logger) http.Handler {
+	options := []httpKitTransport.ServerOption{
+		httpKitTransport.ServerErrorHandler(httpTransport.NewLogErrorHandler(logger)),
+		httpKitTransport.ServerErrorEncoder(response.EncodeError),
+	}
+
+	// Handlers
+	// View statistics
+	viewStatsHandler := httpKitTransport.NewServer(
+		endpoints.ViewStatsEndpoint,
+		decodeHTTPViewStatsRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
+	r := mux.NewRouter()
+
+	// CORS
+	r.Use(mux.CORSMethodMiddleware(r))
+	r.Use(cors.Middleware(cfg.HTTP.CorsAllowedOrigins, logger))
+
+	// Recovery
+	r.Use(recovery.Middleware(logger))
+
+	// AUTH
+	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService, logger))
+
+	// Routes
+
+	// Statistics
+	//
+	// View statistics
+	r.Handle(APIPath, viewStatsHandler).Methods(http.MethodGet)
+
+	// Set custom error handlers
+	response.SetErrorHandlers(r)
+
+	return r
+}
+
+// ViewStats godoc
+// @Summary View statistics
+// @Description View totals of films, directors, cast members and users, films per genre and release year, top directors and creators.
+// @Description Statistics ignore films in trash and are cached, Cache-Control tells how long they stay fresh.
+// @Tags Stats
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewStatsResponse} "Success"
+// @Header 200 {string} Cache-Control "private, max-age of cached statistics"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /stats [get] .
+func decodeHTTPViewStatsRequest(_ context.Context, _ *http.Request) (request interface{}, err error) {
+	return endpoints.ViewStatsRequest{}, nil
+}
@@ -11,4 +11,14 @@ var (
 	ErrUserCheckExistence       = errors.New("failed to check user existence")
 	ErrGeneratePasswordHash     = errors.New("failed to generate password hash")
 	ErrGenerateAuthToken        = errors.New("failed to generate auth token")
+	ErrGenerateRefreshToken     = errors.New("failed to generate refresh token")
+	ErrRefreshTokenCreate       = errors.New("failed to create refresh token")
+	ErrRefreshTokenFind         = errors.New("failed to find refresh token")
+	ErrRefreshTokenNotFound     = errors.New("refresh token not found")
+	ErrRefreshTokenInvalid      = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenReused       = errors.New("refresh token has already been used, all sessions of this login are revoked")
+	ErrRefreshTokenRevoke       = errors.New("failed to revoke refresh token")
+	ErrUserFindByUUID           = errors.New("failed to find user by uuid")
+	ErrParseAuthToken           = errors.New("failed to parse auth token")
+	ErrRevokeAuthToken          = errors.New("failed to revoke auth token")
 )
@@ -36,7 +36,7 @@ func (i instrumentingMiddleware) Register(ctx context.Context, model *models.Use
 	return i.next.Register(ctx, model)
 }
 
-func (i instrumentingMiddleware) Login(ctx context.Context, username string, password string) (authToken string, expirationTime time.Time, err error) {
+func (i instrumentingMiddleware) Login(ctx context.Context, username string, password string) (tokens models.AuthTokens, err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "Login", "error", instrumenting.PrintErr(err)}
 		i.requestCount.With(lvs...).Add(1)
@@ -45,3 +45,23 @@ func (i instrumentingMiddleware) Login(ctx context.Context, username string, pas
 
 	return i.next.Login(ctx, username, password)
 }
+
+func (i instrumentingMiddleware) Refresh(ctx context.Context, refreshToken string) (tokens models.AuthTokens, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "Refresh", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.Refresh(ctx, refreshToken)
+}
+
+func (i instrumentingMiddleware) Logout(ctx context.Context, accessToken string, refreshToken string) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "Logout", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.Logout(ctx, accessToken, refreshToken)
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"film-management/internal/user/domain/models"
+	"film-management/pkg/auth"
 	"github.com/google/uuid"
 	"time"
 )
@@ -12,7 +13,9 @@ import (
 //go:generate mockgen -source=interfaces.go -destination=mocks/mock_service.go -package=mocks
 type Service interface {
 	Register(ctx context.Context, model *models.User) error
-	Login(ctx context.Context, username string, password string) (string, time.Time, error)
+	Login(ctx context.Context, username string, password string) (models.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (models.AuthTokens, error)
+	Logout(ctx context.Context, accessToken string, refreshToken string) error
 }
 
 // UserRepository is a repository for user.
@@ -21,6 +24,16 @@ type UserRepository interface {
 	FindOneUserByUUID(ctx context.Context, uuid uuid.UUID) (models.User, error)
 	FindOneUserByUsername(ctx context.Context, username string) (models.User, error)
 	UserExistsWithUsername(ctx context.Context, username string) error
+	TokenRepository
+}
+
+// TokenRepository is a repository for refresh and revoked tokens.
+type TokenRepository interface {
+	CreateRefreshToken(ctx context.Context, model *models.RefreshToken) error
+	FindOneRefreshTokenByHash(ctx context.Context, tokenHash string) (models.RefreshToken, error)
+	UseRefreshToken(ctx context.Context, id uint) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeAccessToken(ctx context.Context, model *models.RevokedToken) error
 }
 
 type PasswordService interface {
@@ -29,5 +42,8 @@ type PasswordService interface {
 }
 
 type AuthService interface {
-	GenerateAuthToken(userID string) (string, time.Time, error)
+	GenerateAuthToken(userID string, role string) (string, time.Time, error)
+	GenerateRefreshToken() (string, string, time.Time, error)
+	HashRefreshToken(token string) string
+	ParseAuthToken(accessToken string) (*auth.JwtClaims, error)
 }
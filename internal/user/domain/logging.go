@@ -4,7 +4,6 @@ import (
 	"context"
 	"film-management/internal/user/domain/models"
 	"go.uber.org/zap"
-	"time"
 )
 
 type loggingMiddleware struct {
@@ -33,16 +32,42 @@ func (l loggingMiddleware) Register(ctx context.Context, model *models.User) (er
 	return l.next.Register(ctx, model)
 }
 
-func (l loggingMiddleware) Login(ctx context.Context, username string, password string) (authToken string, expirationTime time.Time, err error) {
+func (l loggingMiddleware) Login(ctx context.Context, username string, password string) (tokens models.AuthTokens, err error) {
 	defer func() {
 		l.logger.With(zap.String("method", "Login")).
 			Debug("domain",
 				zap.String("username", username),
 				zap.String("password", password),
-				zap.String("authToken", authToken),
-				zap.Time("expirationTime", expirationTime),
+				zap.String("authToken", tokens.AccessToken),
+				zap.Time("expirationTime", tokens.AccessTokenExpiresAt),
+				zap.Time("refreshExpirationTime", tokens.RefreshTokenExpiresAt),
 				zap.Error(err))
 	}()
 
 	return l.next.Login(ctx, username, password)
 }
+
+func (l loggingMiddleware) Refresh(ctx context.Context, refreshToken string) (tokens models.AuthTokens, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "Refresh")).
+			Debug("domain",
+				zap.String("authToken", tokens.AccessToken),
+				zap.Time("expirationTime", tokens.AccessTokenExpiresAt),
+				zap.Time("refreshExpirationTime", tokens.RefreshTokenExpiresAt),
+				zap.Error(err))
+	}()
+
+	return l.next.Refresh(ctx, refreshToken)
+}
+
+func (l loggingMiddleware) Logout(ctx context.Context, accessToken string, refreshToken string) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "Logout")).
+			Debug("domain",
+				zap.String("authToken", accessToken),
+				zap.Bool("withRefreshToken", refreshToken != ""),
+				zap.Error(err))
+	}()
+
+	return l.next.Logout(ctx, accessToken, refreshToken)
+}
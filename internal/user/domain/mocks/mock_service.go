@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	models "film-management/internal/user/domain/models"
+	auth "film-management/pkg/auth"
 	reflect "reflect"
 	time "time"
 
@@ -38,13 +39,12 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 }
 
 // Login mocks base method.
-func (m *MockService) Login(ctx context.Context, username, password string) (string, time.Time, error) {
+func (m *MockService) Login(ctx context.Context, username, password string) (models.AuthTokens, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Login", ctx, username, password)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(time.Time)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(models.AuthTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
@@ -53,6 +53,35 @@ func (mr *MockServiceMockRecorder) Login(ctx, username, password interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockService)(nil).Login), ctx, username, password)
 }
 
+// Logout mocks base method.
+func (m *MockService) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockServiceMockRecorder) Logout(ctx, accessToken, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockService)(nil).Logout), ctx, accessToken, refreshToken)
+}
+
+// Refresh mocks base method.
+func (m *MockService) Refresh(ctx context.Context, refreshToken string) (models.AuthTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(models.AuthTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockServiceMockRecorder) Refresh(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockService)(nil).Refresh), ctx, refreshToken)
+}
+
 // Register mocks base method.
 func (m *MockService) Register(ctx context.Context, model *models.User) error {
 	m.ctrl.T.Helper()
@@ -90,6 +119,20 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateRefreshToken mocks base method.
+func (m *MockUserRepository) CreateRefreshToken(ctx context.Context, model *models.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockUserRepositoryMockRecorder) CreateRefreshToken(ctx, model interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockUserRepository)(nil).CreateRefreshToken), ctx, model)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserRepository) CreateUser(ctx context.Context, user *models.User) error {
 	m.ctrl.T.Helper()
@@ -104,6 +147,21 @@ func (mr *MockUserRepositoryMockRecorder) CreateUser(ctx, user interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), ctx, user)
 }
 
+// FindOneRefreshTokenByHash mocks base method.
+func (m *MockUserRepository) FindOneRefreshTokenByHash(ctx context.Context, tokenHash string) (models.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneRefreshTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(models.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneRefreshTokenByHash indicates an expected call of FindOneRefreshTokenByHash.
+func (mr *MockUserRepositoryMockRecorder) FindOneRefreshTokenByHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneRefreshTokenByHash", reflect.TypeOf((*MockUserRepository)(nil).FindOneRefreshTokenByHash), ctx, tokenHash)
+}
+
 // FindOneUserByUUID mocks base method.
 func (m *MockUserRepository) FindOneUserByUUID(ctx context.Context, uuid uuid.UUID) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +192,48 @@ func (mr *MockUserRepositoryMockRecorder) FindOneUserByUsername(ctx, username in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneUserByUsername", reflect.TypeOf((*MockUserRepository)(nil).FindOneUserByUsername), ctx, username)
 }
 
+// RevokeAccessToken mocks base method.
+func (m *MockUserRepository) RevokeAccessToken(ctx context.Context, model *models.RevokedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockUserRepositoryMockRecorder) RevokeAccessToken(ctx, model interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockUserRepository)(nil).RevokeAccessToken), ctx, model)
+}
+
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockUserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockUserRepositoryMockRecorder) RevokeRefreshTokenFamily(ctx, familyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockUserRepository)(nil).RevokeRefreshTokenFamily), ctx, familyID)
+}
+
+// UseRefreshToken mocks base method.
+func (m *MockUserRepository) UseRefreshToken(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken.
+func (mr *MockUserRepositoryMockRecorder) UseRefreshToken(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockUserRepository)(nil).UseRefreshToken), ctx, id)
+}
+
 // UserExistsWithUsername mocks base method.
 func (m *MockUserRepository) UserExistsWithUsername(ctx context.Context, username string) error {
 	m.ctrl.T.Helper()
@@ -148,6 +248,100 @@ func (mr *MockUserRepositoryMockRecorder) UserExistsWithUsername(ctx, username i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserExistsWithUsername", reflect.TypeOf((*MockUserRepository)(nil).UserExistsWithUsername), ctx, username)
 }
 
+// MockTokenRepository is a mock of TokenRepository interface.
+type MockTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenRepositoryMockRecorder
+}
+
+// MockTokenRepositoryMockRecorder is the mock recorder for MockTokenRepository.
+type MockTokenRepositoryMockRecorder struct {
+	mock *MockTokenRepository
+}
+
+// NewMockTokenRepository creates a new mock instance.
+func NewMockTokenRepository(ctrl *gomock.Controller) *MockTokenRepository {
+	mock := &MockTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenRepository) EXPECT() *MockTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockTokenRepository) CreateRefreshToken(ctx context.Context, model *models.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockTokenRepositoryMockRecorder) CreateRefreshToken(ctx, model interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockTokenRepository)(nil).CreateRefreshToken), ctx, model)
+}
+
+// FindOneRefreshTokenByHash mocks base method.
+func (m *MockTokenRepository) FindOneRefreshTokenByHash(ctx context.Context, tokenHash string) (models.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneRefreshTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(models.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneRefreshTokenByHash indicates an expected call of FindOneRefreshTokenByHash.
+func (mr *MockTokenRepositoryMockRecorder) FindOneRefreshTokenByHash(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneRefreshTokenByHash", reflect.TypeOf((*MockTokenRepository)(nil).FindOneRefreshTokenByHash), ctx, tokenHash)
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MockTokenRepository) RevokeAccessToken(ctx context.Context, model *models.RevokedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MockTokenRepositoryMockRecorder) RevokeAccessToken(ctx, model interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MockTokenRepository)(nil).RevokeAccessToken), ctx, model)
+}
+
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockTokenRepositoryMockRecorder) RevokeRefreshTokenFamily(ctx, familyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockTokenRepository)(nil).RevokeRefreshTokenFamily), ctx, familyID)
+}
+
+// UseRefreshToken mocks base method.
+func (m *MockTokenRepository) UseRefreshToken(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken.
+func (mr *MockTokenRepositoryMockRecorder) UseRefreshToken(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockTokenRepository)(nil).UseRefreshToken), ctx, id)
+}
+
 // MockPasswordService is a mock of PasswordService interface.
 type MockPasswordService struct {
 	ctrl     *gomock.Controller
@@ -224,9 +418,9 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 }
 
 // GenerateAuthToken mocks base method.
-func (m *MockAuthService) GenerateAuthToken(userID string) (string, time.Time, error) {
+func (m *MockAuthService) GenerateAuthToken(userID, role string) (string, time.Time, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAuthToken", userID)
+	ret := m.ctrl.Call(m, "GenerateAuthToken", userID, role)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(time.Time)
 	ret2, _ := ret[2].(error)
@@ -234,7 +428,53 @@ func (m *MockAuthService) GenerateAuthToken(userID string) (string, time.Time, e
 }
 
 // GenerateAuthToken indicates an expected call of GenerateAuthToken.
-func (mr *MockAuthServiceMockRecorder) GenerateAuthToken(userID interface{}) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) GenerateAuthToken(userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAuthToken", reflect.TypeOf((*MockAuthService)(nil).GenerateAuthToken), userID, role)
+}
+
+// GenerateRefreshToken mocks base method.
+func (m *MockAuthService) GenerateRefreshToken() (string, string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateRefreshToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(time.Time)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GenerateRefreshToken indicates an expected call of GenerateRefreshToken.
+func (mr *MockAuthServiceMockRecorder) GenerateRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateRefreshToken", reflect.TypeOf((*MockAuthService)(nil).GenerateRefreshToken))
+}
+
+// HashRefreshToken mocks base method.
+func (m *MockAuthService) HashRefreshToken(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashRefreshToken", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashRefreshToken indicates an expected call of HashRefreshToken.
+func (mr *MockAuthServiceMockRecorder) HashRefreshToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashRefreshToken", reflect.TypeOf((*MockAuthService)(nil).HashRefreshToken), token)
+}
+
+// ParseAuthToken mocks base method.
+func (m *MockAuthService) ParseAuthToken(accessToken string) (*auth.JwtClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseAuthToken", accessToken)
+	ret0, _ := ret[0].(*auth.JwtClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseAuthToken indicates an expected call of ParseAuthToken.
+func (mr *MockAuthServiceMockRecorder) ParseAuthToken(accessToken interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAuthToken", reflect.TypeOf((*MockAuthService)(nil).GenerateAuthToken), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseAuthToken", reflect.TypeOf((*MockAuthService)(nil).ParseAuthToken), accessToken)
 }
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// RefreshToken is a model for refresh token. Only a hash of the token is stored.
+// All tokens rotated from the same login share a family.
+type RefreshToken struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uuid.UUID `json:"userID" gorm:"type:uuid;not null;index"`
+	FamilyID  uuid.UUID `json:"familyID" gorm:"type:uuid;not null;index"`
+	TokenHash string    `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt int64     `json:"expires_at" gorm:"not null"`
+	RevokedAt *int64    `json:"revoked_at"`
+	CreatedAt int64     `json:"created_at" gorm:"autoCreateTime"`
+
+	User User `json:"-" gorm:"foreignKey:UserID;references:UUID;constraint:OnDelete:CASCADE"`
+}
+
+// IsExpired checks if refresh token is expired.
+func (t *RefreshToken) IsExpired() bool {
+	return time.Now().Unix() > t.ExpiresAt
+}
+
+// RevokedToken is a model for revoked access token, identified by jti claim.
+type RevokedToken struct {
+	TokenID   string `json:"tokenID" gorm:"size:36;primaryKey"`
+	ExpiresAt int64  `json:"expires_at" gorm:"not null;index"`
+	CreatedAt int64  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// AuthTokens is a pair of access and refresh tokens.
+type AuthTokens struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
@@ -1,11 +1,14 @@
 package domain
 
+import "film-management/pkg/policy"
+
 type OptFunc func(*Opts)
 
 type Opts struct {
 	userRepository  UserRepository
 	authService     AuthService
 	passwordService PasswordService
+	defaultRole     policy.Role
 }
 
 func defaultOpts(userRepository UserRepository, authService AuthService, passwordService PasswordService) Opts {
@@ -13,5 +16,13 @@ func defaultOpts(userRepository UserRepository, authService AuthService, passwor
 		userRepository:  userRepository,
 		authService:     authService,
 		passwordService: passwordService,
+		defaultRole:     policy.RoleEditor,
+	}
+}
+
+// WithDefaultRole sets a role for new registered users.
+func WithDefaultRole(role policy.Role) OptFunc {
+	return func(o *Opts) {
+		o.defaultRole = role
 	}
 }
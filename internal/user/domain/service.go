@@ -4,8 +4,8 @@ import (
 	"context"
 	"film-management/internal/user/domain/models"
 	customError "film-management/pkg/errors"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
-	"time"
 )
 
 // service is a struct for domain service.
@@ -44,6 +44,9 @@ func (s service) Register(ctx context.Context, model *models.User) error {
 	// Set hashed password
 	model.Password = hashPassword
 
+	// Set default role
+	model.Role = s.defaultRole
+
 	// Create user in db
 	if err := s.userRepository.CreateUser(ctx, model); err != nil {
 		return ErrUserCreate
@@ -67,27 +70,167 @@ func (s service) checkDuplicateUser(ctx context.Context, username string) error
 }
 
 // Login is a method to login user.
-func (s service) Login(ctx context.Context, username string, password string) (string, time.Time, error) {
+func (s service) Login(ctx context.Context, username string, password string) (models.AuthTokens, error) {
 	// Find user by username in db
 	user, err := s.userRepository.FindOneUserByUsername(ctx, username)
 	if err != nil {
 		switch {
 		case errors.Is(err, ErrUserNotFound):
-			return "", time.Time{}, customError.ValidationError{Field: "username", Err: ErrIncorrectLoginOrPassword}
+			return models.AuthTokens{}, customError.ValidationError{Field: "username", Err: ErrIncorrectLoginOrPassword}
 		default:
-			return "", time.Time{}, ErrUserFindByUsername
+			return models.AuthTokens{}, ErrUserFindByUsername
 		}
 	}
 
 	// Compare password hash
 	if err := s.passwordService.ComparePasswordHash(password, user.Password); err != nil {
-		return "", time.Time{}, customError.ValidationError{Field: "username", Err: ErrIncorrectLoginOrPassword}
+		return models.AuthTokens{}, customError.ValidationError{Field: "username", Err: ErrIncorrectLoginOrPassword}
+	}
+
+	// Generate auth tokens, every login starts a new refresh token family
+	return s.generateAuthTokens(ctx, user, uuid.New())
+}
+
+// Refresh is a method to get new auth tokens by refresh token. The refresh token is rotated on every use.
+func (s service) Refresh(ctx context.Context, refreshToken string) (models.AuthTokens, error) {
+	// Find refresh token by hash in db
+	tokenFromDB, err := s.getRefreshTokenFromDB(ctx, refreshToken)
+	if err != nil {
+		return models.AuthTokens{}, err
+	}
+
+	// A reused refresh token could be stolen, so revoke all tokens of the family
+	if tokenFromDB.RevokedAt != nil {
+		return models.AuthTokens{}, s.revokeReusedRefreshTokenFamily(ctx, tokenFromDB.FamilyID)
+	}
+
+	// Check expiration
+	if tokenFromDB.IsExpired() {
+		return models.AuthTokens{}, customError.AuthError{Err: ErrRefreshTokenInvalid}
+	}
+
+	// Mark refresh token as used, it fails if the token has been used concurrently
+	if errUse := s.userRepository.UseRefreshToken(ctx, tokenFromDB.ID); errUse != nil {
+		switch {
+		case errors.Is(errUse, ErrRefreshTokenReused):
+			return models.AuthTokens{}, s.revokeReusedRefreshTokenFamily(ctx, tokenFromDB.FamilyID)
+		default:
+			return models.AuthTokens{}, ErrRefreshTokenRevoke
+		}
+	}
+
+	// Find user by uuid in db to get the actual role
+	user, err := s.userRepository.FindOneUserByUUID(ctx, tokenFromDB.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			return models.AuthTokens{}, customError.AuthError{Err: ErrRefreshTokenInvalid}
+		default:
+			return models.AuthTokens{}, ErrUserFindByUUID
+		}
+	}
+
+	// Generate auth tokens in the same refresh token family
+	return s.generateAuthTokens(ctx, user, tokenFromDB.FamilyID)
+}
+
+// Logout is a method to revoke access token and refresh token family of the login.
+func (s service) Logout(ctx context.Context, accessToken string, refreshToken string) error {
+	// Parse access token
+	claims, err := s.authService.ParseAuthToken(accessToken)
+	if err != nil {
+		return customError.AuthError{Err: ErrParseAuthToken}
+	}
+
+	// Add access token to denylist until it expires
+	if claims.ID != "" && claims.ExpiresAt != nil {
+		model := &models.RevokedToken{
+			TokenID:   claims.ID,
+			ExpiresAt: claims.ExpiresAt.Unix(),
+		}
+
+		if errRevoke := s.userRepository.RevokeAccessToken(ctx, model); errRevoke != nil {
+			return ErrRevokeAuthToken
+		}
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	// Find refresh token by hash in db
+	tokenFromDB, err := s.getRefreshTokenFromDB(ctx, refreshToken)
+	if err != nil {
+		return err
 	}
 
-	// Generate auth token
-	if authToken, expirationTime, errAuthToken := s.authService.GenerateAuthToken(user.UUID.String()); errAuthToken != nil {
-		return "", time.Time{}, ErrGenerateAuthToken
-	} else {
-		return authToken, expirationTime, nil
+	// Check that refresh token belongs to the user
+	if tokenFromDB.UserID.String() != claims.UUID {
+		return customError.AuthError{Err: ErrRefreshTokenInvalid}
 	}
+
+	// Revoke all refresh tokens of the login
+	if errRevoke := s.userRepository.RevokeRefreshTokenFamily(ctx, tokenFromDB.FamilyID); errRevoke != nil {
+		return ErrRefreshTokenRevoke
+	}
+
+	return nil
+}
+
+// generateAuthTokens Generate access token and refresh token of the family and save refresh token in db.
+func (s service) generateAuthTokens(ctx context.Context, user models.User, familyID uuid.UUID) (models.AuthTokens, error) {
+	// Generate access token
+	accessToken, accessTokenExpiresAt, err := s.authService.GenerateAuthToken(user.UUID.String(), string(user.Role))
+	if err != nil {
+		return models.AuthTokens{}, ErrGenerateAuthToken
+	}
+
+	// Generate refresh token
+	refreshToken, refreshTokenHash, refreshTokenExpiresAt, err := s.authService.GenerateRefreshToken()
+	if err != nil {
+		return models.AuthTokens{}, ErrGenerateRefreshToken
+	}
+
+	// Create refresh token in db
+	model := &models.RefreshToken{
+		UserID:    user.UUID,
+		FamilyID:  familyID,
+		TokenHash: refreshTokenHash,
+		ExpiresAt: refreshTokenExpiresAt.Unix(),
+	}
+
+	if errCreate := s.userRepository.CreateRefreshToken(ctx, model); errCreate != nil {
+		return models.AuthTokens{}, ErrRefreshTokenCreate
+	}
+
+	return models.AuthTokens{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+	}, nil
+}
+
+// getRefreshTokenFromDB Get refresh token from db by hash of the token.
+func (s service) getRefreshTokenFromDB(ctx context.Context, refreshToken string) (models.RefreshToken, error) {
+	tokenFromDB, err := s.userRepository.FindOneRefreshTokenByHash(ctx, s.authService.HashRefreshToken(refreshToken))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrRefreshTokenNotFound):
+			return models.RefreshToken{}, customError.AuthError{Err: ErrRefreshTokenInvalid}
+		default:
+			return models.RefreshToken{}, ErrRefreshTokenFind
+		}
+	}
+
+	return tokenFromDB, nil
+}
+
+// revokeReusedRefreshTokenFamily Revoke all refresh tokens of the family after reuse of a refresh token.
+func (s service) revokeReusedRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	if err := s.userRepository.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return ErrRefreshTokenRevoke
+	}
+
+	return customError.AuthError{Err: ErrRefreshTokenReused}
 }
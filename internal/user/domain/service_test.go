@@ -5,9 +5,13 @@ import (
 	"film-management/internal/user/domain"
 	"film-management/internal/user/domain/mocks"
 	"film-management/internal/user/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/policy"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 type mockUserRepositoryBehavior func(r *mocks.MockUserRepository)
@@ -54,6 +58,7 @@ func TestService_Register(t *testing.T) {
 			mockAuthServiceBehavior: func(r *mocks.MockAuthService) {},
 			assert: func(in *in, out *out) {
 				requireAssert.NoError(out.err)
+				requireAssert.Equal(policy.RoleEditor, in.user.Role)
 			},
 		},
 		{
@@ -156,3 +161,170 @@ func TestService_Register(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Refresh(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	requireAssert := require.New(t)
+
+	var (
+		userID   = uuid.New()
+		familyID = uuid.New()
+		usedAt   = time.Now().Unix()
+	)
+
+	type in struct {
+		refreshToken string
+	}
+
+	type out struct {
+		tokens models.AuthTokens
+		err    error
+	}
+
+	tests := []struct {
+		name                       string
+		in                         in
+		mockUserRepositoryBehavior mockUserRepositoryBehavior
+		mockAuthServiceBehavior    mockAuthServiceBehavior
+		assert                     func(*in, *out)
+	}{
+		{
+			name: "success",
+			in: in{
+				refreshToken: "refresh-token",
+			},
+			mockUserRepositoryBehavior: func(r *mocks.MockUserRepository) {
+				r.EXPECT().FindOneRefreshTokenByHash(gomock.Any(), "hash").Return(models.RefreshToken{
+					ID:        1,
+					UserID:    userID,
+					FamilyID:  familyID,
+					ExpiresAt: time.Now().Add(time.Hour).Unix(),
+				}, nil)
+				r.EXPECT().UseRefreshToken(gomock.Any(), uint(1)).Return(nil)
+				r.EXPECT().FindOneUserByUUID(gomock.Any(), userID).Return(models.User{UUID: userID, Role: policy.RoleEditor}, nil)
+				r.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *models.RefreshToken) error {
+					requireAssert.Equal(familyID, model.FamilyID)
+					requireAssert.Equal("new-hash", model.TokenHash)
+
+					return nil
+				})
+			},
+			mockAuthServiceBehavior: func(r *mocks.MockAuthService) {
+				r.EXPECT().HashRefreshToken("refresh-token").Return("hash")
+				r.EXPECT().GenerateAuthToken(userID.String(), string(policy.RoleEditor)).Return("access-token", time.Now(), nil)
+				r.EXPECT().GenerateRefreshToken().Return("new-refresh-token", "new-hash", time.Now(), nil)
+			},
+			assert: func(in *in, out *out) {
+				requireAssert.NoError(out.err)
+				requireAssert.Equal("access-token", out.tokens.AccessToken)
+				requireAssert.Equal("new-refresh-token", out.tokens.RefreshToken)
+			},
+		},
+		{
+			name: "refresh token not found",
+			in: in{
+				refreshToken: "refresh-token",
+			},
+			mockUserRepositoryBehavior: func(r *mocks.MockUserRepository) {
+				r.EXPECT().FindOneRefreshTokenByHash(gomock.Any(), "hash").Return(models.RefreshToken{}, domain.ErrRefreshTokenNotFound)
+			},
+			mockAuthServiceBehavior: func(r *mocks.MockAuthService) {
+				r.EXPECT().HashRefreshToken("refresh-token").Return("hash")
+			},
+			assert: func(in *in, out *out) {
+				requireAssert.Equal(customError.AuthError{Err: domain.ErrRefreshTokenInvalid}, out.err)
+			},
+		},
+		{
+			name: "refresh token expired",
+			in: in{
+				refreshToken: "refresh-token",
+			},
+			mockUserRepositoryBehavior: func(r *mocks.MockUserRepository) {
+				r.EXPECT().FindOneRefreshTokenByHash(gomock.Any(), "hash").Return(models.RefreshToken{
+					ID:        1,
+					UserID:    userID,
+					FamilyID:  familyID,
+					ExpiresAt: time.Now().Add(-time.Hour).Unix(),
+				}, nil)
+			},
+			mockAuthServiceBehavior: func(r *mocks.MockAuthService) {
+				r.EXPECT().HashRefreshToken("refresh-token").Return("hash")
+			},
+			assert: func(in *in, out *out) {
+				requireAssert.Equal(customError.AuthError{Err: domain.ErrRefreshTokenInvalid}, out.err)
+			},
+		},
+		{
+			name: "reused refresh token revokes family",
+			in: in{
+				refreshToken: "refresh-token",
+			},
+			mockUserRepositoryBehavior: func(r *mocks.MockUserRepository) {
+				r.EXPECT().FindOneRefreshTokenByHash(gomock.Any(), "hash").Return(models.RefreshToken{
+					ID:        1,
+					UserID:    userID,
+					FamilyID:  familyID,
+					ExpiresAt: time.Now().Add(time.Hour).Unix(),
+					RevokedAt: &usedAt,
+				}, nil)
+				r.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), familyID).Return(nil)
+			},
+			mockAuthServiceBehavior: func(r *mocks.MockAuthService) {
+				r.EXPECT().HashRefreshToken("refresh-token").Return("hash")
+			},
+			assert: func(in *in, out *out) {
+				requireAssert.Equal(customError.AuthError{Err: domain.ErrRefreshTokenReused}, out.err)
+			},
+		},
+		{
+			name: "concurrently used refresh token revokes family",
+			in: in{
+				refreshToken: "refresh-token",
+			},
+			mockUserRepositoryBehavior: func(r *mocks.MockUserRepository) {
+				r.EXPECT().FindOneRefreshTokenByHash(gomock.Any(), "hash").Return(models.RefreshToken{
+					ID:        1,
+					UserID:    userID,
+					FamilyID:  familyID,
+					ExpiresAt: time.Now().Add(time.Hour).Unix(),
+				}, nil)
+				r.EXPECT().UseRefreshToken(gomock.Any(), uint(1)).Return(domain.ErrRefreshTokenReused)
+				r.EXPECT().RevokeRefreshTokenFamily(gomock.Any(), familyID).Return(nil)
+			},
+			mockAuthServiceBehavior: func(r *mocks.MockAuthService) {
+				r.EXPECT().HashRefreshToken("refresh-token").Return("hash")
+			},
+			assert: func(in *in, out *out) {
+				requireAssert.Equal(customError.AuthError{Err: domain.ErrRefreshTokenReused}, out.err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepositoryMock := mocks.NewMockUserRepository(ctrl)
+			test.mockUserRepositoryBehavior(userRepositoryMock)
+
+			authServiceMock := mocks.NewMockAuthService(ctrl)
+			test.mockAuthServiceBehavior(authServiceMock)
+
+			passwordServiceMock := mocks.NewMockPasswordService(ctrl)
+
+			userService := domain.NewService(userRepositoryMock, authServiceMock, passwordServiceMock)
+			tokens, err := userService.Refresh(ctx, test.in.refreshToken)
+
+			test.assert(&test.in, &out{
+				tokens: tokens,
+				err:    err,
+			})
+		})
+	}
+}
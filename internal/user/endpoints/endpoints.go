@@ -10,6 +10,8 @@ import (
 type SetEndpoints struct {
 	RegisterEndpoint endpoint.Endpoint
 	LoginEndpoint    endpoint.Endpoint
+	RefreshEndpoint  endpoint.Endpoint
+	LogoutEndpoint   endpoint.Endpoint
 }
 
 // NewEndpoints returns a SetEndpoints that wraps the provided server, and wires in all the provided middlewares.
@@ -26,8 +28,22 @@ func NewEndpoints(s domain.Service, logger *zap.Logger) SetEndpoints {
 		loginEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "Login")))(loginEndpoint)
 	}
 
+	var refreshEndpoint endpoint.Endpoint
+	{
+		refreshEndpoint = MakeRefreshEndpoint(s)
+		refreshEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "Refresh")))(refreshEndpoint)
+	}
+
+	var logoutEndpoint endpoint.Endpoint
+	{
+		logoutEndpoint = MakeLogoutEndpoint(s)
+		logoutEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "Logout")))(logoutEndpoint)
+	}
+
 	return SetEndpoints{
 		RegisterEndpoint: registerEndpoint,
 		LoginEndpoint:    loginEndpoint,
+		RefreshEndpoint:  refreshEndpoint,
+		LogoutEndpoint:   logoutEndpoint,
 	}
 }
@@ -23,9 +23,17 @@ func MakeLoginEndpoint(s domain.Service) endpoint.Endpoint {
 		}
 
 		// Login
-		authToken, expirationTime, err := s.Login(ctx, reqForm.Username, reqForm.Password)
+		tokens, err := s.Login(ctx, reqForm.Username, reqForm.Password)
+		if err != nil {
+			return LoginResponse{Err: err}, nil
+		}
 
-		return LoginResponse{AuthToken: authToken, ExpiredAt: expirationTime, Err: err}, nil
+		return LoginResponse{
+			AuthToken:        tokens.AccessToken,
+			ExpiredAt:        tokens.AccessTokenExpiresAt,
+			RefreshToken:     tokens.RefreshToken,
+			RefreshExpiredAt: tokens.RefreshTokenExpiresAt,
+		}, nil
 	}
 }
 
@@ -49,9 +57,11 @@ func (r *LoginRequest) Validate() error {
 
 // LoginResponse is a response for Login.
 type LoginResponse struct {
-	AuthToken string    `json:"auth_token,omitempty" example:"eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1dWlkIj"`
-	ExpiredAt time.Time `json:"expired_at,omitempty" example:"2023-11-09T15:21:15.973955426Z"`
-	Err       error     `json:"err,omitempty" swaggerignore:"true"`
+	AuthToken        string    `json:"auth_token,omitempty" example:"eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1dWlkIj"`
+	ExpiredAt        time.Time `json:"expired_at,omitempty" example:"2023-11-09T15:21:15.973955426Z"`
+	RefreshToken     string    `json:"refresh_token,omitempty" example:"q8Yk1nq3Vf0bH4xgS2m1bEo7pZr9dWc6LtJ5uA0yKhE"`
+	RefreshExpiredAt time.Time `json:"refresh_expired_at,omitempty" example:"2023-12-09T14:21:15.973955426Z"`
+	Err              error     `json:"err,omitempty" swaggerignore:"true"`
 }
 
 // Failed implements response.Failed.
@@ -0,0 +1,58 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/user/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+)
+
+// MakeLogoutEndpoint is an endpoint for Logout.
+func MakeLogoutEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(LogoutRequest)
+		if !ok {
+			return LogoutResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return LogoutResponse{Err: errValidate}, nil
+		}
+
+		// Logout
+		if errLogout := s.Logout(ctx, reqForm.AuthToken, reqForm.RefreshToken); errLogout != nil {
+			return LogoutResponse{Err: errLogout}, nil
+		}
+
+		return LogoutResponse{}, nil
+	}
+}
+
+// LogoutRequest is a request for Logout.
+type LogoutRequest struct {
+	AuthToken string `json:"authToken" validate:"required" swaggerignore:"true"`
+
+	RefreshToken string `json:"refresh_token" validate:"omitempty,min=16,max=128" example:"q8Yk1nq3Vf0bH4xgS2m1bEo7pZr9dWc6LtJ5uA0yKhE"`
+}
+
+// Validate is a method to validate form.
+func (r *LogoutRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// LogoutResponse is a response for Logout.
+type LogoutResponse struct {
+	Err error `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r LogoutResponse) Failed() error { return r.Err }
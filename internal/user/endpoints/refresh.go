@@ -0,0 +1,54 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/user/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+)
+
+// MakeRefreshEndpoint is an endpoint for Refresh.
+func MakeRefreshEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(RefreshRequest)
+		if !ok {
+			return LoginResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return LoginResponse{Err: errValidate}, nil
+		}
+
+		// Refresh
+		tokens, err := s.Refresh(ctx, reqForm.RefreshToken)
+		if err != nil {
+			return LoginResponse{Err: err}, nil
+		}
+
+		return LoginResponse{
+			AuthToken:        tokens.AccessToken,
+			ExpiredAt:        tokens.AccessTokenExpiresAt,
+			RefreshToken:     tokens.RefreshToken,
+			RefreshExpiredAt: tokens.RefreshTokenExpiresAt,
+		}, nil
+	}
+}
+
+// RefreshRequest is a request for Refresh.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required,min=16,max=128" example:"q8Yk1nq3Vf0bH4xgS2m1bEo7pZr9dWc6LtJ5uA0yKhE"`
+}
+
+// Validate is a method to validate form.
+func (r *RefreshRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	"film-management/internal/user/endpoints"
+	"film-management/internal/user/transport/grpc/pb"
+	grpcTransport "film-management/pkg/transport/grpc"
+	"film-management/pkg/transport/grpc/middlewares/auth"
+	kitGRPCTransport "github.com/go-kit/kit/transport/grpc"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	RegisterMethod = "/user.v1.UserService/Register"
+	LoginMethod    = "/user.v1.UserService/Login"
+	RefreshMethod  = "/user.v1.UserService/Refresh"
+	LogoutMethod   = "/user.v1.UserService/Logout"
+)
+
+// grpcServer is a gRPC server of users, it serves the same endpoints as HTTP handlers.
+type grpcServer struct {
+	pb.UnimplementedUserServiceServer
+
+	register kitGRPCTransport.Handler
+	login    kitGRPCTransport.Handler
+	refresh  kitGRPCTransport.Handler
+	logout   kitGRPCTransport.Handler
+}
+
+// NewGRPCServer is a function that returns a gRPC server of users that makes a set of endpoints available as methods.
+func NewGRPCServer(endpoints endpoints.SetEndpoints, logger *zap.Logger) pb.UserServiceServer {
+	options := []kitGRPCTransport.ServerOption{
+		kitGRPCTransport.ServerErrorHandler(grpcTransport.NewLogErrorHandler(logger)),
+	}
+
+	return &grpcServer{
+		// Register User
+		register: kitGRPCTransport.NewServer(
+			endpoints.RegisterEndpoint,
+			decodeGRPCRegisterRequest,
+			encodeGRPCRegisterResponse,
+			options...,
+		),
+		// Login User
+		login: kitGRPCTransport.NewServer(
+			endpoints.LoginEndpoint,
+			decodeGRPCLoginRequest,
+			encodeGRPCLoginResponse,
+			options...,
+		),
+		// Refresh auth tokens
+		refresh: kitGRPCTransport.NewServer(
+			endpoints.RefreshEndpoint,
+			decodeGRPCRefreshRequest,
+			encodeGRPCLoginResponse,
+			options...,
+		),
+		// Logout User
+		logout: kitGRPCTransport.NewServer(
+			endpoints.LogoutEndpoint,
+			decodeGRPCLogoutRequest,
+			encodeGRPCLogoutResponse,
+			options...,
+		),
+	}
+}
+
+func (s *grpcServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	_, resp, err := s.register.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.RegisterResponse), nil
+}
+
+func (s *grpcServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	_, resp, err := s.login.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.LoginResponse), nil
+}
+
+func (s *grpcServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.LoginResponse, error) {
+	_, resp, err := s.refresh.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.LoginResponse), nil
+}
+
+func (s *grpcServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	_, resp, err := s.logout.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcTransport.EncodeError(err)
+	}
+
+	return resp.(*pb.LogoutResponse), nil
+}
+
+// decodeGRPCRegisterRequest is a function to decode a gRPC request of Register.
+func decodeGRPCRegisterRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.RegisterRequest)
+
+	return endpoints.RegisterRequest{Username: req.GetUsername(), Password: req.GetPassword()}, nil
+}
+
+// encodeGRPCRegisterResponse is a function to encode a response of Register to gRPC.
+func encodeGRPCRegisterResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.RegisterResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return &pb.RegisterResponse{Uuid: resp.UUID, Username: resp.Username}, nil
+}
+
+// decodeGRPCLoginRequest is a function to decode a gRPC request of Login.
+func decodeGRPCLoginRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.LoginRequest)
+
+	return endpoints.LoginRequest{Username: req.GetUsername(), Password: req.GetPassword()}, nil
+}
+
+// encodeGRPCLoginResponse is a function to encode a response of Login and Refresh to gRPC.
+func encodeGRPCLoginResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.LoginResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return &pb.LoginResponse{
+		AuthToken:        resp.AuthToken,
+		ExpiredAt:        timestamppb.New(resp.ExpiredAt),
+		RefreshToken:     resp.RefreshToken,
+		RefreshExpiredAt: timestamppb.New(resp.RefreshExpiredAt),
+	}, nil
+}
+
+// decodeGRPCRefreshRequest is a function to decode a gRPC request of Refresh.
+func decodeGRPCRefreshRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.RefreshRequest)
+
+	return endpoints.RefreshRequest{RefreshToken: req.GetRefreshToken()}, nil
+}
+
+// decodeGRPCLogoutRequest is a function to decode a gRPC request of Logout.
+func decodeGRPCLogoutRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.LogoutRequest)
+
+	// Get AuthToken from metadata, it has been already checked by auth interceptor
+	authToken, err := auth.TokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints.LogoutRequest{AuthToken: authToken, RefreshToken: req.GetRefreshToken()}, nil
+}
+
+// encodeGRPCLogoutResponse is a function to encode a response of Logout to gRPC.
+func encodeGRPCLogoutResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.LogoutResponse)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	httpCommon "film-management/internal/common/transport/http"
 	"film-management/internal/user/endpoints"
 	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/transport/http/middlewares/auth"
 	"film-management/pkg/transport/http/middlewares/cors"
 	"film-management/pkg/transport/http/middlewares/recovery"
 	"film-management/pkg/transport/http/response"
@@ -14,6 +15,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"go.uber.org/zap"
 	"net/http"
+	"strings"
 )
 
 const (
@@ -21,10 +23,12 @@ const (
 
 	RegisterPath = APIPath + "register"
 	LoginPath    = APIPath + "login"
+	RefreshPath  = APIPath + "refresh"
+	LogoutPath   = APIPath + "logout"
 )
 
 // NewHTTPHandlers is a function that returns a http.Handler that makes a set of endpoints available on predefined paths.
-func NewHTTPHandlers(endpoints endpoints.SetEndpoints, cfg *config.Config, logger *zap.Logger) http.Handler {
+func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service, cfg *config.Config, logger *zap.Logger) http.Handler {
 	options := []httpKitTransport.ServerOption{
 		httpKitTransport.ServerErrorHandler(httpTransport.NewLogErrorHandler(logger)),
 		httpKitTransport.ServerErrorEncoder(response.EncodeError),
@@ -48,6 +52,22 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, cfg *config.Config, logge
 		options...,
 	)
 
+	// Refresh auth tokens
+	refreshHandler := httpKitTransport.NewServer(
+		endpoints.RefreshEndpoint,
+		decodeHTTPRefreshRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
+	// Logout User
+	logoutHandler := httpKitTransport.NewServer(
+		endpoints.LogoutEndpoint,
+		decodeHTTPLogoutRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
 	r := mux.NewRouter()
 
 	// CORS
@@ -57,6 +77,9 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, cfg *config.Config, logge
 	// Recovery
 	r.Use(recovery.Middleware(logger))
 
+	// AUTH
+	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService, logger))
+
 	// Routes
 
 	// User
@@ -65,6 +88,10 @@ func NewHTTPHandlers(endpoints endpoints.SetEndpoints, cfg *config.Config, logge
 	r.Handle(RegisterPath, registerHandler).Methods(http.MethodPost, http.MethodOptions)
 	// Login
 	r.Handle(LoginPath, loginHandler).Methods(http.MethodPost, http.MethodOptions)
+	// Refresh
+	r.Handle(RefreshPath, refreshHandler).Methods(http.MethodPost, http.MethodOptions)
+	// Logout
+	r.Handle(LogoutPath, logoutHandler).Methods(http.MethodPost, http.MethodOptions)
 	// Set custom error handlers
 	response.SetErrorHandlers(r)
 
@@ -114,3 +141,56 @@ func decodeHTTPLoginRequest(_ context.Context, r *http.Request) (request interfa
 
 	return reqForm, nil
 }
+
+// Refresh godoc
+// @Summary Refresh
+// @Description Get new auth tokens by refresh token. The refresh token can be used only once, a reused refresh token revokes all tokens of the login.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param form body endpoints.RefreshRequest true "Refresh form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.LoginResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /user/refresh [post] .
+func decodeHTTPRefreshRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.RefreshRequest
+
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	return reqForm, nil
+}
+
+// Logout godoc
+// @Summary Logout
+// @Description Revoke the current auth token and, if passed, all refresh tokens of the login
+// @Tags User
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param form body endpoints.LogoutRequest false "Logout form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.LogoutResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /user/logout [post] .
+func decodeHTTPLogoutRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.LogoutRequest
+
+	// Decode JSON, body is optional
+	if r.ContentLength != 0 {
+		if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+			return nil, httpTransport.ErrJSONDecode
+		}
+	}
+
+	// Set AuthToken from header, it has been already checked by auth middleware
+	reqForm.AuthToken = strings.TrimPrefix(r.Header.Get(auth.AuthorizationHeader), auth.AuthorizationPrefix+" ")
+
+	return reqForm, nil
+}
@@ -7,6 +7,7 @@ import (
 	"film-management/internal/user/domain/mocks"
 	"film-management/internal/user/endpoints"
 	userHttp "film-management/internal/user/transport/http"
+	"film-management/pkg/auth"
 	customError "film-management/pkg/errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,7 @@ func TestRegisterHandler(t *testing.T) {
 
 	cfg := config.GetConfig(ConfigPath)
 	log := zap.NewNop()
+	authService := auth.NewAuthService(cfg.Services.Auth, log)
 
 	type request struct {
 		body string
@@ -125,7 +127,7 @@ func TestRegisterHandler(t *testing.T) {
 			test.mockServiceBehavior(serviceMock)
 
 			serviceEndpoints := endpoints.NewEndpoints(serviceMock, log)
-			serviceHTTPHandler := userHttp.NewHTTPHandlers(serviceEndpoints, cfg, log)
+			serviceHTTPHandler := userHttp.NewHTTPHandlers(serviceEndpoints, authService, cfg, log)
 
 			srv := httptest.NewServer(serviceHTTPHandler)
 			defer srv.Close()
@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+var (
+	ErrWatchlistItemCreate      = errors.New("failed to add film to watchlist")
+	ErrWatchlistItemUpdate      = errors.New("failed to update film in watchlist")
+	ErrWatchlistItemDelete      = errors.New("failed to remove film from watchlist")
+	ErrWatchlistItemFind        = errors.New("failed to find film in watchlist")
+	ErrWatchlistItemFindAll     = errors.New("failed to find all films in watchlist")
+	ErrWatchlistItemNotFound    = errors.New("film not found in watchlist")
+	ErrWatchlistItemExists      = errors.New("film is already in watchlist")
+	ErrWatchlistItemCheckExists = errors.New("failed to check film in watchlist")
+	ErrWatchlistFilmNotFound    = errors.New("film not found")
+	ErrWatchlistFilmFind        = errors.New("failed to find film")
+	ErrWatchlistFilterWrong     = errors.New("filter wrong")
+	ErrWatchlistUnknownField    = errors.New("unknown field")
+)
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain/models"
+	"film-management/pkg/instrumenting"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/go-kit/kit/metrics"
+	"github.com/google/uuid"
+	"time"
+)
+
+type instrumentingMiddleware struct {
+	requestCount    metrics.Counter
+	requestDuration metrics.Histogram
+	next            Service
+}
+
+// NewInstrumentingMiddleware returns an instance of the instrumenting middleware.
+func NewInstrumentingMiddleware(requestCount metrics.Counter,
+	requestDuration metrics.Histogram) Middleware {
+	return func(next Service) Service {
+		return &instrumentingMiddleware{
+			requestCount,
+			requestDuration,
+			next,
+		}
+	}
+}
+
+func (i instrumentingMiddleware) AddToWatchlist(ctx context.Context, model *models.WatchlistItem) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "AddToWatchlist", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.AddToWatchlist(ctx, model)
+}
+
+func (i instrumentingMiddleware) RemoveFromWatchlist(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "RemoveFromWatchlist", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.RemoveFromWatchlist(ctx, userID, filmID)
+}
+
+func (i instrumentingMiddleware) MarkAsWatched(ctx context.Context, model *models.WatchlistItem) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "MarkAsWatched", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.MarkAsWatched(ctx, model)
+}
+
+func (i instrumentingMiddleware) ViewWatchlist(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) (items []models.WatchlistItem, p pagination.Pagination, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ViewWatchlist", "error", instrumenting.PrintErr(err)}
+		i.requestCount.With(lvs...).Add(1)
+		i.requestDuration.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return i.next.ViewWatchlist(ctx, userID, filterSortLimit)
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain/models"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+)
+
+// Service is an interface for domain service.
+type Service interface {
+	AddToWatchlist(ctx context.Context, model *models.WatchlistItem) error
+	RemoveFromWatchlist(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) error
+	MarkAsWatched(ctx context.Context, model *models.WatchlistItem) error
+	ViewWatchlist(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.WatchlistItem, pagination.Pagination, error)
+}
+
+// Repository is a repository for watchlist.
+type Repository interface {
+	CreateWatchlistItem(ctx context.Context, model *models.WatchlistItem) error
+	UpdateWatchlistItem(ctx context.Context, model *models.WatchlistItem) error
+	FindOneWatchlistItem(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) (models.WatchlistItem, error)
+	FindAllWatchlistItems(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.WatchlistItem, pagination.Pagination, error)
+	DeleteWatchlistItem(ctx context.Context, id uint) error
+	WatchlistItemExists(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) error
+	FilmExists(ctx context.Context, filmID uuid.UUID) error
+}
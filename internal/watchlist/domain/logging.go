@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain/models"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type loggingMiddleware struct {
+	next   Service
+	logger *zap.Logger
+}
+
+func NewLoggingMiddleware(logger *zap.Logger) Middleware {
+	return func(next Service) Service {
+		return &loggingMiddleware{
+			next:   next,
+			logger: logger,
+		}
+	}
+}
+
+func (l loggingMiddleware) AddToWatchlist(ctx context.Context, model *models.WatchlistItem) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "AddToWatchlist")).
+			Debug("domain",
+				zap.Any("userID", model.UserID),
+				zap.Any("filmID", model.FilmID),
+				zap.Error(err))
+	}()
+
+	return l.next.AddToWatchlist(ctx, model)
+}
+
+func (l loggingMiddleware) RemoveFromWatchlist(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "RemoveFromWatchlist")).
+			Debug("domain",
+				zap.Any("userID", userID),
+				zap.Any("filmID", filmID),
+				zap.Error(err))
+	}()
+
+	return l.next.RemoveFromWatchlist(ctx, userID, filmID)
+}
+
+func (l loggingMiddleware) MarkAsWatched(ctx context.Context, model *models.WatchlistItem) (err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "MarkAsWatched")).
+			Debug("domain",
+				zap.Any("userID", model.UserID),
+				zap.Any("filmID", model.FilmID),
+				zap.Bool("watched", model.Watched),
+				zap.Any("watchedAt", model.WatchedAt),
+				zap.Error(err))
+	}()
+
+	return l.next.MarkAsWatched(ctx, model)
+}
+
+func (l loggingMiddleware) ViewWatchlist(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) (items []models.WatchlistItem, p pagination.Pagination, err error) {
+	defer func() {
+		l.logger.With(zap.String("method", "ViewWatchlist")).
+			Debug("domain",
+				zap.Any("userID", userID),
+				zap.String("sort_field", filterSortLimit.Sort.Field()),
+				zap.String("sort_order", filterSortLimit.Sort.Order()),
+				zap.Int("limit", filterSortLimit.Limit),
+				zap.Int("offset", filterSortLimit.Offset),
+				zap.Int("page", p.Page),
+				zap.Int("page-size", p.PageSize),
+				zap.Int("total-count", p.TotalCount),
+				zap.Error(err))
+	}()
+
+	return l.next.ViewWatchlist(ctx, userID, filterSortLimit)
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	modelsFilm "film-management/internal/film/domain/models"
+	"film-management/internal/user/domain/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+// WatchlistItem is a model for film in user watchlist.
+type WatchlistItem struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	UserID    uuid.UUID  `json:"userID" gorm:"type:uuid;not null;uniqueIndex:idx_watchlist_items_user_film"`
+	FilmID    uuid.UUID  `json:"filmID" gorm:"type:uuid;not null;uniqueIndex:idx_watchlist_items_user_film"`
+	Watched   bool       `json:"watched" gorm:"not null;default:false"`
+	WatchedAt *time.Time `json:"watched_at" gorm:"type:date"`
+	CreatedAt int64      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt int64      `json:"updated_at" gorm:"autoUpdateTime"`
+
+	User models.User     `json:"-" gorm:"foreignKey:UserID;references:UUID;constraint:OnDelete:CASCADE"`
+	Film modelsFilm.Film `json:"film" gorm:"foreignKey:FilmID;references:UUID;constraint:OnDelete:CASCADE"`
+}
+
+// SetDataForUpdate sets data for update.
+func (w *WatchlistItem) SetDataForUpdate(data *WatchlistItem) {
+	w.Watched = data.Watched
+	w.WatchedAt = data.WatchedAt
+}
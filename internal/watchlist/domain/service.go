@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// service is a struct for domain service.
+type service struct {
+	Opts
+}
+
+// NewService is a constructor for domain service.
+func NewService(repository Repository, opts ...OptFunc) Service {
+	// Init default options
+	o := defaultOpts(repository)
+
+	// Apply options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &service{
+		Opts: o,
+	}
+}
+
+// AddToWatchlist Add a film to user watchlist.
+func (s service) AddToWatchlist(ctx context.Context, model *models.WatchlistItem) error {
+	// Check if a film exists
+	if err := s.checkFilmExists(ctx, model.FilmID); err != nil {
+		return err
+	}
+
+	// Check if a film is already in watchlist
+	if err := s.repository.WatchlistItemExists(ctx, model.UserID, model.FilmID); err != nil {
+		switch {
+		case errors.Is(err, ErrWatchlistItemExists):
+			return customError.ValidationError{Field: "film_uuid", Err: ErrWatchlistItemExists}
+		default:
+			return ErrWatchlistItemCheckExists
+		}
+	}
+
+	// Create watchlist item in db
+	if err := s.repository.CreateWatchlistItem(ctx, model); err != nil {
+		return ErrWatchlistItemCreate
+	}
+
+	return nil
+}
+
+// RemoveFromWatchlist Remove a film from user watchlist.
+func (s service) RemoveFromWatchlist(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) error {
+	// Get watchlist item from db
+	itemFromDB, err := s.getWatchlistItemFromDB(ctx, userID, filmID)
+	if err != nil {
+		return err
+	}
+
+	// Delete watchlist item in db
+	if errDelete := s.repository.DeleteWatchlistItem(ctx, itemFromDB.ID); errDelete != nil {
+		return ErrWatchlistItemDelete
+	}
+
+	return nil
+}
+
+// MarkAsWatched Mark a film in user watchlist as watched or unwatched.
+func (s service) MarkAsWatched(ctx context.Context, model *models.WatchlistItem) error {
+	// Get watchlist item from db
+	itemFromDB, err := s.getWatchlistItemFromDB(ctx, model.UserID, model.FilmID)
+	if err != nil {
+		return err
+	}
+
+	// Set new watchlist item data
+	itemFromDB.SetDataForUpdate(model)
+
+	// Update watchlist item in db
+	if errUpdate := s.repository.UpdateWatchlistItem(ctx, &itemFromDB); errUpdate != nil {
+		return ErrWatchlistItemUpdate
+	}
+
+	*model = itemFromDB
+
+	return nil
+}
+
+// ViewWatchlist View all films in user watchlist.
+func (s service) ViewWatchlist(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.WatchlistItem, pagination.Pagination, error) {
+	itemsFromDB, p, err := s.repository.FindAllWatchlistItems(ctx, userID, filterSortLimit)
+	if err != nil {
+		return nil, pagination.Pagination{}, err
+	}
+
+	return itemsFromDB, p, nil
+}
+
+// getWatchlistItemFromDB Get watchlist item from db.
+func (s service) getWatchlistItemFromDB(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) (models.WatchlistItem, error) {
+	itemFromDB, err := s.repository.FindOneWatchlistItem(ctx, userID, filmID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrWatchlistItemNotFound):
+			return models.WatchlistItem{}, customError.NotFoundError{Err: ErrWatchlistItemNotFound}
+		default:
+			return models.WatchlistItem{}, ErrWatchlistItemFind
+		}
+	}
+
+	return itemFromDB, nil
+}
+
+// checkFilmExists Check if a film exists in db.
+func (s service) checkFilmExists(ctx context.Context, filmID uuid.UUID) error {
+	if err := s.repository.FilmExists(ctx, filmID); err != nil {
+		switch {
+		case errors.Is(err, ErrWatchlistFilmNotFound):
+			return customError.NotFoundError{Err: ErrWatchlistFilmNotFound}
+		default:
+			return ErrWatchlistFilmFind
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/film/endpoints"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MakeAddToWatchlistEndpoint is an endpoint for AddToWatchlist.
+func MakeAddToWatchlistEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(AddToWatchlistRequest)
+		if !ok {
+			return AddToWatchlistResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return AddToWatchlistResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return AddToWatchlistResponse{Err: err}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return AddToWatchlistResponse{Err: err}, nil
+		}
+
+		// Prepare a watchlist item model
+		model := &models.WatchlistItem{
+			UserID: parseUserUUID,
+			FilmID: parseFilmUUID,
+		}
+
+		// Add film to watchlist
+		if errAddToWatchlist := s.AddToWatchlist(ctx, model); errAddToWatchlist != nil {
+			return AddToWatchlistResponse{Err: errAddToWatchlist}, nil
+		}
+
+		return AddToWatchlistResponse{
+			UUID: model.FilmID.String(),
+		}, nil
+	}
+}
+
+// AddToWatchlistRequest is a request for AddToWatchlist.
+type AddToWatchlistRequest struct {
+	UserID string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+
+	FilmID string `json:"film_uuid" validate:"required,uuid4" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// Validate is a method to validate form.
+func (r *AddToWatchlistRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// AddToWatchlistResponse is a response for AddToWatchlist.
+type AddToWatchlistResponse struct {
+	UUID string `json:"uuid,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Err  error  `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r AddToWatchlistResponse) Failed() error { return r.Err }
+
+// ItemWatchlist is a response item for watchlist.
+type ItemWatchlist struct {
+	Film      endpoints.ItemAllFilms `json:"film"`
+	Watched   bool                   `json:"watched" example:"true"`
+	WatchedAt string                 `json:"watched_at,omitempty" example:"2021-01-01"`
+	AddedAt   string                 `json:"added_at" example:"2021-01-01 00:00:00"`
+}
+
+// domainWatchlistItemToItemWatchlist is a function to convert domain watchlist item to item watchlist.
+func domainWatchlistItemToItemWatchlist(item models.WatchlistItem) ItemWatchlist {
+	itemWatchlist := ItemWatchlist{
+		Film:    endpoints.DomainFilmToItemAllFilms(item.Film),
+		Watched: item.Watched,
+		AddedAt: time.Unix(item.CreatedAt, 0).Format(time.DateTime),
+	}
+
+	if item.WatchedAt != nil {
+		itemWatchlist.WatchedAt = item.WatchedAt.Format(time.DateOnly)
+	}
+
+	return itemWatchlist
+}
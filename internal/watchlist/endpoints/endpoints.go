@@ -0,0 +1,49 @@
+package endpoints
+
+import (
+	"film-management/internal/watchlist/domain"
+	"github.com/go-kit/kit/endpoint"
+	"go.uber.org/zap"
+)
+
+// SetEndpoints collects all the endpoints that compose a watchlist service.
+type SetEndpoints struct {
+	AddToWatchlistEndpoint      endpoint.Endpoint
+	RemoveFromWatchlistEndpoint endpoint.Endpoint
+	MarkAsWatchedEndpoint       endpoint.Endpoint
+	ViewWatchlistEndpoint       endpoint.Endpoint
+}
+
+// NewEndpoints returns a SetEndpoints that wraps the provided server, and wires in all the provided middlewares.
+func NewEndpoints(s domain.Service, logger *zap.Logger) SetEndpoints {
+	var addToWatchlistEndpoint endpoint.Endpoint
+	{
+		addToWatchlistEndpoint = MakeAddToWatchlistEndpoint(s)
+		addToWatchlistEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "AddToWatchlist")))(addToWatchlistEndpoint)
+	}
+
+	var removeFromWatchlistEndpoint endpoint.Endpoint
+	{
+		removeFromWatchlistEndpoint = MakeRemoveFromWatchlistEndpoint(s)
+		removeFromWatchlistEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "RemoveFromWatchlist")))(removeFromWatchlistEndpoint)
+	}
+
+	var markAsWatchedEndpoint endpoint.Endpoint
+	{
+		markAsWatchedEndpoint = MakeMarkAsWatchedEndpoint(s)
+		markAsWatchedEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "MarkAsWatched")))(markAsWatchedEndpoint)
+	}
+
+	var viewWatchlistEndpoint endpoint.Endpoint
+	{
+		viewWatchlistEndpoint = MakeViewWatchlistEndpoint(s)
+		viewWatchlistEndpoint = NewLoggingMiddleware(logger.With(zap.String("method", "ViewWatchlist")))(viewWatchlistEndpoint)
+	}
+
+	return SetEndpoints{
+		AddToWatchlistEndpoint:      addToWatchlistEndpoint,
+		RemoveFromWatchlistEndpoint: removeFromWatchlistEndpoint,
+		MarkAsWatchedEndpoint:       markAsWatchedEndpoint,
+		ViewWatchlistEndpoint:       viewWatchlistEndpoint,
+	}
+}
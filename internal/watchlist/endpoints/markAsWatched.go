@@ -0,0 +1,98 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/domain/models"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+	"time"
+)
+
+// MakeMarkAsWatchedEndpoint is an endpoint for MarkAsWatched.
+func MakeMarkAsWatchedEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(MarkAsWatchedRequest)
+		if !ok {
+			return MarkAsWatchedResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return MarkAsWatchedResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return MarkAsWatchedResponse{Err: err}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return MarkAsWatchedResponse{Err: err}, nil
+		}
+
+		// Prepare a watchlist item model, film is watched by default
+		model := &models.WatchlistItem{
+			UserID:  parseUserUUID,
+			FilmID:  parseFilmUUID,
+			Watched: reqForm.Watched == nil || *reqForm.Watched,
+		}
+
+		// Set watched date, today by default
+		if model.Watched {
+			watchedAt := time.Now().Truncate(24 * time.Hour)
+
+			if reqForm.WatchedAt != "" {
+				if watchedAt, err = time.Parse(time.DateOnly, reqForm.WatchedAt); err != nil {
+					return MarkAsWatchedResponse{Err: err}, nil
+				}
+			}
+
+			model.WatchedAt = &watchedAt
+		}
+
+		// Mark film as watched
+		if errMarkAsWatched := s.MarkAsWatched(ctx, model); errMarkAsWatched != nil {
+			return MarkAsWatchedResponse{Err: errMarkAsWatched}, nil
+		}
+
+		return MarkAsWatchedResponse{
+			Item: domainWatchlistItemToItemWatchlist(*model),
+		}, nil
+	}
+}
+
+// MarkAsWatchedRequest is a request for MarkAsWatched.
+type MarkAsWatchedRequest struct {
+	UserID string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	FilmID string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+
+	Watched   *bool  `json:"watched" validate:"omitempty" example:"true"`
+	WatchedAt string `json:"watched_at" validate:"omitempty,customDate" example:"2021-01-01"`
+}
+
+// Validate is a method to validate form.
+func (r *MarkAsWatchedRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// MarkAsWatchedResponse is a response for MarkAsWatched.
+type MarkAsWatchedResponse struct {
+	Item ItemWatchlist `json:"item,omitempty"`
+	Err  error         `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r MarkAsWatchedResponse) Failed() error { return r.Err }
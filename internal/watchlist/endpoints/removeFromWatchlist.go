@@ -0,0 +1,70 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain"
+	"film-management/pkg/errors"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeRemoveFromWatchlistEndpoint is an endpoint for RemoveFromWatchlist.
+func MakeRemoveFromWatchlistEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(RemoveFromWatchlistRequest)
+		if !ok {
+			return RemoveFromWatchlistResponse{}, errors.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return RemoveFromWatchlistResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return RemoveFromWatchlistResponse{Err: err}, nil
+		}
+
+		// Parse film UUID
+		parseFilmUUID, err := uuid.Parse(reqForm.FilmID)
+		if err != nil {
+			return RemoveFromWatchlistResponse{Err: err}, nil
+		}
+
+		// Remove film from watchlist
+		if errRemove := s.RemoveFromWatchlist(ctx, parseUserUUID, parseFilmUUID); errRemove != nil {
+			return RemoveFromWatchlistResponse{Err: errRemove}, nil
+		}
+
+		return RemoveFromWatchlistResponse{}, nil
+	}
+}
+
+// RemoveFromWatchlistRequest is a request for RemoveFromWatchlist.
+type RemoveFromWatchlistRequest struct {
+	UserID string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+	FilmID string `json:"filmID" validate:"required,uuid4" swaggerignore:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *RemoveFromWatchlistRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// RemoveFromWatchlistResponse is a response for RemoveFromWatchlist.
+type RemoveFromWatchlistResponse struct {
+	Err error `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r RemoveFromWatchlistResponse) Failed() error { return r.Err }
@@ -0,0 +1,123 @@
+package endpoints
+
+import (
+	"context"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"film-management/pkg/validation"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/google/uuid"
+)
+
+// MakeViewWatchlistEndpoint is an endpoint for ViewWatchlist.
+func MakeViewWatchlistEndpoint(s domain.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		reqForm, ok := request.(ViewWatchlistRequest)
+		if !ok {
+			return ViewWatchlistResponse{}, customError.ErrInvalidRequest
+		}
+
+		// Validate form
+		if errValidate := reqForm.Validate(); errValidate != nil {
+			return ViewWatchlistResponse{Err: errValidate}, nil
+		}
+
+		// Parse user UUID
+		parseUserUUID, err := uuid.Parse(reqForm.UserID)
+		if err != nil {
+			return ViewWatchlistResponse{Err: err}, nil
+		}
+
+		// Build FilterSortLimit
+		builder := query.NewFilterSortLimitBuilder()
+
+		// Get sort
+		sortOption, err := sort.GetSortOptions(reqForm.Sort, []string{"title", "release_date", "rating", "added_at", "watched_at"}, "added_at.desc")
+		if err != nil {
+			return ViewWatchlistResponse{Err: err}, nil
+		}
+
+		// Get limit and offset
+		limit, err := pagination.GetLimitOption(reqForm.Limit, 20)
+		if err != nil {
+			return ViewWatchlistResponse{Err: err}, nil
+		}
+
+		// Get offset from HTTP request
+		offset, err := pagination.GetOffsetOption(reqForm.Offset)
+		if err != nil {
+			return ViewWatchlistResponse{Err: err}, nil
+		}
+
+		// Get filters
+		myFilters := make(query.Filter)
+
+		if reqForm.Watched != "" {
+			myFilters["watched"] = reqForm.Watched == "true"
+		}
+
+		// Build FilterSortLimit
+		filterSortLimit := builder.
+			SetSort(sortOption).
+			SetFilter(myFilters).
+			SetLimit(limit).
+			SetOffset(offset).
+			Build()
+
+		if items, p, errViewWatchlist := s.ViewWatchlist(ctx, parseUserUUID, filterSortLimit); errViewWatchlist != nil {
+			return ViewWatchlistResponse{Err: errViewWatchlist}, nil
+		} else {
+			return ViewWatchlistResponse{
+				Items:      domainWatchlistItemsToItemsWatchlist(items),
+				Pagination: p,
+			}, nil
+		}
+	}
+}
+
+// ViewWatchlistRequest is a request for ViewWatchlist.
+type ViewWatchlistRequest struct {
+	UserID string `json:"userID" validate:"required,uuid4" swaggerignore:"true"`
+
+	Sort    string `json:"sort" validate:"omitempty,min=3,max=30" example:"added_at.desc"`
+	Limit   int    `json:"limit" validate:"omitempty,min=1,max=100" example:"10"`
+	Offset  int    `json:"offset" validate:"omitempty,min=0" example:"0"`
+	Watched string `json:"watched" validate:"omitempty,oneof=true false" example:"true"`
+}
+
+// Validate is a method to validate form.
+func (r *ViewWatchlistRequest) Validate() error {
+	// Get custom validator
+	customValidator, err := validation.GetValidator()
+	if err != nil {
+		return err
+	}
+
+	// Validate form
+	return customValidator.Validate(r)
+}
+
+// ViewWatchlistResponse is a response for ViewWatchlist.
+type ViewWatchlistResponse struct {
+	Items      []ItemWatchlist       `json:"items"`
+	Pagination pagination.Pagination `json:"pagination,omitempty"`
+	Err        error                 `json:"err,omitempty" swaggerignore:"true"`
+}
+
+// Failed implements response.Failed.
+func (r ViewWatchlistResponse) Failed() error { return r.Err }
+
+// domainWatchlistItemsToItemsWatchlist is a function to convert domain watchlist items to items watchlist.
+func domainWatchlistItemsToItemsWatchlist(items []models.WatchlistItem) []ItemWatchlist {
+	watchlist := make([]ItemWatchlist, 0, len(items))
+
+	for _, item := range items {
+		watchlist = append(watchlist, domainWatchlistItemToItemWatchlist(item))
+	}
+
+	return watchlist
+}
@@ -0,0 +1,251 @@
+package http
+
+import (
+	"context"
+	"film-management/config"
+	httpCommon "film-management/internal/common/transport/http"
+	"film-management/internal/watchlist/endpoints"
+	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/transport/http/middlewares/auth"
+	"film-management/pkg/transport/http/middlewares/cors"
+	"film-management/pkg/transport/http/middlewares/recovery"
+	"film-management/pkg/transport/http/response"
+	"film-management/pkg/utils"
+	httpKitTransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+const (
+	APIPath = httpCommon.APIPath + "user/watchlist/"
+)
+
+// NewHTTPHandlers is a function that returns a http.Handler that makes a set of endpoints available on predefined paths.
+func NewHTTPHandlers(endpoints endpoints.SetEndpoints, authService auth.Service, cfg *config.Config, logger *zap.Logger) http.Handler {
+	options := []httpKitTransport.ServerOption{
+		httpKitTransport.ServerErrorHandler(httpTransport.NewLogErrorHandler(logger)),
+		httpKitTransport.ServerErrorEncoder(response.EncodeError),
+	}
+
+	// Handlers
+	// Add a film to watchlist
+	addToWatchlistHandler := httpKitTransport.NewServer(
+		endpoints.AddToWatchlistEndpoint,
+		decodeHTTPAddToWatchlistRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Remove a film from watchlist
+	removeFromWatchlistHandler := httpKitTransport.NewServer(
+		endpoints.RemoveFromWatchlistEndpoint,
+		decodeHTTPRemoveFromWatchlistRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// Mark a film in watchlist as watched
+	markAsWatchedHandler := httpKitTransport.NewServer(
+		endpoints.MarkAsWatchedEndpoint,
+		decodeHTTPMarkAsWatchedRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+	// View watchlist
+	viewWatchlistHandler := httpKitTransport.NewServer(
+		endpoints.ViewWatchlistEndpoint,
+		decodeHTTPViewWatchlistRequest,
+		response.EncodeHTTPResponse,
+		options...,
+	)
+
+	r := mux.NewRouter()
+
+	// CORS
+	r.Use(mux.CORSMethodMiddleware(r))
+	r.Use(cors.Middleware(cfg.HTTP.CorsAllowedOrigins, logger))
+
+	// Recovery
+	r.Use(recovery.Middleware(logger))
+
+	// AUTH
+	r.Use(auth.Middleware(cfg.HTTP.NotAuthUrls, authService, logger))
+
+	// Routes
+
+	// Watchlist
+	//
+	// Add a film to watchlist
+	r.Handle(APIPath, addToWatchlistHandler).Methods(http.MethodPost)
+	// View watchlist
+	r.Handle(APIPath, viewWatchlistHandler).Methods(http.MethodGet)
+	// Remove a film from watchlist
+	r.Handle(APIPath+"{id}", removeFromWatchlistHandler).Methods(http.MethodDelete)
+	// Mark a film in watchlist as watched
+	r.Handle(APIPath+"{id}/watched", markAsWatchedHandler).Methods(http.MethodPut)
+
+	// Set custom error handlers
+	response.SetErrorHandlers(r)
+
+	return r
+}
+
+// AddToWatchlist godoc
+// @Summary Add a film to watchlist
+// @Description Add a film to watchlist of the current user
+// @Tags Watchlist
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param form body endpoints.AddToWatchlistRequest true "Add to watchlist form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.AddToWatchlistResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /user/watchlist/ [post] .
+func decodeHTTPAddToWatchlistRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.AddToWatchlistRequest
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set UserID
+	reqForm.UserID = userID
+
+	return reqForm, nil
+}
+
+// RemoveFromWatchlist godoc
+// @Summary Remove a film from watchlist
+// @Description Remove a film from watchlist of the current user
+// @Tags Watchlist
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.RemoveFromWatchlistResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /user/watchlist/{id} [delete] .
+func decodeHTTPRemoveFromWatchlistRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	return endpoints.RemoveFromWatchlistRequest{
+		UserID: userID,
+		FilmID: filmUUIDFromPath,
+	}, nil
+}
+
+// MarkAsWatched godoc
+// @Summary Mark a film in watchlist as watched
+// @Description Mark a film in watchlist as watched or unwatched. Watched date is today by default.
+// @Tags Watchlist
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Film UUID"
+// @Param form body endpoints.MarkAsWatchedRequest true "Mark as watched form"
+// @Success 200 {object} response.SuccessResponse{data=endpoints.MarkAsWatchedResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Not Found"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /user/watchlist/{id}/watched [put] .
+func decodeHTTPMarkAsWatchedRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var reqForm endpoints.MarkAsWatchedRequest
+
+	// Get film UUID from path
+	filmUUIDFromPath, err := httpTransport.GetValueFromPath(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Decode JSON
+	if e := jsoniter.NewDecoder(r.Body).Decode(&reqForm); e != nil {
+		return nil, httpTransport.ErrJSONDecode
+	}
+
+	// Set FilmID and UserID
+	reqForm.FilmID = filmUUIDFromPath
+	reqForm.UserID = userID
+
+	return reqForm, nil
+}
+
+// ViewWatchlist godoc
+// @Summary View watchlist
+// @Description View watchlist of the current user
+// @Tags Watchlist
+// @Security ApiKeyAuth
+// @Accept  json
+// @Produce  json
+// @Param sort query string false "sort" example(title.asc or release_date.desc or rating.desc or added_at.desc or watched_at.desc)
+// @Param limit query string false "limit" example(10)
+// @Param offset query string false "offset" example(1)
+// @Param watched query string false "watched" example(true or false)
+// @Success 200 {object} response.SuccessResponse{data=endpoints.ViewWatchlistResponse} "Success"
+// @Failure 400 {object} response.ErrorResponse	"Bad Request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 422 {object} response.ErrorResponseValidation "Data Validation Failed"
+// @Failure 500 {object} response.ErrorResponse "Internal Server Error"
+// @Router /user/watchlist/ [get] .
+func decodeHTTPViewWatchlistRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoints.ViewWatchlistRequest
+
+	// Get UserID from context
+	userID, errUserID := utils.GetValueFromContext(r.Context(), auth.ContextKeyUserID)
+	if errUserID != nil {
+		return nil, httpTransport.ErrContextUserID
+	}
+
+	// Get sort from HTTP request
+	req.Sort = r.URL.Query().Get("sort")
+
+	// Get limit from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("limit", r, &req.Limit); err != nil {
+		return nil, err
+	}
+
+	// Get offset from HTTP request
+	if err := httpTransport.GetIntParamFromHTTPRequest("offset", r, &req.Offset); err != nil {
+		return nil, err
+	}
+
+	// Get watched from HTTP request
+	req.Watched = r.URL.Query().Get("watched")
+
+	// Set UserID
+	req.UserID = userID
+
+	return req, nil
+}
@@ -0,0 +1,234 @@
+package http_test
+
+import (
+	"context"
+	"film-management/config"
+	domainFilm "film-management/internal/film/domain"
+	modelsFilm "film-management/internal/film/domain/models"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/endpoints"
+	watchlistHttp "film-management/internal/watchlist/transport/http"
+	"film-management/pkg/auth"
+	"film-management/pkg/policy"
+	httpAuth "film-management/pkg/transport/http/middlewares/auth"
+	"film-management/repositories/storage/memory"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	ConfigPath = "../../../../config"
+)
+
+// TestWatchlistHandlers tests adding, marking as watched, viewing and removing films of watchlist one after another.
+func TestWatchlistHandlers(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetConfig(ConfigPath)
+	log := zap.NewNop()
+
+	// Paths of keys are relative to the root of the project
+	authConfig := cfg.Services.Auth
+	authConfig.PathPrivateKeyFile = filepath.Join(ConfigPath, "ssl", "jwtRS256.key")
+	authConfig.PathPublicKeyFile = filepath.Join(ConfigPath, "ssl", "jwtRS256.key.pub")
+	authService := auth.NewAuthService(authConfig, log)
+
+	token, _, err := authService.GenerateAuthToken(uuid.New().String(), string(policy.RoleViewer))
+	require.NoError(t, err)
+
+	// Films and watchlist share one store
+	store := memory.NewStore()
+	films := addFilms(t, store, "Alpha", "Bravo")
+	alpha, bravo := films[0], films[1]
+
+	service := domain.NewService(memory.NewWatchlistRepository(store, log))
+	serviceEndpoints := endpoints.NewEndpoints(service, log)
+	serviceHTTPHandler := watchlistHttp.NewHTTPHandlers(serviceEndpoints, authService, cfg, log)
+
+	steps := []struct {
+		name               string
+		method             string
+		path               string
+		body               string
+		expectedStatusCode int
+		expectedResponse   string
+		// expectedTitles are titles of films of the viewed watchlist in order
+		expectedTitles []string
+		expectedCount  int
+	}{
+		{
+			name:               "add film",
+			method:             http.MethodPost,
+			body:               `{"film_uuid":"` + alpha.String() + `"}`,
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"code":200,"data":{"uuid":"` + alpha.String() + `"},"message":"OK"}`,
+		},
+		{
+			name:               "add film again",
+			method:             http.MethodPost,
+			body:               `{"film_uuid":"` + alpha.String() + `"}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedResponse:   `"film_uuid":"film is already in watchlist"`,
+		},
+		{
+			name:               "add missing film",
+			method:             http.MethodPost,
+			body:               `{"film_uuid":"` + uuid.New().String() + `"}`,
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":404,"message":"film not found"}`,
+		},
+		{
+			name:               "add other film",
+			method:             http.MethodPost,
+			body:               `{"film_uuid":"` + bravo.String() + `"}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "mark as watched with date",
+			method:             http.MethodPut,
+			path:               alpha.String() + "/watched",
+			body:               `{"watched_at":"2024-05-01"}`,
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `"watched":true,"watched_at":"2024-05-01"`,
+		},
+		{
+			name:               "mark as watched with wrong date",
+			method:             http.MethodPut,
+			path:               bravo.String() + "/watched",
+			body:               `{"watched_at":"2024-13-01"}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "mark as watched film not in watchlist",
+			method:             http.MethodPut,
+			path:               uuid.New().String() + "/watched",
+			body:               `{}`,
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":404,"message":"film not found in watchlist"}`,
+		},
+		{
+			name:               "view by title",
+			method:             http.MethodGet,
+			path:               "?sort=title.asc",
+			expectedStatusCode: http.StatusOK,
+			expectedTitles:     []string{"Alpha", "Bravo"},
+			expectedCount:      2,
+		},
+		{
+			name:               "view by release date with limit",
+			method:             http.MethodGet,
+			path:               "?sort=release_date.desc&limit=1",
+			expectedStatusCode: http.StatusOK,
+			expectedTitles:     []string{"Bravo"},
+			expectedCount:      2,
+		},
+		{
+			name:               "view with offset",
+			method:             http.MethodGet,
+			path:               "?sort=title.asc&limit=1&offset=1",
+			expectedStatusCode: http.StatusOK,
+			expectedTitles:     []string{"Bravo"},
+			expectedCount:      2,
+		},
+		{
+			name:               "view watched",
+			method:             http.MethodGet,
+			path:               "?watched=true",
+			expectedStatusCode: http.StatusOK,
+			expectedTitles:     []string{"Alpha"},
+			expectedCount:      1,
+		},
+		{
+			name:               "view with unknown sort",
+			method:             http.MethodGet,
+			path:               "?sort=director.asc",
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "remove film",
+			method:             http.MethodDelete,
+			path:               alpha.String(),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "remove film again",
+			method:             http.MethodDelete,
+			path:               alpha.String(),
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":404,"message":"film not found in watchlist"}`,
+		},
+		{
+			name:               "view after remove",
+			method:             http.MethodGet,
+			expectedStatusCode: http.StatusOK,
+			expectedTitles:     []string{"Bravo"},
+			expectedCount:      1,
+		},
+	}
+
+	// Steps change the same watchlist, so they are run in order
+	for _, step := range steps {
+		req, _ := http.NewRequestWithContext(context.Background(), step.method, watchlistHttp.APIPath+step.path, strings.NewReader(step.body))
+		req.Header.Set("Authorization", httpAuth.AuthorizationPrefix+" "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		serviceHTTPHandler.ServeHTTP(w, req)
+
+		require.Equal(t, step.expectedStatusCode, w.Code, "%s: %s", step.name, w.Body.String())
+		assert.Contains(t, strings.TrimSpace(w.Body.String()), step.expectedResponse, step.name)
+
+		if step.expectedTitles != nil {
+			var resp struct {
+				Data endpoints.ViewWatchlistResponse `json:"data"`
+			}
+			require.NoError(t, jsoniter.Unmarshal(w.Body.Bytes(), &resp), step.name)
+
+			titles := make([]string, 0, len(resp.Data.Items))
+			for _, item := range resp.Data.Items {
+				titles = append(titles, item.Film.Title)
+			}
+
+			assert.Equal(t, step.expectedTitles, titles, step.name)
+			assert.Equal(t, step.expectedCount, resp.Data.Pagination.TotalCount, step.name)
+		}
+	}
+}
+
+// addFilms is a function to add films with the titles to the store, every next film is released a year later.
+func addFilms(t *testing.T, store *memory.Store, titles ...string) []uuid.UUID {
+	t.Helper()
+
+	ctx := context.Background()
+	admin := policy.NewActor(uuid.New(), policy.RoleAdmin)
+	service := domainFilm.NewService(memory.NewFilmRepository(store, zap.NewNop()))
+
+	require.NoError(t, service.AddGenre(ctx, &modelsFilm.Genre{Name: "drama"}, admin))
+
+	films := make([]uuid.UUID, 0, len(titles))
+
+	for i, title := range titles {
+		film := modelsFilm.Film{
+			Title:       title,
+			Director:    modelsFilm.Director{Name: "John Doe"},
+			Genres:      []modelsFilm.Genre{{Name: "drama"}},
+			Credits:     []modelsFilm.Credit{{Cast: modelsFilm.Cast{Name: "Jane Roe"}, Department: modelsFilm.DepartmentCast}},
+			Synopsis:    "Synopsis of " + title,
+			ReleaseDate: time.Date(2020+i, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		require.NoError(t, service.AddFilm(ctx, &film, admin))
+
+		films = append(films, film.UUID)
+	}
+
+	return films
+}
@@ -0,0 +1,18 @@
+// Package migrations embeds numbered SQL migrations of the database of every SQL storage driver.
+// Directories are named by the driver, the same version is the same change of the schema in every directory.
+package migrations
+
+import (
+	"embed"
+	"film-management/pkg/database/migrator"
+)
+
+// FS is a file system with directories of migrations of drivers.
+//
+//go:embed postgres/*.sql sqlite/*.sql
+var FS embed.FS
+
+// Load is a function to get migrations of the storage driver in order of versions.
+func Load(driver string) ([]migrator.Migration, error) {
+	return migrator.Load(FS, driver)
+}
@@ -0,0 +1,193 @@
+package migrations_test
+
+import (
+	"context"
+	modelsFilm "film-management/internal/film/domain/models"
+	modelsUser "film-management/internal/user/domain/models"
+	"film-management/migrations"
+	"film-management/pkg/database"
+	"film-management/pkg/database/migrator"
+	"film-management/pkg/database/postgresql"
+	"film-management/pkg/database/sqlite"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Models of the first release, its GORM auto migration made the schema which migrations start from.
+type (
+	User struct {
+		UUID      uuid.UUID `gorm:"type:uuid;primaryKey"`
+		Username  string    `gorm:"size:40;unique;not null"`
+		Password  string    `gorm:"size:255;not null"`
+		CreatedAt int64     `gorm:"autoCreateTime"`
+		UpdatedAt int64     `gorm:"autoUpdateTime"`
+	}
+	Director struct {
+		ID   uint   `gorm:"primaryKey"`
+		Name string `gorm:"unique;not null;size:100"`
+	}
+	Genre struct {
+		ID   uint   `gorm:"primaryKey"`
+		Name string `gorm:"unique;not null;size:100"`
+	}
+	Cast struct {
+		ID   uint   `gorm:"primaryKey"`
+		Name string `gorm:"unique;not null;size:100"`
+	}
+	Film struct {
+		UUID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+		CreatorID   uuid.UUID `gorm:"type:uuid;not null"`
+		Title       string    `gorm:"unique;not null;size:100"`
+		DirectorID  uint      `gorm:"not null"`
+		ReleaseDate time.Time `gorm:"type:date;not null;index"`
+		Casts       []Cast    `gorm:"many2many:film_casts;constraint:OnDelete:CASCADE"`
+		Genres      []Genre   `gorm:"many2many:film_genres;constraint:OnDelete:CASCADE"`
+		Synopsis    string    `gorm:"type:text;not null"`
+		CreatedAt   int64     `gorm:"autoCreateTime"`
+		UpdatedAt   int64     `gorm:"autoUpdateTime"`
+
+		Creator  User     `gorm:"foreignKey:CreatorID;references:UUID;constraint:OnDelete:CASCADE"`
+		Director Director `gorm:"foreignKey:DirectorID;references:ID;constraint:OnDelete:CASCADE"`
+	}
+)
+
+func TestLoad(t *testing.T) {
+	postgresMigrations, err := migrations.Load(postgresql.Driver)
+	require.NoError(t, err)
+	require.NotEmpty(t, postgresMigrations)
+
+	sqliteMigrations, err := migrations.Load(sqlite.Driver)
+	require.NoError(t, err)
+
+	// Every change of the schema is made for every driver with the same version
+	require.Len(t, sqliteMigrations, len(postgresMigrations))
+
+	for i := range postgresMigrations {
+		assert.Equal(t, postgresMigrations[i].Version, sqliteMigrations[i].Version)
+		assert.Equal(t, postgresMigrations[i].Name, sqliteMigrations[i].Name)
+	}
+}
+
+func TestSQLiteFromFirstRelease(t *testing.T) {
+	db, err := database.Connect(&database.Config{
+		Driver: sqlite.Driver,
+		SQLite: sqlite.Config{Path: filepath.Join(t.TempDir(), "film_management.db")},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if sqlDB, errDB := db.DB(); errDB == nil {
+			sqlDB.Close()
+		}
+	})
+
+	testFromFirstRelease(t, db, sqlite.Driver)
+}
+
+// TestPostgresFromFirstRelease runs against the database of TEST_POSTGRES_URL, all its data is removed.
+func TestPostgresFromFirstRelease(t *testing.T) {
+	dbURL := os.Getenv("TEST_POSTGRES_URL")
+	if dbURL == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec("DROP SCHEMA public CASCADE; CREATE SCHEMA public").Error)
+
+	testFromFirstRelease(t, db, postgresql.Driver)
+}
+
+// testFromFirstRelease migrates the database made by the first release with its data up, down and up again.
+func testFromFirstRelease(t *testing.T, db *gorm.DB, driver string) {
+	ctx := context.Background()
+
+	require.NoError(t, db.AutoMigrate(&User{}, &Film{}, &Genre{}, &Director{}, &Cast{}))
+
+	user := User{UUID: uuid.New(), Username: "user1", Password: "password"}
+	require.NoError(t, db.Create(&user).Error)
+
+	film := Film{
+		UUID:        uuid.New(),
+		CreatorID:   user.UUID,
+		Title:       "Film 1",
+		Director:    Director{Name: "Director A"},
+		ReleaseDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Casts:       []Cast{{Name: "Cast A"}, {Name: "Cast B"}},
+		Genres:      []Genre{{Name: "drama"}, {Name: "comedy"}},
+		Synopsis:    "Synopsis A",
+	}
+	require.NoError(t, db.Create(&film).Error)
+
+	filmMigrations, err := migrations.Load(driver)
+	require.NoError(t, err)
+
+	m := migrator.NewMigrator(db, filmMigrations, zap.NewNop())
+
+	count, err := m.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(filmMigrations), count)
+
+	// Data of the first release has columns of later releases
+	var migratedUser modelsUser.User
+	require.NoError(t, db.First(&migratedUser, "uuid = ?", user.UUID).Error)
+	assert.Equal(t, "editor", string(migratedUser.Role))
+
+	var migratedFilm modelsFilm.Film
+	require.NoError(t, db.
+		Preload("Credits", func(db *gorm.DB) *gorm.DB { return db.Order("credits.billing_order ASC") }).
+		Preload("Credits.Cast").
+		First(&migratedFilm, "uuid = ?", film.UUID).Error)
+	assert.Equal(t, 1, migratedFilm.Version)
+	assert.Zero(t, migratedFilm.RatingCount)
+	require.Len(t, migratedFilm.Credits, 2)
+	assert.Equal(t, "Cast A", migratedFilm.Credits[0].Cast.Name)
+	assert.Equal(t, 1, migratedFilm.Credits[0].BillingOrder)
+	assert.Equal(t, modelsFilm.DepartmentCast, migratedFilm.Credits[1].Department)
+	assert.False(t, db.Migrator().HasTable("film_casts"))
+
+	var revision modelsFilm.Revision
+	require.NoError(t, db.First(&revision, "film_id = ?", film.UUID).Error)
+	assert.Equal(t, 1, revision.Number)
+	assert.Equal(t, user.UUID, revision.EditorID)
+	assert.Equal(t, modelsFilm.RevisionSnapshot{
+		Title:    "Film 1",
+		Director: "Director A",
+		Genres:   []string{"comedy", "drama"},
+		Credits: []modelsFilm.RevisionCredit{
+			{Name: "Cast A", Department: modelsFilm.DepartmentCast, BillingOrder: 1},
+			{Name: "Cast B", Department: modelsFilm.DepartmentCast, BillingOrder: 2},
+		},
+		Synopsis:    "Synopsis A",
+		ReleaseDate: "2020-01-01",
+	}, revision.Snapshot)
+
+	// Rolling back all migrations leaves the schema of the first release with its data
+	count, err = m.Down(ctx, len(filmMigrations)-1)
+	require.NoError(t, err)
+	assert.Equal(t, len(filmMigrations)-1, count)
+
+	var casts int64
+	require.NoError(t, db.Table("film_casts").Where("film_uuid = ?", film.UUID).Count(&casts).Error)
+	assert.Equal(t, int64(2), casts)
+	assert.False(t, db.Migrator().HasColumn("films", "version"))
+	assert.False(t, db.Migrator().HasTable("credits"))
+
+	count, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(filmMigrations)-1, count)
+
+	_, err = m.Down(ctx, len(filmMigrations))
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable("films"))
+}
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "film_genres";
+DROP TABLE IF EXISTS "film_casts";
+DROP TABLE IF EXISTS "films";
+DROP TABLE IF EXISTS "casts";
+DROP TABLE IF EXISTS "genres";
+DROP TABLE IF EXISTS "directors";
+DROP TABLE IF EXISTS "users";
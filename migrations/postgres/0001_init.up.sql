@@ -0,0 +1,60 @@
+-- Schema of the first release. Tables and indexes are created only if missing,
+-- so databases made by the GORM auto migration of that release are adopted as they are.
+
+CREATE TABLE IF NOT EXISTS "users" (
+    "uuid" uuid,
+    "username" varchar(40) NOT NULL UNIQUE,
+    "password" varchar(255) NOT NULL,
+    "created_at" bigint,
+    "updated_at" bigint,
+    PRIMARY KEY ("uuid")
+);
+
+CREATE TABLE IF NOT EXISTS "directors" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL UNIQUE,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "genres" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL UNIQUE,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "casts" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL UNIQUE,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "films" (
+    "uuid" uuid,
+    "creator_id" uuid NOT NULL,
+    "title" varchar(100) NOT NULL UNIQUE,
+    "director_id" bigint NOT NULL,
+    "release_date" date NOT NULL,
+    "synopsis" text NOT NULL,
+    "created_at" bigint,
+    "updated_at" bigint,
+    PRIMARY KEY ("uuid"),
+    CONSTRAINT "fk_films_creator" FOREIGN KEY ("creator_id") REFERENCES "users"("uuid") ON DELETE CASCADE,
+    CONSTRAINT "fk_films_director" FOREIGN KEY ("director_id") REFERENCES "directors"("id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_films_release_date" ON "films" ("release_date");
+
+CREATE TABLE IF NOT EXISTS "film_casts" (
+    "film_uuid" uuid,
+    "cast_id" bigint,
+    PRIMARY KEY ("film_uuid", "cast_id"),
+    CONSTRAINT "fk_film_casts_film" FOREIGN KEY ("film_uuid") REFERENCES "films"("uuid") ON DELETE CASCADE,
+    CONSTRAINT "fk_film_casts_cast" FOREIGN KEY ("cast_id") REFERENCES "casts"("id") ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "film_genres" (
+    "film_uuid" uuid,
+    "genre_id" bigint,
+    PRIMARY KEY ("film_uuid", "genre_id"),
+    CONSTRAINT "fk_film_genres_film" FOREIGN KEY ("film_uuid") REFERENCES "films"("uuid") ON DELETE CASCADE,
+    CONSTRAINT "fk_film_genres_genre" FOREIGN KEY ("genre_id") REFERENCES "genres"("id") ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "ratings";
+DROP INDEX IF EXISTS "idx_films_rating";
+ALTER TABLE "films" DROP COLUMN IF EXISTS "rating_count", DROP COLUMN IF EXISTS "rating";
@@ -0,0 +1,16 @@
+ALTER TABLE "films" ADD COLUMN IF NOT EXISTS "rating" decimal NOT NULL DEFAULT 0;
+ALTER TABLE "films" ADD COLUMN IF NOT EXISTS "rating_count" bigint NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS "idx_films_rating" ON "films" ("rating");
+
+CREATE TABLE IF NOT EXISTS "ratings" (
+    "id" bigserial,
+    "film_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "score" bigint NOT NULL,
+    "created_at" bigint,
+    "updated_at" bigint,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_ratings_user" FOREIGN KEY ("user_id") REFERENCES "users"("uuid") ON DELETE CASCADE,
+    CONSTRAINT "fk_films_ratings" FOREIGN KEY ("film_id") REFERENCES "films"("uuid") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_ratings_film_user" ON "ratings" ("film_id", "user_id");
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "watchlist_items" (
+    "id" bigserial,
+    "user_id" uuid NOT NULL,
+    "film_id" uuid NOT NULL,
+    "watched" boolean NOT NULL DEFAULT false,
+    "watched_at" date,
+    "created_at" bigint,
+    "updated_at" bigint,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_watchlist_items_user" FOREIGN KEY ("user_id") REFERENCES "users"("uuid") ON DELETE CASCADE,
+    CONSTRAINT "fk_watchlist_items_film" FOREIGN KEY ("film_id") REFERENCES "films"("uuid") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_watchlist_items_user_film" ON "watchlist_items" ("user_id", "film_id");
@@ -0,0 +1,2 @@
+-- Users of the first release become editors
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "role" varchar(20) NOT NULL DEFAULT 'editor';
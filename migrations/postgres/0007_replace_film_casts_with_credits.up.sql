@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "credits" (
+    "id" bigserial,
+    "film_id" uuid NOT NULL,
+    "cast_id" bigint NOT NULL,
+    "character" varchar(100),
+    "department" varchar(30) NOT NULL DEFAULT 'cast',
+    "billing_order" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_credits_cast" FOREIGN KEY ("cast_id") REFERENCES "casts"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_films_credits" FOREIGN KEY ("film_id") REFERENCES "films"("uuid") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_credits_cast_id" ON "credits" ("cast_id");
+CREATE INDEX IF NOT EXISTS "idx_credits_film_id" ON "credits" ("film_id");
+
+-- Move casts of films from the join table to credits, databases migrated by a later release have no join table
+DO $$
+BEGIN
+    IF to_regclass('film_casts') IS NOT NULL THEN
+        INSERT INTO "credits" ("film_id", "cast_id", "department", "billing_order")
+        SELECT "film_uuid", "cast_id", 'cast', ROW_NUMBER() OVER (PARTITION BY "film_uuid" ORDER BY "cast_id")
+        FROM "film_casts";
+
+        DROP TABLE "film_casts";
+    END IF;
+END $$;
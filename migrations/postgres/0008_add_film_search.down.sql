@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_films_search_vector";
+ALTER TABLE "films" DROP COLUMN IF EXISTS "search_vector";
@@ -0,0 +1,10 @@
+ALTER TABLE "films" ADD COLUMN IF NOT EXISTS "search_vector" tsvector;
+CREATE INDEX IF NOT EXISTS "idx_films_search_vector" ON "films" USING gin ("search_vector");
+
+-- Build the search vector of existing films, the same as the film repository does on every change
+UPDATE "films" SET "search_vector" =
+    setweight(to_tsvector('english', films.title), 'A') ||
+    setweight(to_tsvector('english', COALESCE((SELECT directors.name FROM directors WHERE directors.id = films.director_id), '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE((SELECT string_agg(casts.name, ' ') FROM credits JOIN casts ON casts.id = credits.cast_id WHERE credits.film_id = films.uuid), '')), 'B') ||
+    setweight(to_tsvector('english', films.synopsis), 'C')
+WHERE films.search_vector IS NULL;
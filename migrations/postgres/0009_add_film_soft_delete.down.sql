@@ -0,0 +1,4 @@
+-- Films in trash are deleted for good
+DELETE FROM "films" WHERE "deleted_at" IS NOT NULL;
+DROP INDEX IF EXISTS "idx_films_deleted_at";
+ALTER TABLE "films" DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,2 @@
+ALTER TABLE "films" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz;
+CREATE INDEX IF NOT EXISTS "idx_films_deleted_at" ON "films" ("deleted_at");
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "revisions" (
+    "id" bigserial,
+    "film_id" uuid NOT NULL,
+    "number" bigint NOT NULL,
+    "editor_id" uuid NOT NULL,
+    "action" varchar(20) NOT NULL,
+    "snapshot" jsonb NOT NULL,
+    "created_at" bigint,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_films_revisions" FOREIGN KEY ("film_id") REFERENCES "films"("uuid") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_revisions_editor_id" ON "revisions" ("editor_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_revisions_film_number" ON "revisions" ("film_id", "number");
+
+-- Make the first revision of existing films, the snapshot is the same as of new revisions
+INSERT INTO "revisions" ("film_id", "number", "editor_id", "action", "snapshot", "created_at")
+SELECT films.uuid, 1, films.creator_id, 'create', jsonb_build_object(
+        'title', films.title,
+        'director', COALESCE(directors.name, ''),
+        'genres', COALESCE((SELECT jsonb_agg(genres.name ORDER BY genres.name COLLATE "C")
+            FROM film_genres JOIN genres ON genres.id = film_genres.genre_id
+            WHERE film_genres.film_uuid = films.uuid), '[]'::jsonb),
+        'credits', COALESCE((SELECT jsonb_agg(jsonb_build_object(
+                'name', casts.name,
+                'character', COALESCE(credits."character", ''),
+                'department', credits.department,
+                'billing_order', credits.billing_order) ORDER BY credits.billing_order, credits.id)
+            FROM credits JOIN casts ON casts.id = credits.cast_id
+            WHERE credits.film_id = films.uuid), '[]'::jsonb),
+        'synopsis', films.synopsis,
+        'release_date', to_char(films.release_date, 'YYYY-MM-DD')),
+    EXTRACT(EPOCH FROM now())::bigint
+FROM films
+LEFT JOIN directors ON directors.id = films.director_id
+WHERE NOT EXISTS (SELECT 1 FROM revisions WHERE revisions.film_id = films.uuid);
@@ -0,0 +1 @@
+ALTER TABLE "films" ALTER COLUMN "rating" TYPE decimal;
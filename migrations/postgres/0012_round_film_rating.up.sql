@@ -0,0 +1,2 @@
+-- The aggregate score is rounded to cents, so cursors of the rating sort keep it exactly in float64
+ALTER TABLE "films" ALTER COLUMN "rating" TYPE numeric(4,2) USING ROUND("rating", 2);
@@ -0,0 +1,56 @@
+-- Schema of the first release, SQLite databases are made by migrations from the start.
+
+CREATE TABLE IF NOT EXISTS `users` (
+    `uuid` uuid,
+    `username` text NOT NULL UNIQUE,
+    `password` text NOT NULL,
+    `created_at` integer,
+    `updated_at` integer,
+    PRIMARY KEY (`uuid`)
+);
+
+CREATE TABLE IF NOT EXISTS `directors` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `name` text NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS `genres` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `name` text NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS `casts` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `name` text NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS `films` (
+    `uuid` uuid,
+    `creator_id` uuid NOT NULL,
+    `title` text NOT NULL UNIQUE,
+    `director_id` integer NOT NULL,
+    `release_date` date NOT NULL,
+    `synopsis` text NOT NULL,
+    `created_at` integer,
+    `updated_at` integer,
+    PRIMARY KEY (`uuid`),
+    CONSTRAINT `fk_films_creator` FOREIGN KEY (`creator_id`) REFERENCES `users`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_films_director` FOREIGN KEY (`director_id`) REFERENCES `directors`(`id`) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS `idx_films_release_date` ON `films` (`release_date`);
+
+CREATE TABLE IF NOT EXISTS `film_casts` (
+    `film_uuid` uuid,
+    `cast_id` integer,
+    PRIMARY KEY (`film_uuid`, `cast_id`),
+    CONSTRAINT `fk_film_casts_film` FOREIGN KEY (`film_uuid`) REFERENCES `films`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_film_casts_cast` FOREIGN KEY (`cast_id`) REFERENCES `casts`(`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `film_genres` (
+    `film_uuid` uuid,
+    `genre_id` integer,
+    PRIMARY KEY (`film_uuid`, `genre_id`),
+    CONSTRAINT `fk_film_genres_film` FOREIGN KEY (`film_uuid`) REFERENCES `films`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_film_genres_genre` FOREIGN KEY (`genre_id`) REFERENCES `genres`(`id`) ON DELETE CASCADE
+);
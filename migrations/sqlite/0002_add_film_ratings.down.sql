@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `ratings`;
+DROP INDEX IF EXISTS `idx_films_rating`;
+ALTER TABLE `films` DROP COLUMN `rating_count`;
+ALTER TABLE `films` DROP COLUMN `rating`;
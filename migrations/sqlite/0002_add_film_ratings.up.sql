@@ -0,0 +1,15 @@
+ALTER TABLE `films` ADD COLUMN `rating` real NOT NULL DEFAULT 0;
+ALTER TABLE `films` ADD COLUMN `rating_count` integer NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS `idx_films_rating` ON `films` (`rating`);
+
+CREATE TABLE IF NOT EXISTS `ratings` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `film_id` uuid NOT NULL,
+    `user_id` uuid NOT NULL,
+    `score` integer NOT NULL,
+    `created_at` integer,
+    `updated_at` integer,
+    CONSTRAINT `fk_ratings_user` FOREIGN KEY (`user_id`) REFERENCES `users`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_films_ratings` FOREIGN KEY (`film_id`) REFERENCES `films`(`uuid`) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_ratings_film_user` ON `ratings` (`film_id`, `user_id`);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `reviews` (
+    `uuid` uuid,
+    `film_id` uuid NOT NULL,
+    `author_id` uuid NOT NULL,
+    `text` text NOT NULL,
+    `hidden` numeric NOT NULL DEFAULT false,
+    `created_at` integer,
+    `updated_at` integer,
+    PRIMARY KEY (`uuid`),
+    CONSTRAINT `fk_reviews_author` FOREIGN KEY (`author_id`) REFERENCES `users`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_films_reviews` FOREIGN KEY (`film_id`) REFERENCES `films`(`uuid`) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS `idx_reviews_author_id` ON `reviews` (`author_id`);
+CREATE INDEX IF NOT EXISTS `idx_reviews_film_id` ON `reviews` (`film_id`);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `watchlist_items` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `user_id` uuid NOT NULL,
+    `film_id` uuid NOT NULL,
+    `watched` numeric NOT NULL DEFAULT false,
+    `watched_at` date,
+    `created_at` integer,
+    `updated_at` integer,
+    CONSTRAINT `fk_watchlist_items_user` FOREIGN KEY (`user_id`) REFERENCES `users`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_watchlist_items_film` FOREIGN KEY (`film_id`) REFERENCES `films`(`uuid`) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_watchlist_items_user_film` ON `watchlist_items` (`user_id`, `film_id`);
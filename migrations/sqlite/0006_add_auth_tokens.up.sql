@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `refresh_tokens` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `user_id` uuid NOT NULL,
+    `family_id` uuid NOT NULL,
+    `token_hash` text NOT NULL,
+    `expires_at` integer NOT NULL,
+    `revoked_at` integer,
+    `created_at` integer,
+    CONSTRAINT `fk_refresh_tokens_user` FOREIGN KEY (`user_id`) REFERENCES `users`(`uuid`) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_refresh_tokens_token_hash` ON `refresh_tokens` (`token_hash`);
+CREATE INDEX IF NOT EXISTS `idx_refresh_tokens_family_id` ON `refresh_tokens` (`family_id`);
+CREATE INDEX IF NOT EXISTS `idx_refresh_tokens_user_id` ON `refresh_tokens` (`user_id`);
+
+CREATE TABLE IF NOT EXISTS `revoked_tokens` (
+    `token_id` text,
+    `expires_at` integer NOT NULL,
+    `created_at` integer,
+    PRIMARY KEY (`token_id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_revoked_tokens_expires_at` ON `revoked_tokens` (`expires_at`);
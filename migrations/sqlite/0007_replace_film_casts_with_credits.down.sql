@@ -0,0 +1,13 @@
+-- Only actors go back to the join table, characters, crew and billing order are lost
+CREATE TABLE IF NOT EXISTS `film_casts` (
+    `film_uuid` uuid,
+    `cast_id` integer,
+    PRIMARY KEY (`film_uuid`, `cast_id`),
+    CONSTRAINT `fk_film_casts_film` FOREIGN KEY (`film_uuid`) REFERENCES `films`(`uuid`) ON DELETE CASCADE,
+    CONSTRAINT `fk_film_casts_cast` FOREIGN KEY (`cast_id`) REFERENCES `casts`(`id`) ON DELETE CASCADE
+);
+
+INSERT OR IGNORE INTO `film_casts` (`film_uuid`, `cast_id`)
+SELECT DISTINCT `film_id`, `cast_id` FROM `credits` WHERE `department` = 'cast';
+
+DROP TABLE IF EXISTS `credits`;
@@ -0,0 +1 @@
+-- SQLite has no full-text search, films are searched by LIKE without a search vector.
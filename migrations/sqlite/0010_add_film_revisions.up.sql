@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS `revisions` (
+    `id` integer PRIMARY KEY AUTOINCREMENT,
+    `film_id` uuid NOT NULL,
+    `number` integer NOT NULL,
+    `editor_id` uuid NOT NULL,
+    `action` text NOT NULL,
+    `snapshot` jsonb NOT NULL,
+    `created_at` integer,
+    CONSTRAINT `fk_films_revisions` FOREIGN KEY (`film_id`) REFERENCES `films`(`uuid`) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS `idx_revisions_editor_id` ON `revisions` (`editor_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_revisions_film_number` ON `revisions` (`film_id`, `number`);
+
+-- Make the first revision of existing films, the snapshot is the same as of new revisions.
+-- Results of subqueries lose their JSON type, json() keeps them arrays and objects.
+INSERT INTO `revisions` (`film_id`, `number`, `editor_id`, `action`, `snapshot`, `created_at`)
+SELECT films.uuid, 1, films.creator_id, 'create', json_object(
+        'title', films.title,
+        'director', COALESCE(directors.name, ''),
+        'genres', json((SELECT json_group_array(name) FROM (
+            SELECT genres.name FROM film_genres JOIN genres ON genres.id = film_genres.genre_id
+            WHERE film_genres.film_uuid = films.uuid ORDER BY genres.name))),
+        'credits', json((SELECT json_group_array(json(credit)) FROM (
+            SELECT json_object(
+                'name', casts.name,
+                'character', COALESCE(credits.character, ''),
+                'department', credits.department,
+                'billing_order', credits.billing_order) AS credit
+            FROM credits JOIN casts ON casts.id = credits.cast_id
+            WHERE credits.film_id = films.uuid ORDER BY credits.billing_order, credits.id))),
+        'synopsis', films.synopsis,
+        'release_date', DATE(films.release_date)),
+    CAST(strftime('%s', 'now') AS INTEGER)
+FROM films
+LEFT JOIN directors ON directors.id = films.director_id
+WHERE NOT EXISTS (SELECT 1 FROM revisions WHERE revisions.film_id = films.uuid);
@@ -0,0 +1 @@
+ALTER TABLE `films` ADD COLUMN `version` integer NOT NULL DEFAULT 1;
@@ -0,0 +1 @@
+-- Rounded scores are kept, SQLite keeps the score as real in both versions.
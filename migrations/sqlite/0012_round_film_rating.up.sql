@@ -0,0 +1,2 @@
+-- The aggregate score is rounded to cents like in Postgres
+UPDATE `films` SET `rating` = ROUND(`rating`, 2);
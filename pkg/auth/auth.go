@@ -1,9 +1,15 @@
 package auth
 
 import (
+	"context"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"os"
@@ -20,11 +26,19 @@ var (
 	ErrGetPrivateKeyFile         = errors.New("error getting private key from file")
 	ErrParseRSAPrivateKeyFromPEM = errors.New("error parsing RSA private key from PEM")
 	ErrNewClaims                 = errors.New("error creating new claims")
+	ErrGenerateRefreshToken      = errors.New("error generating refresh token")
+	ErrCheckRevokedAuthToken     = errors.New("error checking if token is revoked")
+)
+
+const (
+	// refreshTokenLength is a length of random bytes of refresh token.
+	refreshTokenLength = 32
 )
 
 // Config is a struct for auth config.
 type Config struct {
 	AuthDurationMin    int64
+	RefreshDurationMin int64
 	PathPublicKeyFile  string
 	PathPrivateKeyFile string
 }
@@ -32,25 +46,48 @@ type Config struct {
 // JwtClaims is a struct for JWT auth.
 type JwtClaims struct {
 	UUID string `json:"uuid"`
+	Role string `json:"role"`
 	jwt.StandardClaims
 }
 
+// Denylist is an interface for storage of revoked tokens.
+type Denylist interface {
+	IsAccessTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+type OptFunc func(*Service)
+
+// WithDenylist sets a storage of revoked tokens.
+func WithDenylist(denylist Denylist) OptFunc {
+	return func(s *Service) {
+		s.denylist = denylist
+	}
+}
+
 // Service is a struct for Auth.
 type Service struct {
-	logger *zap.Logger
-	cfg    Config
+	logger   *zap.Logger
+	cfg      Config
+	denylist Denylist
 }
 
 // NewAuthService is a constructor for Service.
-func NewAuthService(cfg Config, logger *zap.Logger) *Service {
-	return &Service{
+func NewAuthService(cfg Config, logger *zap.Logger, opts ...OptFunc) *Service {
+	s := &Service{
 		cfg:    cfg,
 		logger: logger,
 	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // GenerateAuthToken is a function for generating auth token.
-func (a Service) GenerateAuthToken(uuid string) (string, time.Time, error) {
+func (a Service) GenerateAuthToken(userID string, role string) (string, time.Time, error) {
 	privateKeyBytes, err := getPrivateKeyFile(a.cfg.PathPrivateKeyFile)
 	if err != nil {
 		a.logger.Error("during getPrivateKeyFile", zap.Error(err))
@@ -71,8 +108,10 @@ func (a Service) GenerateAuthToken(uuid string) (string, time.Time, error) {
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: jwt.At(expirationTime),
 			IssuedAt:  jwt.At(time.Now()),
+			ID:        uuid.New().String(),
 		},
-		UUID: uuid,
+		UUID: userID,
+		Role: role,
 	}
 
 	a.logger.Debug("claims", zap.Any("claims", claims))
@@ -132,6 +171,45 @@ func (a Service) ParseAuthToken(accessToken string) (*JwtClaims, error) {
 	return claims, nil
 }
 
+// GenerateRefreshToken is a function for generating refresh token. It returns the token, its hash and expiration time.
+func (a Service) GenerateRefreshToken() (string, string, time.Time, error) {
+	tokenBytes := make([]byte, refreshTokenLength)
+
+	if _, err := rand.Read(tokenBytes); err != nil {
+		a.logger.Error("during rand.Read", zap.Error(err))
+
+		return "", "", time.Time{}, errors.Wrap(ErrGenerateRefreshToken, "authService.GenerateRefreshToken.rand.Read")
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	expirationTime := time.Now().Add(time.Duration(a.cfg.RefreshDurationMin) * time.Minute)
+
+	return token, a.HashRefreshToken(token), expirationTime, nil
+}
+
+// HashRefreshToken is a function for hashing refresh token before storing it.
+func (a Service) HashRefreshToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// IsTokenRevoked is a function for checking if token is in denylist.
+func (a Service) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	if a.denylist == nil {
+		return false, nil
+	}
+
+	revoked, err := a.denylist.IsAccessTokenRevoked(ctx, tokenID)
+	if err != nil {
+		a.logger.Error("during denylist.IsAccessTokenRevoked", zap.Error(err))
+
+		return false, errors.Wrap(ErrCheckRevokedAuthToken, "authService.IsTokenRevoked.IsAccessTokenRevoked")
+	}
+
+	return revoked, nil
+}
+
 // getPrivateKeyFile is a function for getting private key from file.
 func getPrivateKeyFile(pathPrivateKeyFile string) ([]byte, error) {
 	path, err := filepath.Abs(pathPrivateKeyFile)
@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// BackendLRU is a backend of cache in memory of the process.
+	BackendLRU = "lru"
+	// BackendRedis is a backend of cache in Redis.
+	BackendRedis = "redis"
+	// BackendNone disables cache.
+	BackendNone = "none"
+)
+
+var ErrNotFound = errors.New("key is not found in cache")
+
+// Cache is a storage of cached values with expiration.
+type Cache interface {
+	// Get returns a value of the key, ErrNotFound is returned for a missing or expired key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set stores a value of the key for the TTL.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Incr increments a counter of the key and returns its new value, counters never expire.
+	Incr(ctx context.Context, key string) (int64, error)
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultLRUSize is a default number of values kept by LRU cache.
+const DefaultLRUSize = 1000
+
+// lru is an in-process cache which evicts the least recently used values over the size.
+type lru struct {
+	mu       sync.Mutex
+	size     int
+	items    *list.List
+	keys     map[string]*list.Element
+	counters map[string]int64
+}
+
+// lruItem is a cached value in the list of LRU cache.
+type lruItem struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRU is a constructor of in-process LRU cache with a maximum number of values.
+func NewLRU(size int) Cache {
+	if size <= 0 {
+		size = DefaultLRUSize
+	}
+
+	return &lru{
+		size:     size,
+		items:    list.New(),
+		keys:     make(map[string]*list.Element),
+		counters: make(map[string]int64),
+	}
+}
+
+// Get returns a value of the key.
+func (c *lru) Get(_ context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Counters are kept out of the list, so they are never evicted
+	if counter, ok := c.counters[key]; ok {
+		return []byte(strconv.FormatInt(counter, 10)), nil
+	}
+
+	element, ok := c.keys[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	item := element.Value.(*lruItem)
+	if time.Now().After(item.expiresAt) {
+		c.remove(element)
+
+		return nil, ErrNotFound
+	}
+
+	c.items.MoveToFront(element)
+
+	return item.value, nil
+}
+
+// Set stores a value of the key for the TTL.
+func (c *lru) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+
+	if element, ok := c.keys[key]; ok {
+		item := element.Value.(*lruItem)
+		item.value = value
+		item.expiresAt = expiresAt
+		c.items.MoveToFront(element)
+
+		return nil
+	}
+
+	c.keys[key] = c.items.PushFront(&lruItem{key: key, value: value, expiresAt: expiresAt})
+
+	// Evict the least recently used values over the size
+	for c.items.Len() > c.size {
+		c.remove(c.items.Back())
+	}
+
+	return nil
+}
+
+// Incr increments a counter of the key.
+func (c *lru) Incr(_ context.Context, key string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counters[key]++
+
+	return c.counters[key], nil
+}
+
+// remove is a method to remove an element from the list and the keys.
+func (c *lru) remove(element *list.Element) {
+	c.items.Remove(element)
+	delete(c.keys, element.Value.(*lruItem).key)
+}
@@ -0,0 +1,73 @@
+package cache_test
+
+import (
+	"context"
+	"film-management/pkg/cache"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU_Evict(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := cache.NewLRU(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, c.Set(ctx, "b", []byte("2"), time.Minute))
+
+	// "a" is used recently, so "b" is evicted
+	_, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.NoError(t, c.Set(ctx, "c", []byte("3"), time.Minute))
+
+	_, err = c.Get(ctx, "b")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+
+	value, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	value, err = c.Get(ctx, "c")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("3"), value)
+}
+
+func TestLRU_Expire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := cache.NewLRU(2)
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+
+	_, err := c.Get(ctx, "a")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+}
+
+func TestLRU_Incr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := cache.NewLRU(1)
+
+	counter, err := c.Incr(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), counter)
+
+	counter, err = c.Incr(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), counter)
+
+	// Counters are not evicted by values
+	require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, c.Set(ctx, "b", []byte("2"), time.Minute))
+
+	value, err := c.Get(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	goredis "github.com/redis/go-redis/v9"
+	"time"
+)
+
+const (
+	// DefaultRedisPoolSize is a default number of connections kept to Redis.
+	DefaultRedisPoolSize = 10
+	// redisTimeout is a timeout of a command without a sooner deadline of context, a slow cache must not slow down requests.
+	redisTimeout = time.Second
+)
+
+// RedisConfig is a config of Redis connection.
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+	PoolSize int
+}
+
+// redis is a cache in Redis shared by all instances of the service.
+type redis struct {
+	client *goredis.Client
+}
+
+// NewRedis is a constructor of Redis cache, connections are opened on demand.
+func NewRedis(config RedisConfig) Cache {
+	if config.PoolSize <= 0 {
+		config.PoolSize = DefaultRedisPoolSize
+	}
+
+	return &redis{
+		client: goredis.NewClient(&goredis.Options{
+			Addr:         config.Addr,
+			Password:     config.Password,
+			DB:           config.DB,
+			PoolSize:     config.PoolSize,
+			DialTimeout:  redisTimeout,
+			ReadTimeout:  redisTimeout,
+			WriteTimeout: redisTimeout,
+			PoolTimeout:  redisTimeout,
+		}),
+	}
+}
+
+// Get returns a value of the key.
+func (c *redis) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrNotFound
+	}
+
+	return value, err
+}
+
+// Set stores a value of the key for the TTL.
+func (c *redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	// TTL below a millisecond would make the key persistent
+	if ttl < time.Millisecond {
+		ttl = time.Millisecond
+	}
+
+	return c.client.Set(ctx, key, value, ttl).Err()
+}
+
+// Incr increments a counter of the key.
+func (c *redis) Incr(ctx context.Context, key string) (int64, error) {
+	return c.client.Incr(ctx, key).Result()
+}
@@ -0,0 +1,94 @@
+package cache_test
+
+import (
+	"context"
+	"film-management/pkg/cache"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedis(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+
+	c := cache.NewRedis(cache.RedisConfig{Addr: server.Addr(), Password: "secret"})
+
+	_, err := c.Get(ctx, "film")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+
+	value := []byte("{\"title\":\"line\r\nbreak\"}")
+	require.NoError(t, c.Set(ctx, "film", value, time.Minute))
+
+	got, err := c.Get(ctx, "film")
+	require.NoError(t, err)
+	assert.Equal(t, value, got)
+	assert.Equal(t, time.Minute, server.TTL("film"))
+
+	counter, err := c.Incr(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), counter)
+
+	counter, err = c.Incr(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), counter)
+	assert.Zero(t, server.TTL("gen"))
+
+	_, err = c.Incr(ctx, "film")
+	assert.Error(t, err)
+
+	// Values expire by TTL
+	server.FastForward(time.Minute)
+
+	_, err = c.Get(ctx, "film")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+}
+
+func TestRedis_DB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	server := miniredis.RunT(t)
+
+	c := cache.NewRedis(cache.RedisConfig{Addr: server.Addr(), DB: 2})
+	require.NoError(t, c.Set(ctx, "film", []byte("value"), time.Minute))
+
+	got, err := server.DB(2).Get("film")
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+	assert.False(t, server.Exists("film"))
+}
+
+func TestRedis_Auth(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+
+	c := cache.NewRedis(cache.RedisConfig{Addr: server.Addr(), Password: "wrong"})
+
+	_, err := c.Get(context.TODO(), "film")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, cache.ErrNotFound)
+}
+
+func TestRedis_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	addr := server.Addr()
+	server.Close()
+
+	c := cache.NewRedis(cache.RedisConfig{Addr: addr})
+
+	_, err := c.Get(context.TODO(), "film")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, cache.ErrNotFound)
+}
@@ -0,0 +1,56 @@
+// Package database connects to the SQL database of the storage driver and hides differences of SQL dialects.
+package database
+
+import (
+	"film-management/pkg/database/postgresql"
+	"film-management/pkg/database/sqlite"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ErrUnknownDriver is an error of a storage driver which is not a SQL database.
+var ErrUnknownDriver = errors.New("unknown database driver")
+
+// Config is a config of SQL databases of the storage, the driver selects one of them.
+type Config struct {
+	Driver   string
+	Postgres postgresql.Config
+	SQLite   sqlite.Config
+}
+
+// Connect is a function to connect to the database of the driver.
+func Connect(config *Config, logger *zap.Logger) (*gorm.DB, error) {
+	switch config.Driver {
+	case postgresql.Driver:
+		return postgresql.Connect(&config.Postgres, logger)
+	case sqlite.Driver:
+		return sqlite.Connect(&config.SQLite, logger)
+	default:
+		return nil, errors.Wrap(ErrUnknownDriver, config.Driver)
+	}
+}
+
+// IsSQLite is a function to check if the database is SQLite.
+func IsSQLite(db *gorm.DB) bool {
+	return db.Dialector.Name() == sqlite.Driver
+}
+
+// Date is a function to get the SQL expression of the date of the column.
+// SQLite keeps dates as text with time of day, so they are compared with dates as text by the date part only.
+func Date(db *gorm.DB, column string) string {
+	if IsSQLite(db) {
+		return "DATE(" + column + ")"
+	}
+
+	return column
+}
+
+// Year is a function to get the SQL expression of the year of the date column as integer.
+func Year(db *gorm.DB, column string) string {
+	if IsSQLite(db) {
+		return "CAST(strftime('%Y', " + column + ") AS INTEGER)"
+	}
+
+	return "EXTRACT(YEAR FROM " + column + ")::int"
+}
@@ -0,0 +1,177 @@
+// Package migrator applies and rolls back numbered SQL migrations and records applied versions in the database.
+package migrator
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidMigration    = errors.New("invalid migration file name")
+	ErrDuplicateMigration  = errors.New("duplicate migration version")
+	ErrIncompleteMigration = errors.New("migration has no up or down file")
+	ErrInvalidName         = errors.New("invalid migration name")
+	ErrNoDirectories       = errors.New("no directories of migrations")
+)
+
+// fileNamePattern is a pattern of migration file names: <version>_<name>.up.sql or <version>_<name>.down.sql.
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// notNamePattern is a pattern of characters which are replaced with "_" in names of new migrations.
+var notNamePattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Migration is a numbered change of the schema with SQL to apply and to roll back it.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load is a function to get migrations of the directory of the file system in order of versions.
+// Every version must have both up and down files.
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "migrator.Load.ReadDir")
+	}
+
+	var (
+		migrations = make(map[int64]*Migration)
+		files      = make(map[int64]int)
+	)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		version, name, direction, errParse := parseFileName(entry.Name())
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		content, errRead := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if errRead != nil {
+			return nil, errors.Wrap(errRead, "migrator.Load.ReadFile")
+		}
+
+		migration, ok := migrations[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			migrations[version] = migration
+		}
+
+		if migration.Name != name {
+			return nil, errors.Wrapf(ErrDuplicateMigration, "%d", version)
+		}
+
+		files[version]++
+
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(migrations))
+
+	for version, migration := range migrations {
+		if files[version] != 2 {
+			return nil, errors.Wrapf(ErrIncompleteMigration, "%d_%s", migration.Version, migration.Name)
+		}
+
+		result = append(result, *migration)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
+
+// parseFileName is a function to get version, name and direction of the migration file.
+func parseFileName(fileName string) (int64, string, string, error) {
+	matches := fileNamePattern.FindStringSubmatch(fileName)
+	if matches == nil {
+		return 0, "", "", errors.Wrap(ErrInvalidMigration, fileName)
+	}
+
+	version, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, "", "", errors.Wrap(ErrInvalidMigration, fileName)
+	}
+
+	return version, matches[2], matches[3], nil
+}
+
+// fileName is a function to get name of the file of the migration in the direction.
+func fileName(version int64, name string, direction string) string {
+	return fmt.Sprintf("%04d_%s.%s.sql", version, name, direction)
+}
+
+// Create is a function to add empty up and down files of a new migration to every directory of migrations in dir.
+// The version is next to the last version of all directories, so it is the same for every driver.
+func Create(dir string, name string) ([]string, error) {
+	name = strings.Trim(notNamePattern.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "migrator.Create.ReadDir")
+	}
+
+	var (
+		dirs    []string
+		version int64
+	)
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		migrations, errLoad := Load(os.DirFS(dir), entry.Name())
+		if errLoad != nil {
+			return nil, errLoad
+		}
+
+		if len(migrations) > 0 && migrations[len(migrations)-1].Version > version {
+			version = migrations[len(migrations)-1].Version
+		}
+
+		dirs = append(dirs, filepath.Join(dir, entry.Name()))
+	}
+
+	if len(dirs) == 0 {
+		return nil, errors.Wrap(ErrNoDirectories, dir)
+	}
+
+	version++
+	files := make([]string, 0, len(dirs)*2)
+
+	for _, migrationDir := range dirs {
+		for _, direction := range []string{"up", "down"} {
+			file := filepath.Join(migrationDir, fileName(version, name, direction))
+			content := fmt.Sprintf("-- Migration %s %s for %s\n", name, direction, filepath.Base(migrationDir))
+
+			if errWrite := os.WriteFile(file, []byte(content), 0o644); errWrite != nil {
+				return files, errors.Wrap(errWrite, "migrator.Create.WriteFile")
+			}
+
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
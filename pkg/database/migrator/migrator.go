@@ -0,0 +1,279 @@
+package migrator
+
+import (
+	"context"
+	"film-management/pkg/database"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSteps     = errors.New("number of migrations to roll back must be positive")
+	ErrUnknownMigration = errors.New("applied migration has no files")
+)
+
+// tableName is a name of the table of applied migrations.
+const tableName = "schema_migrations"
+
+// lockKey is a key of the Postgres advisory lock held while migrations run, so concurrent runs wait for each other.
+const lockKey = 4126091374
+
+const (
+	createPostgresTableQuery = `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+	version bigint PRIMARY KEY,
+	name varchar(255) NOT NULL,
+	applied_at timestamptz NOT NULL
+)`
+	createSQLiteTableQuery = `CREATE TABLE IF NOT EXISTS ` + tableName + ` (
+	version integer PRIMARY KEY,
+	name text NOT NULL,
+	applied_at datetime NOT NULL
+)`
+)
+
+// schemaMigration is a row of an applied migration.
+type schemaMigration struct {
+	Version   int64 `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// TableName is a method to get the table of applied migrations.
+func (schemaMigration) TableName() string {
+	return tableName
+}
+
+// Status is a state of a migration in the database.
+// Applied migrations without files, e.g. made by a newer release, are also in status with Missing.
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	Missing   bool
+}
+
+// Migrator is a struct to apply and roll back migrations of the database.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+	logger     *zap.Logger
+}
+
+// NewMigrator is a constructor for Migrator of migrations in order of versions.
+func NewMigrator(db *gorm.DB, migrations []Migration, logger *zap.Logger) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     logger,
+	}
+}
+
+// Up is a method to apply all migrations which are not applied in order of versions, it returns the number of applied ones.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		for _, migration := range m.migrations {
+			applied, err := m.apply(conn, migration)
+			if err != nil {
+				return errors.Wrapf(err, "migration %d_%s", migration.Version, migration.Name)
+			}
+
+			if applied {
+				count++
+			}
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// Down is a method to roll back the last n applied migrations in reverse order of versions, it returns the number of rolled back ones.
+func (m *Migrator) Down(ctx context.Context, n int) (int, error) {
+	if n < 1 {
+		return 0, ErrInvalidSteps
+	}
+
+	count := 0
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		var applied []schemaMigration
+
+		if err := conn.Order("version DESC").Limit(n).Find(&applied).Error; err != nil {
+			return errors.Wrap(err, "migrator.Down.Find")
+		}
+
+		for _, row := range applied {
+			migration, ok := m.find(row.Version)
+			if !ok {
+				return errors.Wrapf(ErrUnknownMigration, "%d_%s", row.Version, row.Name)
+			}
+
+			rolledBack, err := m.rollback(conn, migration)
+			if err != nil {
+				return errors.Wrapf(err, "migration %d_%s", migration.Version, migration.Name)
+			}
+
+			if rolledBack {
+				count++
+			}
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// Status is a method to get states of all migrations in order of versions.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		var applied []schemaMigration
+
+		if err := conn.Order("version ASC").Find(&applied).Error; err != nil {
+			return errors.Wrap(err, "migrator.Status.Find")
+		}
+
+		appliedByVersion := make(map[int64]schemaMigration, len(applied))
+		for _, row := range applied {
+			appliedByVersion[row.Version] = row
+		}
+
+		statuses = make([]Status, 0, len(m.migrations))
+
+		for _, migration := range m.migrations {
+			status := Status{Version: migration.Version, Name: migration.Name}
+
+			if row, ok := appliedByVersion[migration.Version]; ok {
+				status.Applied = true
+				status.AppliedAt = row.AppliedAt
+			}
+
+			statuses = append(statuses, status)
+		}
+
+		for _, row := range applied {
+			if _, ok := m.find(row.Version); !ok {
+				statuses = append(statuses, Status{Version: row.Version, Name: row.Name, Applied: true, AppliedAt: row.AppliedAt, Missing: true})
+			}
+		}
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// withLock is a method to run the function on one connection of the database with the table of applied migrations.
+// Postgres runs hold an advisory lock of the connection, SQLite transactions take the write lock at start already.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		createTableQuery := createSQLiteTableQuery
+
+		if !database.IsSQLite(conn) {
+			createTableQuery = createPostgresTableQuery
+
+			m.logger.Debug("Wait for migration lock")
+
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", lockKey).Error; err != nil {
+				return errors.Wrap(err, "migrator.withLock.Lock")
+			}
+
+			defer func() {
+				if err := conn.Exec("SELECT pg_advisory_unlock(?)", lockKey).Error; err != nil {
+					m.logger.Error("Error unlock migrations", zap.Error(err))
+				}
+			}()
+		}
+
+		if err := conn.Exec(createTableQuery).Error; err != nil {
+			return errors.Wrap(err, "migrator.withLock.CreateTable")
+		}
+
+		return fn(conn)
+	})
+}
+
+// apply is a method to apply the migration in a transaction if it is not applied.
+func (m *Migrator) apply(conn *gorm.DB, migration Migration) (bool, error) {
+	applied := false
+
+	err := conn.Transaction(func(tx *gorm.DB) error {
+		// Another run could apply the migration before the lock was taken
+		var count int64
+		if err := tx.Model(&schemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return nil
+		}
+
+		m.logger.Info("Apply migration", zap.Int64("version", migration.Version), zap.String("name", migration.Name))
+
+		if err := execute(tx, migration.Up); err != nil {
+			return err
+		}
+
+		applied = true
+
+		return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now().UTC()}).Error
+	})
+
+	return applied, err
+}
+
+// rollback is a method to roll back the migration in a transaction if it is applied.
+func (m *Migrator) rollback(conn *gorm.DB, migration Migration) (bool, error) {
+	rolledBack := false
+
+	err := conn.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("version = ?", migration.Version).Delete(&schemaMigration{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		m.logger.Info("Roll back migration", zap.Int64("version", migration.Version), zap.String("name", migration.Name))
+
+		rolledBack = true
+
+		return execute(tx, migration.Down)
+	})
+
+	return rolledBack, err
+}
+
+// find is a method to get the migration of the version.
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+
+	return Migration{}, false
+}
+
+// execute is a function to run SQL of a migration with many statements in the transaction.
+// The SQL goes to the driver as it is, gorm would take "?" and "@" in it for parameters.
+func execute(tx *gorm.DB, sql string) error {
+	if strings.TrimSpace(sql) == "" {
+		return nil
+	}
+
+	_, err := tx.Statement.ConnPool.ExecContext(tx.Statement.Context, sql)
+
+	return err
+}
@@ -0,0 +1,200 @@
+package migrator_test
+
+import (
+	"context"
+	"film-management/pkg/database"
+	"film-management/pkg/database/migrator"
+	"film-management/pkg/database/sqlite"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var testMigrations = fstest.MapFS{
+	"sqlite/0002_add_genres.up.sql":   {Data: []byte("CREATE TABLE genres (id integer PRIMARY KEY, name text NOT NULL);")},
+	"sqlite/0002_add_genres.down.sql": {Data: []byte("DROP TABLE genres;")},
+	"sqlite/0001_init.up.sql": {Data: []byte(`CREATE TABLE films (id integer PRIMARY KEY, title text NOT NULL);
+		INSERT INTO films (title) VALUES ('Film ?');`)},
+	"sqlite/0001_init.down.sql": {Data: []byte("DROP TABLE films;")},
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := database.Connect(&database.Config{
+		Driver: sqlite.Driver,
+		SQLite: sqlite.Config{Path: filepath.Join(t.TempDir(), "migrator.db")},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if sqlDB, errDB := db.DB(); errDB == nil {
+			sqlDB.Close()
+		}
+	})
+
+	return db
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Migrations in order of versions", func(t *testing.T) {
+		migrations, err := migrator.Load(testMigrations, "sqlite")
+		require.NoError(t, err)
+		require.Len(t, migrations, 2)
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "init", migrations[0].Name)
+		assert.Equal(t, "DROP TABLE films;", migrations[0].Down)
+		assert.Equal(t, int64(2), migrations[1].Version)
+		assert.Equal(t, "add_genres", migrations[1].Name)
+	})
+
+	t.Run("Migration without down file", func(t *testing.T) {
+		_, err := migrator.Load(fstest.MapFS{"sqlite/0001_init.up.sql": {Data: []byte("SELECT 1;")}}, "sqlite")
+		assert.ErrorIs(t, err, migrator.ErrIncompleteMigration)
+	})
+
+	t.Run("Invalid file name", func(t *testing.T) {
+		_, err := migrator.Load(fstest.MapFS{"sqlite/init.sql": {Data: []byte("SELECT 1;")}}, "sqlite")
+		assert.ErrorIs(t, err, migrator.ErrInvalidMigration)
+	})
+
+	t.Run("Two names of a version", func(t *testing.T) {
+		_, err := migrator.Load(fstest.MapFS{
+			"sqlite/0001_init.up.sql":    {Data: []byte("SELECT 1;")},
+			"sqlite/0001_other.down.sql": {Data: []byte("SELECT 1;")},
+		}, "sqlite")
+		assert.ErrorIs(t, err, migrator.ErrDuplicateMigration)
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	migrations, err := migrator.Load(testMigrations, "sqlite")
+	require.NoError(t, err)
+
+	m := migrator.NewMigrator(db, migrations, zap.NewNop())
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.False(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+
+	count, err := m.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.True(t, db.Migrator().HasTable("genres"))
+
+	// SQL goes to the database as it is, "?" is not a parameter
+	var title string
+	require.NoError(t, db.Raw("SELECT title FROM films").Scan(&title).Error)
+	assert.Equal(t, "Film ?", title)
+
+	count, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.True(t, statuses[1].Applied)
+	assert.False(t, statuses[1].AppliedAt.IsZero())
+
+	count, err = m.Down(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.False(t, db.Migrator().HasTable("genres"))
+	assert.True(t, db.Migrator().HasTable("films"))
+
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+
+	_, err = m.Down(ctx, 0)
+	assert.ErrorIs(t, err, migrator.ErrInvalidSteps)
+
+	count, err = m.Down(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.False(t, db.Migrator().HasTable("films"))
+}
+
+func TestMigratorFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	m := migrator.NewMigrator(db, []migrator.Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE films (id integer PRIMARY KEY);", Down: "DROP TABLE films;"},
+		{Version: 2, Name: "broken", Up: "CREATE TABLE genres (id integer PRIMARY KEY); INSERT INTO missing VALUES (1);", Down: "DROP TABLE genres;"},
+	}, zap.NewNop())
+
+	_, err := m.Up(ctx)
+	require.Error(t, err)
+
+	// The failed migration is rolled back as a whole
+	assert.True(t, db.Migrator().HasTable("films"))
+	assert.False(t, db.Migrator().HasTable("genres"))
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+}
+
+func TestMigratorUnknownMigration(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	_, err := migrator.NewMigrator(db, []migrator.Migration{
+		{Version: 1, Name: "init", Up: "CREATE TABLE films (id integer PRIMARY KEY);", Down: "DROP TABLE films;"},
+	}, zap.NewNop()).Up(ctx)
+	require.NoError(t, err)
+
+	// Migrator of an older release has no files of the applied migration
+	older := migrator.NewMigrator(db, nil, zap.NewNop())
+
+	statuses, err := older.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.True(t, statuses[0].Missing)
+
+	_, err = older.Down(ctx, 1)
+	assert.ErrorIs(t, err, migrator.ErrUnknownMigration)
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, driver := range []string{"postgres", "sqlite"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, driver), 0o755))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sqlite", "0003_init.up.sql"), []byte("SELECT 1;"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sqlite", "0003_init.down.sql"), []byte("SELECT 1;"), 0o644))
+
+	files, err := migrator.Create(dir, "Add film Budget")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "postgres", "0004_add_film_budget.up.sql"),
+		filepath.Join(dir, "postgres", "0004_add_film_budget.down.sql"),
+		filepath.Join(dir, "sqlite", "0004_add_film_budget.up.sql"),
+		filepath.Join(dir, "sqlite", "0004_add_film_budget.down.sql"),
+	}, files)
+
+	migrations, err := migrator.Load(os.DirFS(dir), "postgres")
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	assert.Equal(t, int64(4), migrations[0].Version)
+
+	_, err = migrator.Create(dir, "--")
+	assert.ErrorIs(t, err, migrator.ErrInvalidName)
+}
@@ -11,6 +11,9 @@ import (
 	"time"
 )
 
+// Driver is a name of the postgres storage in config.
+const Driver = "postgres"
+
 // Connect to postgres database.
 func Connect(config *Config, logger *zap.Logger) (*gorm.DB, error) {
 	dbURL := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s",
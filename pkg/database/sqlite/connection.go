@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"film-management/pkg/database/postgresql"
+	"fmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	gormSQLite "gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// Driver is a name of the sqlite storage in config, it is also the name of the gorm dialect.
+const Driver = "sqlite"
+
+// busyTimeoutMs is how long a writer waits for another writer before the database is locked error.
+const busyTimeoutMs = 5000
+
+// Connect to sqlite database.
+func Connect(config *Config, logger *zap.Logger) (*gorm.DB, error) {
+	// Foreign keys are off in SQLite by default, transactions take the write lock at start,
+	// so concurrent writers wait for each other instead of failing
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=%d&_txlock=immediate", config.Path, busyTimeoutMs)
+	logger.Debug("sqlite db dsn", zap.String("dsn", dsn))
+
+	db, err := gorm.Open(gormSQLite.Open(dsn), &gorm.Config{
+		Logger: postgresql.NewGormLogger(logger),
+	})
+
+	if err != nil {
+		logger.Error("failed to connect to database", zap.Error(err))
+
+		return nil, errors.Wrap(err, "failed to connect to database")
+	}
+
+	logger.Info("Connected to sqlite database", zap.String("path", config.Path))
+
+	return db, nil
+}
+
+type Config struct {
+	Path string
+}
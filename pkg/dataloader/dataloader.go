@@ -0,0 +1,159 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrBatchPanic is an error of keys of a batch when the batch function panics.
+var ErrBatchPanic = errors.New("batch function panicked")
+
+const (
+	// defaultWait is a default time to collect keys of a batch.
+	defaultWait = 2 * time.Millisecond
+	// defaultMaxBatch is a default maximum number of keys in a batch.
+	defaultMaxBatch = 100
+)
+
+// BatchFunc is a function to load values of all the keys at once, keys without a value are missing in the map.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader is a loader which collects keys requested at the same time and loads them by one call of the batch function.
+// Loaded values are kept by the loader, so a loader is created for every request.
+type Loader[K comparable, V any] struct {
+	batchFn  BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	current *batch[K, V]
+	batches map[K]*batch[K, V]
+}
+
+// batch is a set of keys which are loaded together.
+type batch[K comparable, V any] struct {
+	keys    []K
+	once    sync.Once
+	done    chan struct{}
+	results map[K]V
+	err     error
+}
+
+// OptFunc is a function to set options of a loader.
+type OptFunc func(*options)
+
+// options is a set of options of a loader.
+type options struct {
+	wait     time.Duration
+	maxBatch int
+}
+
+// WithWait is a function to set how long keys of a batch are collected.
+func WithWait(wait time.Duration) OptFunc {
+	return func(o *options) {
+		o.wait = wait
+	}
+}
+
+// WithMaxBatch is a function to set a maximum number of keys in a batch, a full batch is loaded without waiting.
+func WithMaxBatch(maxBatch int) OptFunc {
+	return func(o *options) {
+		o.maxBatch = maxBatch
+	}
+}
+
+// New is a function to create a loader with the batch function.
+func New[K comparable, V any](batchFn BatchFunc[K, V], opts ...OptFunc) *Loader[K, V] {
+	o := options{
+		wait:     defaultWait,
+		maxBatch: defaultMaxBatch,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Loader[K, V]{
+		batchFn:  batchFn,
+		wait:     o.wait,
+		maxBatch: o.maxBatch,
+		batches:  make(map[K]*batch[K, V]),
+	}
+}
+
+// Load is a method to load a value of the key, it waits for the batch with the key.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+
+	b, ok := l.batches[key]
+	if !ok {
+		b = l.addKey(ctx, key)
+	}
+
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		var zero V
+
+		return zero, ctx.Err()
+	}
+
+	if b.err != nil {
+		var zero V
+
+		return zero, b.err
+	}
+
+	return b.results[key], nil
+}
+
+// addKey is a method to add the key to the current batch, the caller holds the lock.
+func (l *Loader[K, V]) addKey(ctx context.Context, key K) *batch[K, V] {
+	if l.current == nil {
+		b := &batch[K, V]{done: make(chan struct{})}
+		l.current = b
+
+		time.AfterFunc(l.wait, func() {
+			l.mu.Lock()
+			if l.current == b {
+				l.current = nil
+			}
+			l.mu.Unlock()
+
+			l.dispatch(ctx, b)
+		})
+	}
+
+	b := l.current
+	b.keys = append(b.keys, key)
+	l.batches[key] = b
+
+	// A full batch is loaded at once, next keys are collected in a new batch
+	if len(b.keys) >= l.maxBatch {
+		l.current = nil
+
+		go l.dispatch(ctx, b)
+	}
+
+	return b
+}
+
+// dispatch is a method to load the batch, the batch is loaded once.
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *batch[K, V]) {
+	b.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				b.err = fmt.Errorf("%w: %v", ErrBatchPanic, r)
+			}
+
+			close(b.done)
+		}()
+
+		b.results, b.err = l.batchFn(ctx, b.keys)
+	})
+}
@@ -0,0 +1,132 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"film-management/pkg/dataloader"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// double is a batch function which doubles the keys and counts calls.
+func double(calls *int32, sizes *[]int, mu *sync.Mutex) dataloader.BatchFunc[int, int] {
+	return func(_ context.Context, keys []int) (map[int]int, error) {
+		atomic.AddInt32(calls, 1)
+
+		mu.Lock()
+		*sizes = append(*sizes, len(keys))
+		mu.Unlock()
+
+		values := make(map[int]int, len(keys))
+		for _, key := range keys {
+			values[key] = key * 2
+		}
+
+		return values, nil
+	}
+}
+
+// loadAll is a function to load the keys concurrently.
+func loadAll(t *testing.T, loader *dataloader.Loader[int, int], keys []int) []int {
+	t.Helper()
+
+	values := make([]int, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+
+		go func(i, key int) {
+			defer wg.Done()
+
+			value, err := loader.Load(context.TODO(), key)
+			assert.NoError(t, err)
+
+			values[i] = value
+		}(i, key)
+	}
+
+	wg.Wait()
+
+	return values
+}
+
+func TestLoader_Batch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls int32
+		sizes []int
+		mu    sync.Mutex
+	)
+
+	loader := dataloader.New(double(&calls, &sizes, &mu), dataloader.WithWait(20*time.Millisecond))
+
+	values := loadAll(t, loader, []int{1, 2, 3, 2, 1})
+	assert.Equal(t, []int{2, 4, 6, 4, 2}, values)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, []int{3}, sizes)
+
+	// Loaded keys are not loaded again
+	value, err := loader.Load(context.TODO(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, 6, value)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestLoader_MaxBatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls int32
+		sizes []int
+		mu    sync.Mutex
+	)
+
+	loader := dataloader.New(double(&calls, &sizes, &mu), dataloader.WithWait(20*time.Millisecond), dataloader.WithMaxBatch(2))
+
+	values := loadAll(t, loader, []int{1, 2, 3, 4, 5})
+	assert.Equal(t, []int{2, 4, 6, 8, 10}, values)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.ElementsMatch(t, []int{2, 2, 1}, sizes)
+}
+
+func TestLoader_Error(t *testing.T) {
+	t.Parallel()
+
+	errLoad := errors.New("load failed")
+	loader := dataloader.New(func(_ context.Context, _ []string) (map[string]int, error) {
+		return nil, errLoad
+	})
+
+	_, err := loader.Load(context.TODO(), "a")
+	assert.ErrorIs(t, err, errLoad)
+}
+
+func TestLoader_Panic(t *testing.T) {
+	t.Parallel()
+
+	loader := dataloader.New(func(_ context.Context, _ []string) (map[string]int, error) {
+		panic("boom")
+	})
+
+	_, err := loader.Load(context.TODO(), "a")
+	assert.ErrorIs(t, err, dataloader.ErrBatchPanic)
+}
+
+func TestLoader_Missing(t *testing.T) {
+	t.Parallel()
+
+	loader := dataloader.New(func(_ context.Context, _ []string) (map[string]int, error) {
+		return map[string]int{}, nil
+	})
+
+	value, err := loader.Load(context.TODO(), "a")
+	require.NoError(t, err)
+	assert.Zero(t, value)
+}
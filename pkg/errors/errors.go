@@ -54,3 +54,30 @@ type PermissionError struct {
 func (e PermissionError) Error() string {
 	return e.Err.Error()
 }
+
+// PreconditionFailedError implements the Error interface.
+type PreconditionFailedError struct {
+	Err error
+}
+
+func (e PreconditionFailedError) Error() string {
+	return e.Err.Error()
+}
+
+// UnavailableError implements the Error interface.
+type UnavailableError struct {
+	Err error
+}
+
+func (e UnavailableError) Error() string {
+	return e.Err.Error()
+}
+
+// PreconditionRequiredError implements the Error interface.
+type PreconditionRequiredError struct {
+	Err error
+}
+
+func (e PreconditionRequiredError) Error() string {
+	return e.Err.Error()
+}
@@ -0,0 +1,213 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	jsoniter "github.com/json-iterator/go"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// FormatCSV is a format of export with a header and a row per line, lists are separated by ListSeparator.
+	FormatCSV = "csv"
+	// FormatJSONL is a format of export with a JSON object per line.
+	FormatJSONL = "jsonl"
+	// FormatXLSX is a format of export as an Excel workbook with one sheet.
+	FormatXLSX = "xlsx"
+
+	// ContentTypeCSV is a content type of CSV export.
+	ContentTypeCSV = "text/csv"
+	// ContentTypeJSONL is a content type of JSON Lines export.
+	ContentTypeJSONL = "application/x-ndjson"
+	// ContentTypeXLSX is a content type of Excel workbook export.
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// ListSeparator is a separator of list values in CSV and XLSX export.
+	ListSeparator = "|"
+)
+
+var ErrFormat = errors.New("unknown export format")
+
+// jsonAPI is a JSON config of JSON Lines export, text is kept as is without escaping of HTML.
+var jsonAPI = jsoniter.Config{EscapeHTML: false, SortMapKeys: true}.Froze()
+
+// Formats is a list of all export formats.
+var Formats = []string{FormatCSV, FormatJSONL, FormatXLSX}
+
+// contentTypes is a map of export formats to content types.
+var contentTypes = map[string]string{
+	FormatCSV:   ContentTypeCSV,
+	FormatJSONL: ContentTypeJSONL,
+	FormatXLSX:  ContentTypeXLSX,
+}
+
+// Writer writes rows of export one by one, values of a row are in order of the columns.
+// Supported values are string, []string, int, int64, float64 and nil.
+type Writer interface {
+	Write(values []interface{}) error
+	// Close writes the end of export, it does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter is a constructor for Writer of the format with the columns.
+func NewWriter(format string, w io.Writer, columns []string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, columns)
+	case FormatJSONL:
+		return newJSONLWriter(w, columns), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, columns)
+	default:
+		return nil, ErrFormat
+	}
+}
+
+// ContentType returns content type of the format.
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+// FormatFromAccept returns the first format acceptable by the Accept header.
+// Empty header or any type is CSV, false is returned if no format is acceptable.
+func FormatFromAccept(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return FormatCSV, true
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case "*/*", "text/*":
+			return FormatCSV, true
+		case "application/jsonl", "application/x-jsonlines":
+			return FormatJSONL, true
+		}
+
+		for format, contentType := range contentTypes {
+			if mediaType == contentType {
+				return format, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// csvWriter is a Writer of CSV export.
+type csvWriter struct {
+	writer *csv.Writer
+	record []string
+}
+
+// newCSVWriter is a constructor for csvWriter, the header is written at once.
+func newCSVWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{writer: writer, record: make([]string, len(columns))}, nil
+}
+
+// Write writes a row of values.
+func (c *csvWriter) Write(values []interface{}) error {
+	for i := range c.record {
+		c.record[i] = ""
+		if i < len(values) {
+			c.record[i] = formatValue(values[i])
+		}
+	}
+
+	return c.writer.Write(c.record)
+}
+
+// Close flushes the rows.
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+// jsonlWriter is a Writer of JSON Lines export.
+type jsonlWriter struct {
+	w       io.Writer
+	columns [][]byte
+	buf     bytes.Buffer
+}
+
+// newJSONLWriter is a constructor for jsonlWriter.
+func newJSONLWriter(w io.Writer, columns []string) *jsonlWriter {
+	keys := make([][]byte, len(columns))
+	for i, column := range columns {
+		keys[i], _ = jsonAPI.Marshal(column)
+	}
+
+	return &jsonlWriter{w: w, columns: keys}
+}
+
+// Write writes a row of values as an object with keys in order of the columns.
+func (j *jsonlWriter) Write(values []interface{}) error {
+	j.buf.Reset()
+	j.buf.WriteByte('{')
+
+	for i, key := range j.columns {
+		if i > 0 {
+			j.buf.WriteByte(',')
+		}
+
+		var value interface{}
+		if i < len(values) {
+			value = values[i]
+		}
+
+		data, err := jsonAPI.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		j.buf.Write(key)
+		j.buf.WriteByte(':')
+		j.buf.Write(data)
+	}
+
+	j.buf.WriteString("}\n")
+
+	_, err := j.w.Write(j.buf.Bytes())
+
+	return err
+}
+
+// Close does nothing as every row is written at once.
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+// formatValue is a function to format a value as text.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ListSeparator)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
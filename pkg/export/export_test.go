@@ -0,0 +1,127 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"film-management/pkg/export"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	columns = []string{"title", "genres", "rating"}
+	rows    = [][]interface{}{
+		{"Garry Potter", []string{"drama", "fantasy"}, 7.5},
+		{`Say "Hi", <World> & co`, []string{}, int64(0)},
+	}
+)
+
+// writeRows is a function to write rows in the format.
+func writeRows(t *testing.T, format string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	writer, err := export.NewWriter(format, &buf, columns)
+	require.NoError(t, err)
+
+	for _, row := range rows {
+		require.NoError(t, writer.Write(row))
+	}
+
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func TestCSVWriter(t *testing.T) {
+	t.Parallel()
+
+	expected := "title,genres,rating\n" +
+		"Garry Potter,drama|fantasy,7.5\n" +
+		"\"Say \"\"Hi\"\", <World> & co\",,0\n"
+
+	assert.Equal(t, expected, string(writeRows(t, export.FormatCSV)))
+}
+
+func TestJSONLWriter(t *testing.T) {
+	t.Parallel()
+
+	expected := `{"title":"Garry Potter","genres":["drama","fantasy"],"rating":7.5}` + "\n" +
+		`{"title":"Say \"Hi\", <World> & co","genres":[],"rating":0}` + "\n"
+
+	assert.Equal(t, expected, string(writeRows(t, export.FormatJSONL)))
+}
+
+func TestXLSXWriter(t *testing.T) {
+	t.Parallel()
+
+	data := writeRows(t, export.FormatXLSX)
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string]string, len(reader.File))
+
+	for _, file := range reader.File {
+		rc, errOpen := file.Open()
+		require.NoError(t, errOpen)
+
+		content, errRead := io.ReadAll(rc)
+		require.NoError(t, errRead)
+		require.NoError(t, rc.Close())
+
+		files[file.Name] = string(content)
+	}
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files, "xl/workbook.xml")
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">title</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2" t="inlineStr"><is><t xml:space="preserve">drama|fantasy</t></is></c><c r="C2"><v>7.5</v></c></row>`)
+	assert.Contains(t, sheet, `Say &#34;Hi&#34;, &lt;World&gt; &amp; co`)
+	assert.Contains(t, sheet, `</sheetData></worksheet>`)
+}
+
+func TestNewWriterUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := export.NewWriter("pdf", io.Discard, columns)
+
+	assert.ErrorIs(t, err, export.ErrFormat)
+}
+
+func TestFormatFromAccept(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		accept   string
+		expected string
+		ok       bool
+	}{
+		{name: "Empty", accept: "", expected: export.FormatCSV, ok: true},
+		{name: "Any", accept: "*/*", expected: export.FormatCSV, ok: true},
+		{name: "CSV", accept: "text/csv; charset=utf-8", expected: export.FormatCSV, ok: true},
+		{name: "JSONL", accept: "application/x-ndjson", expected: export.FormatJSONL, ok: true},
+		{name: "XLSX", accept: export.ContentTypeXLSX, expected: export.FormatXLSX, ok: true},
+		{name: "First acceptable", accept: "application/pdf, application/x-ndjson, text/csv", expected: export.FormatJSONL, ok: true},
+		{name: "Not acceptable", accept: "application/pdf", expected: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, ok := export.FormatFromAccept(tc.accept)
+
+			assert.Equal(t, tc.expected, format)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+	jsoniter "github.com/json-iterator/go"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ContentTypeMergePatch is a content type of JSON Merge Patch (RFC 7386).
+	ContentTypeMergePatch = "application/merge-patch+json"
+	// ContentTypeJSONPatch is a content type of JSON Patch (RFC 6902).
+	ContentTypeJSONPatch = "application/json-patch+json"
+)
+
+var (
+	ErrPatchWrong       = errors.New("patch is not valid json")
+	ErrPatchContentType = errors.New("unknown patch content type")
+	ErrPatchOperation   = errors.New("unknown patch operation")
+	ErrPatchPath        = errors.New("patch path does not exist")
+	ErrPatchValue       = errors.New("patch value is missing")
+	ErrPatchTest        = errors.New("patch test failed")
+)
+
+// Operation is an operation of JSON Patch.
+type Operation struct {
+	Op    string              `json:"op"`
+	Path  string              `json:"path"`
+	From  string              `json:"from"`
+	Value jsoniter.RawMessage `json:"value"`
+}
+
+// Apply applies the patch of the content type to the JSON document and returns the patched document.
+func Apply(contentType string, doc []byte, patch []byte) ([]byte, error) {
+	switch contentType {
+	case ContentTypeMergePatch:
+		return MergePatch(doc, patch)
+	case ContentTypeJSONPatch:
+		return JSONPatch(doc, patch)
+	default:
+		return nil, ErrPatchContentType
+	}
+}
+
+// MergePatch applies JSON Merge Patch to the JSON document.
+// Objects of the patch are merged, null removes a member and any other value replaces it.
+func MergePatch(doc []byte, patch []byte) ([]byte, error) {
+	var target, patchValue interface{}
+
+	if err := jsoniter.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+
+	if err := jsoniter.Unmarshal(patch, &patchValue); err != nil {
+		return nil, ErrPatchWrong
+	}
+
+	return jsoniter.Marshal(mergeValue(target, patchValue))
+}
+
+// mergeValue merges the patch into the target value.
+func mergeValue(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergeValue(targetObject[key], value)
+		}
+	}
+
+	return targetObject
+}
+
+// JSONPatch applies operations of JSON Patch to the JSON document, all operations are applied or none.
+func JSONPatch(doc []byte, patch []byte) ([]byte, error) {
+	var (
+		target     interface{}
+		operations []Operation
+	)
+
+	if err := jsoniter.Unmarshal(doc, &target); err != nil {
+		return nil, err
+	}
+
+	if err := jsoniter.Unmarshal(patch, &operations); err != nil {
+		return nil, ErrPatchWrong
+	}
+
+	for i, operation := range operations {
+		var err error
+		if target, err = applyOperation(target, operation); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+
+	return jsoniter.Marshal(target)
+}
+
+// applyOperation applies one operation to the document and returns the changed document.
+func applyOperation(doc interface{}, operation Operation) (interface{}, error) {
+	path, err := parsePointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operation.Op {
+	case "add", "replace", "test":
+		value, errValue := operation.value()
+		if errValue != nil {
+			return nil, errValue
+		}
+
+		switch operation.Op {
+		case "add":
+			return addValue(doc, path, value)
+		case "replace":
+			if doc, err = removeValue(doc, path); err != nil {
+				return nil, err
+			}
+
+			return addValue(doc, path, value)
+		default:
+			current, errGet := getValue(doc, path)
+			if errGet != nil {
+				return nil, errGet
+			}
+
+			if !reflect.DeepEqual(current, value) {
+				return nil, ErrPatchTest
+			}
+
+			return doc, nil
+		}
+	case "remove":
+		return removeValue(doc, path)
+	case "move", "copy":
+		from, errFrom := parsePointer(operation.From)
+		if errFrom != nil {
+			return nil, errFrom
+		}
+
+		value, errGet := getValue(doc, from)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		if operation.Op == "move" {
+			// A value can not be moved into itself
+			if len(path) > len(from) && isPrefix(from, path) {
+				return nil, ErrPatchPath
+			}
+
+			if doc, err = removeValue(doc, from); err != nil {
+				return nil, err
+			}
+		} else if value, err = copyValue(value); err != nil {
+			return nil, err
+		}
+
+		return addValue(doc, path, value)
+	default:
+		return nil, ErrPatchOperation
+	}
+}
+
+// value returns the value of the operation, null is a value too.
+func (o Operation) value() (interface{}, error) {
+	if o.Value == nil {
+		return nil, ErrPatchValue
+	}
+
+	var value interface{}
+	if err := jsoniter.Unmarshal(o.Value, &value); err != nil {
+		return nil, ErrPatchWrong
+	}
+
+	return value, nil
+}
+
+// parsePointer parses JSON Pointer (RFC 6901) to reference tokens, the empty pointer is the whole document.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, ErrPatchPath
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// getValue returns the value at the path.
+func getValue(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, ErrPatchPath
+			}
+
+			doc = value
+		case []interface{}:
+			index, err := arrayIndex(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+
+			doc = node[index]
+		default:
+			return nil, ErrPatchPath
+		}
+	}
+
+	return doc, nil
+}
+
+// addValue adds the value at the path, a member of object is replaced and an element of array is inserted.
+func addValue(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return changeParent(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = value
+
+			return node, nil
+		case []interface{}:
+			// "-" is the end of array
+			if token == "-" {
+				return append(node, value), nil
+			}
+
+			index, err := arrayIndex(token, len(node)+1)
+			if err != nil {
+				return nil, err
+			}
+
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+
+			return node, nil
+		default:
+			return nil, ErrPatchPath
+		}
+	})
+}
+
+// removeValue removes the value at the path, it must exist.
+func removeValue(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	return changeParent(doc, path, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := node[token]; !ok {
+				return nil, ErrPatchPath
+			}
+
+			delete(node, token)
+
+			return node, nil
+		case []interface{}:
+			index, err := arrayIndex(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+
+			return append(node[:index], node[index+1:]...), nil
+		default:
+			return nil, ErrPatchPath
+		}
+	})
+}
+
+// changeParent changes the parent of the value at the path with the function and returns the changed document.
+func changeParent(doc interface{}, path []string, change func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return change(doc, path[0])
+	}
+
+	child, err := getValue(doc, path[:1])
+	if err != nil {
+		return nil, err
+	}
+
+	if child, err = changeParent(child, path[1:], change); err != nil {
+		return nil, err
+	}
+
+	// Put the changed child back, arrays can be reallocated by the change
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		node[path[0]] = child
+	case []interface{}:
+		index, _ := arrayIndex(path[0], len(node))
+		node[index] = child
+	}
+
+	return doc, nil
+}
+
+// arrayIndex parses the token as an index of array less than the limit.
+func arrayIndex(token string, limit int) (int, error) {
+	// Leading zeros are not allowed
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, ErrPatchPath
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index >= limit {
+		return 0, ErrPatchPath
+	}
+
+	return index, nil
+}
+
+// isPrefix checks that the path starts with the prefix.
+func isPrefix(prefix []string, path []string) bool {
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// copyValue returns a deep copy of the value.
+func copyValue(value interface{}) (interface{}, error) {
+	data, err := jsoniter.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var valueCopy interface{}
+	if err = jsoniter.Unmarshal(data, &valueCopy); err != nil {
+		return nil, err
+	}
+
+	return valueCopy, nil
+}
@@ -0,0 +1,169 @@
+package patch_test
+
+import (
+	"film-management/pkg/patch"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+		err      error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "ReplaceMember",
+			doc:      `{"title":"Film","synopsis":"Old"}`,
+			patch:    `{"synopsis":"New"}`,
+			expected: `{"title":"Film","synopsis":"New"}`,
+		},
+		{
+			name:     "RemoveMemberWithNull",
+			doc:      `{"title":"Film","synopsis":"Old"}`,
+			patch:    `{"synopsis":null}`,
+			expected: `{"title":"Film"}`,
+		},
+		{
+			name:     "ReplaceArray",
+			doc:      `{"genres":["action","drama"]}`,
+			patch:    `{"genres":["comedy"]}`,
+			expected: `{"genres":["comedy"]}`,
+		},
+		{
+			name:     "MergeNestedObject",
+			doc:      `{"a":{"b":"c","d":"e"}}`,
+			patch:    `{"a":{"b":"x","f":"g"}}`,
+			expected: `{"a":{"b":"x","d":"e","f":"g"}}`,
+		},
+		{
+			name:  "WrongPatch",
+			doc:   `{"title":"Film"}`,
+			patch: `{"title":`,
+			err:   patch.ErrPatchWrong,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := patch.MergePatch([]byte(tc.doc), []byte(tc.patch))
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(result))
+		})
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	t.Parallel()
+
+	doc := `{"title":"Film","genres":["action","drama"],"casts":[{"name":"Cast A"},{"name":"Cast B"}]}`
+
+	type testCase struct {
+		name     string
+		patch    string
+		expected string
+		err      error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "Replace",
+			patch:    `[{"op":"replace","path":"/title","value":"New Film"}]`,
+			expected: `{"title":"New Film","genres":["action","drama"],"casts":[{"name":"Cast A"},{"name":"Cast B"}]}`,
+		},
+		{
+			name:     "AddToEndOfArray",
+			patch:    `[{"op":"add","path":"/genres/-","value":"comedy"}]`,
+			expected: `{"title":"Film","genres":["action","drama","comedy"],"casts":[{"name":"Cast A"},{"name":"Cast B"}]}`,
+		},
+		{
+			name:     "InsertIntoArray",
+			patch:    `[{"op":"add","path":"/genres/0","value":"comedy"}]`,
+			expected: `{"title":"Film","genres":["comedy","action","drama"],"casts":[{"name":"Cast A"},{"name":"Cast B"}]}`,
+		},
+		{
+			name:     "RemoveFromArray",
+			patch:    `[{"op":"remove","path":"/casts/0"}]`,
+			expected: `{"title":"Film","genres":["action","drama"],"casts":[{"name":"Cast B"}]}`,
+		},
+		{
+			name:     "ReplaceNestedMember",
+			patch:    `[{"op":"replace","path":"/casts/1/name","value":"Cast C"}]`,
+			expected: `{"title":"Film","genres":["action","drama"],"casts":[{"name":"Cast A"},{"name":"Cast C"}]}`,
+		},
+		{
+			name:     "MoveAndCopy",
+			patch:    `[{"op":"move","from":"/genres/1","path":"/genres/0"},{"op":"copy","from":"/title","path":"/synopsis"}]`,
+			expected: `{"title":"Film","synopsis":"Film","genres":["drama","action"],"casts":[{"name":"Cast A"},{"name":"Cast B"}]}`,
+		},
+		{
+			name:     "TestPassed",
+			patch:    `[{"op":"test","path":"/title","value":"Film"},{"op":"remove","path":"/genres/1"}]`,
+			expected: `{"title":"Film","genres":["action"],"casts":[{"name":"Cast A"},{"name":"Cast B"}]}`,
+		},
+		{
+			name:  "TestFailed",
+			patch: `[{"op":"remove","path":"/genres/1"},{"op":"test","path":"/title","value":"Other"}]`,
+			err:   patch.ErrPatchTest,
+		},
+		{
+			name:  "PathNotFound",
+			patch: `[{"op":"replace","path":"/rating","value":10}]`,
+			err:   patch.ErrPatchPath,
+		},
+		{
+			name:  "IndexOutOfRange",
+			patch: `[{"op":"remove","path":"/genres/2"}]`,
+			err:   patch.ErrPatchPath,
+		},
+		{
+			name:  "MissingValue",
+			patch: `[{"op":"add","path":"/synopsis"}]`,
+			err:   patch.ErrPatchValue,
+		},
+		{
+			name:  "UnknownOperation",
+			patch: `[{"op":"merge","path":"/title","value":"Film"}]`,
+			err:   patch.ErrPatchOperation,
+		},
+		{
+			name:  "WrongPatch",
+			patch: `{"op":"replace"}`,
+			err:   patch.ErrPatchWrong,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := patch.JSONPatch([]byte(doc), []byte(tc.patch))
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(result))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package policy
+
+import (
+	"github.com/google/uuid"
+)
+
+// Role is a type for user role.
+type Role string
+
+const (
+	// RoleViewer can only read, rate and review films.
+	RoleViewer Role = "viewer"
+	// RoleEditor can also add films and manage own films.
+	RoleEditor Role = "editor"
+	// RoleAdmin can manage any film.
+	RoleAdmin Role = "admin"
+
+	// roleWithoutClaim is a role of users whose access tokens were issued before roles, it is the default of the role column.
+	roleWithoutClaim = RoleEditor
+)
+
+// IsValid checks if a role is known.
+func (r Role) IsValid() bool {
+	_, ok := rules[r]
+
+	return ok
+}
+
+// Action is a type for action on a resource.
+type Action string
+
+const (
+	ActionFilmCreate     Action = "film:create"
+	ActionFilmUpdate     Action = "film:update"
+	ActionFilmDelete     Action = "film:delete"
+	ActionFilmRestore    Action = "film:restore"
+	ActionFilmRollback   Action = "film:rollback"
+	ActionReviewUpdate   Action = "review:update"
+	ActionReviewDelete   Action = "review:delete"
+	ActionReviewModerate Action = "review:moderate"
+	ActionDirectorUpdate Action = "director:update"
+	ActionDirectorMerge  Action = "director:merge"
+	ActionGenreManage    Action = "genre:manage"
+)
+
+// scope is a type for scope of the allowed action.
+type scope int
+
+const (
+	// scopeNone denies the action.
+	scopeNone scope = iota
+	// scopeOwn allows the action only on own resources.
+	scopeOwn
+	// scopeAny allows the action on any resource.
+	scopeAny
+)
+
+// rules is a map of allowed actions for every role.
+var rules = map[Role]map[Action]scope{
+	RoleViewer: {
+		ActionReviewUpdate: scopeOwn,
+		ActionReviewDelete: scopeOwn,
+	},
+	RoleEditor: {
+		ActionFilmCreate:     scopeAny,
+		ActionFilmUpdate:     scopeOwn,
+		ActionFilmDelete:     scopeOwn,
+		ActionFilmRestore:    scopeOwn,
+		ActionReviewUpdate:   scopeOwn,
+		ActionReviewDelete:   scopeOwn,
+		ActionReviewModerate: scopeOwn,
+	},
+	RoleAdmin: {
+		ActionFilmCreate:     scopeAny,
+		ActionFilmUpdate:     scopeAny,
+		ActionFilmDelete:     scopeAny,
+		ActionFilmRestore:    scopeAny,
+		ActionFilmRollback:   scopeAny,
+		ActionReviewUpdate:   scopeOwn,
+		ActionReviewDelete:   scopeAny,
+		ActionReviewModerate: scopeAny,
+		ActionDirectorUpdate: scopeAny,
+		ActionDirectorMerge:  scopeAny,
+		ActionGenreManage:    scopeAny,
+	},
+}
+
+// Actor is a user who wants to do an action.
+type Actor struct {
+	UserID uuid.UUID
+	Role   Role
+}
+
+// NewActor is a constructor for Actor.
+func NewActor(userID uuid.UUID, role Role) Actor {
+	return Actor{
+		UserID: userID,
+		Role:   role,
+	}
+}
+
+// Can checks if an actor can do an action on a resource owned by ownerID.
+// Use uuid.Nil as ownerID for actions without a resource owner.
+// An actor without role has a token issued before roles, it is an editor like users of that time.
+func Can(actor Actor, action Action, ownerID uuid.UUID) bool {
+	role := actor.Role
+	if role == "" {
+		role = roleWithoutClaim
+	}
+
+	switch rules[role][action] {
+	case scopeAny:
+		return true
+	case scopeOwn:
+		return actor.UserID != uuid.Nil && actor.UserID == ownerID
+	default:
+		return false
+	}
+}
@@ -0,0 +1,164 @@
+package policy_test
+
+import (
+	"film-management/pkg/policy"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCan(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		actor    policy.Actor
+		action   policy.Action
+		ownerID  uuid.UUID
+		expected bool
+	}
+
+	var (
+		ownerID = uuid.New()
+		otherID = uuid.New()
+	)
+
+	testCases := []testCase{
+		{
+			name:     "ViewerCannotCreateFilm",
+			actor:    policy.NewActor(ownerID, policy.RoleViewer),
+			action:   policy.ActionFilmCreate,
+			ownerID:  uuid.Nil,
+			expected: false,
+		},
+		{
+			name:     "EditorCanCreateFilm",
+			actor:    policy.NewActor(ownerID, policy.RoleEditor),
+			action:   policy.ActionFilmCreate,
+			ownerID:  uuid.Nil,
+			expected: true,
+		},
+		{
+			name:     "EditorCanUpdateOwnFilm",
+			actor:    policy.NewActor(ownerID, policy.RoleEditor),
+			action:   policy.ActionFilmUpdate,
+			ownerID:  ownerID,
+			expected: true,
+		},
+		{
+			name:     "EditorCannotDeleteOtherFilm",
+			actor:    policy.NewActor(otherID, policy.RoleEditor),
+			action:   policy.ActionFilmDelete,
+			ownerID:  ownerID,
+			expected: false,
+		},
+		{
+			name:     "AdminCanDeleteOtherFilm",
+			actor:    policy.NewActor(otherID, policy.RoleAdmin),
+			action:   policy.ActionFilmDelete,
+			ownerID:  ownerID,
+			expected: true,
+		},
+		{
+			name:     "EditorCanRestoreOwnFilm",
+			actor:    policy.NewActor(ownerID, policy.RoleEditor),
+			action:   policy.ActionFilmRestore,
+			ownerID:  ownerID,
+			expected: true,
+		},
+		{
+			name:     "ViewerCannotRestoreFilm",
+			actor:    policy.NewActor(ownerID, policy.RoleViewer),
+			action:   policy.ActionFilmRestore,
+			ownerID:  ownerID,
+			expected: false,
+		},
+		{
+			name:     "EditorCannotRollbackOwnFilm",
+			actor:    policy.NewActor(ownerID, policy.RoleEditor),
+			action:   policy.ActionFilmRollback,
+			ownerID:  ownerID,
+			expected: false,
+		},
+		{
+			name:     "AdminCanRollbackOtherFilm",
+			actor:    policy.NewActor(otherID, policy.RoleAdmin),
+			action:   policy.ActionFilmRollback,
+			ownerID:  ownerID,
+			expected: true,
+		},
+		{
+			name:     "EditorCannotMergeDirectors",
+			actor:    policy.NewActor(ownerID, policy.RoleEditor),
+			action:   policy.ActionDirectorMerge,
+			ownerID:  uuid.Nil,
+			expected: false,
+		},
+		{
+			name:     "AdminCanUpdateDirector",
+			actor:    policy.NewActor(otherID, policy.RoleAdmin),
+			action:   policy.ActionDirectorUpdate,
+			ownerID:  uuid.Nil,
+			expected: true,
+		},
+		{
+			name:     "EditorCannotManageGenres",
+			actor:    policy.NewActor(ownerID, policy.RoleEditor),
+			action:   policy.ActionGenreManage,
+			ownerID:  uuid.Nil,
+			expected: false,
+		},
+		{
+			name:     "AdminCanManageGenres",
+			actor:    policy.NewActor(otherID, policy.RoleAdmin),
+			action:   policy.ActionGenreManage,
+			ownerID:  uuid.Nil,
+			expected: true,
+		},
+		{
+			name:     "AdminCannotUpdateOtherReview",
+			actor:    policy.NewActor(otherID, policy.RoleAdmin),
+			action:   policy.ActionReviewUpdate,
+			ownerID:  ownerID,
+			expected: false,
+		},
+		{
+			name:     "ViewerCanDeleteOwnReview",
+			actor:    policy.NewActor(ownerID, policy.RoleViewer),
+			action:   policy.ActionReviewDelete,
+			ownerID:  ownerID,
+			expected: true,
+		},
+		{
+			name:     "UnknownRoleIsDenied",
+			actor:    policy.NewActor(ownerID, policy.Role("guest")),
+			action:   policy.ActionReviewDelete,
+			ownerID:  ownerID,
+			expected: false,
+		},
+		{
+			name:     "EmptyRoleCanUpdateOwnFilmLikeEditor",
+			actor:    policy.NewActor(ownerID, policy.Role("")),
+			action:   policy.ActionFilmUpdate,
+			ownerID:  ownerID,
+			expected: true,
+		},
+		{
+			name:     "EmptyRoleCannotDeleteOtherFilmLikeEditor",
+			actor:    policy.NewActor(otherID, policy.Role("")),
+			action:   policy.ActionFilmDelete,
+			ownerID:  ownerID,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, policy.Can(tc.actor, tc.action, tc.ownerID))
+		})
+	}
+}
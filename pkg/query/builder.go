@@ -1,14 +1,17 @@
 package query
 
 import (
+	"film-management/pkg/query/pagination"
 	"film-management/pkg/query/sort"
 )
 
 type FilterSortLimit struct {
-	Sort   sort.Sortable
-	Filter Filter
-	Limit  int
-	Offset int
+	Sort      sort.Sortable
+	Filter    Filter
+	Limit     int
+	Offset    int
+	Cursor    *pagination.Cursor
+	WithCount bool
 }
 
 type Filter map[string]interface{}
@@ -18,14 +21,18 @@ type FilterSortLimitBuilder interface {
 	SetFilter(Filter) FilterSortLimitBuilder
 	SetLimit(int) FilterSortLimitBuilder
 	SetOffset(int) FilterSortLimitBuilder
+	SetCursor(*pagination.Cursor) FilterSortLimitBuilder
+	SetWithCount(bool) FilterSortLimitBuilder
 	Build() FilterSortLimit
 }
 
 type filterSortLimitBuilder struct {
-	sort   sort.Sortable
-	filter Filter
-	limit  int
-	offset int
+	sort      sort.Sortable
+	filter    Filter
+	limit     int
+	offset    int
+	cursor    *pagination.Cursor
+	withCount bool
 }
 
 func NewFilterSortLimitBuilder() FilterSortLimitBuilder {
@@ -56,11 +63,25 @@ func (b *filterSortLimitBuilder) SetOffset(offset int) FilterSortLimitBuilder {
 	return b
 }
 
+func (b *filterSortLimitBuilder) SetCursor(cursor *pagination.Cursor) FilterSortLimitBuilder {
+	b.cursor = cursor
+
+	return b
+}
+
+func (b *filterSortLimitBuilder) SetWithCount(withCount bool) FilterSortLimitBuilder {
+	b.withCount = withCount
+
+	return b
+}
+
 func (b *filterSortLimitBuilder) Build() FilterSortLimit {
 	return FilterSortLimit{
-		Sort:   b.sort,
-		Filter: b.filter,
-		Limit:  b.limit,
-		Offset: b.offset,
+		Sort:      b.sort,
+		Filter:    b.filter,
+		Limit:     b.limit,
+		Offset:    b.offset,
+		Cursor:    b.cursor,
+		WithCount: b.withCount,
 	}
 }
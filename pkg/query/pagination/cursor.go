@@ -0,0 +1,82 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query/sort"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"strings"
+)
+
+var (
+	ErrCursorInvalid      = errors.New("cursor query parameter is not valid")
+	ErrCursorSortMismatch = errors.New("cursor query parameter was issued for another sort")
+	ErrCursorWithOffset   = errors.New("offset query parameter can not be used with cursor")
+)
+
+// Cursor is a position in a list sorted by a key, the UUID breaks ties of equal keys.
+type Cursor struct {
+	Sort  string      `json:"s"`
+	Value interface{} `json:"v"`
+	UUID  uuid.UUID   `json:"id"`
+}
+
+// NewCursor returns cursor after the item with the value of sort key and UUID.
+func NewCursor(sortOption sort.Sortable, value interface{}, id uuid.UUID) Cursor {
+	return Cursor{
+		Sort:  sortKey(sortOption),
+		Value: value,
+		UUID:  id,
+	}
+}
+
+// Encode returns opaque string of cursor.
+func (c Cursor) Encode() string {
+	data, err := jsoniter.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor returns cursor from opaque string.
+func DecodeCursor(cursor string) (Cursor, error) {
+	var c Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, customError.ValidationError{Field: "cursor", Err: ErrCursorInvalid}
+	}
+
+	if err = jsoniter.Unmarshal(data, &c); err != nil || c.UUID == uuid.Nil || c.Value == nil {
+		return Cursor{}, customError.ValidationError{Field: "cursor", Err: ErrCursorInvalid}
+	}
+
+	return c, nil
+}
+
+// GetCursorOption returns cursor for the sort or nil if cursor is not set.
+func GetCursorOption(cursor string, sortOption sort.Sortable) (*Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	c, err := DecodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Sort != sortKey(sortOption) {
+		return nil, customError.ValidationError{Field: "cursor", Err: ErrCursorSortMismatch}
+	}
+
+	return &c, nil
+}
+
+// sortKey returns sort in format of sort query parameter.
+func sortKey(sortOption sort.Sortable) string {
+	return sortOption.Field() + "." + strings.ToLower(sortOption.Order())
+}
@@ -0,0 +1,75 @@
+package pagination_test
+
+import (
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCursorOption(t *testing.T) {
+	t.Parallel()
+
+	ratingDesc, err := sort.GetSortOptions("rating.desc", []string{"title", "rating"}, "rating.desc")
+	require.NoError(t, err)
+
+	titleAsc, err := sort.GetSortOptions("title.asc", []string{"title", "rating"}, "rating.desc")
+	require.NoError(t, err)
+
+	filmID := uuid.New()
+	cursor := pagination.NewCursor(ratingDesc, 7.5, filmID)
+
+	type testCase struct {
+		name     string
+		cursor   string
+		sort     sort.Sortable
+		expected *pagination.Cursor
+		err      error
+	}
+
+	testCases := []testCase{
+		{
+			name:     "EmptyCursor",
+			cursor:   "",
+			sort:     ratingDesc,
+			expected: nil,
+			err:      nil,
+		},
+		{
+			name:     "EncodedCursor",
+			cursor:   cursor.Encode(),
+			sort:     ratingDesc,
+			expected: &pagination.Cursor{Sort: "rating.desc", Value: 7.5, UUID: filmID},
+			err:      nil,
+		},
+		{
+			name:     "CursorOfAnotherSort",
+			cursor:   cursor.Encode(),
+			sort:     titleAsc,
+			expected: nil,
+			err:      customError.ValidationError{Field: "cursor", Err: pagination.ErrCursorSortMismatch},
+		},
+		{
+			name:     "MalformedCursor",
+			cursor:   "not a cursor",
+			sort:     ratingDesc,
+			expected: nil,
+			err:      customError.ValidationError{Field: "cursor", Err: pagination.ErrCursorInvalid},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := pagination.GetCursorOption(tc.cursor, tc.sort)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -13,10 +13,11 @@ var (
 
 // Pagination is a struct for pagination.
 type Pagination struct {
-	Page       int `json:"page" example:"1"`
-	TotalPages int `json:"total_pages" example:"10"`
-	PageSize   int `json:"page_size" example:"20"`
-	TotalCount int `json:"total_count" example:"200"`
+	Page       int    `json:"page" example:"1"`
+	TotalPages int    `json:"total_pages" example:"10"`
+	PageSize   int    `json:"page_size" example:"20"`
+	TotalCount int    `json:"total_count" example:"200"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoicmF0aW5nLmRlc2MiLCJ2Ijo3LjUsImlkIjoiNTUwZTg0MDAtZTI5Yi00MWQ0LWE3MTYtNDQ2NjU1NDQwMDAwIn0"`
 }
 
 // NewPagination returns pagination.
@@ -42,6 +43,22 @@ func NewPagination(totalCount, limit, offset int) Pagination {
 	}
 }
 
+// NewCursorPagination returns pagination of a page fetched by cursor, it has no page number and total count.
+func NewCursorPagination(limit int, nextCursor string) Pagination {
+	return Pagination{
+		PageSize:   limit,
+		NextCursor: nextCursor,
+	}
+}
+
+// WithTotalCount returns pagination with total count and total pages.
+func (p Pagination) WithTotalCount(totalCount int) Pagination {
+	p.TotalCount = totalCount
+	p.TotalPages = int(math.Ceil(float64(totalCount) / float64(p.PageSize)))
+
+	return p
+}
+
 // GetLimitOption returns limit.
 func GetLimitOption(limit int, defaultLimit int) (int, error) {
 	if limit > 0 {
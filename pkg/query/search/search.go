@@ -0,0 +1,174 @@
+// Package search parses the query of full-text search for storages without full-text search of their own.
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Terms is a function to get lower case words of the query which must and must not match.
+// Words prefixed with "-" must not match, "or" and "and" are not words of the query.
+func Terms(q string) (include []string, exclude []string) {
+	for _, term := range strings.Fields(strings.ToLower(q)) {
+		negative := strings.HasPrefix(term, "-")
+
+		for _, word := range Words(term) {
+			if word == "or" || word == "and" {
+				continue
+			}
+
+			if negative {
+				exclude = append(exclude, word)
+			} else {
+				include = append(include, word)
+			}
+		}
+	}
+
+	return include, exclude
+}
+
+// Words is a function to split the text into lower case words.
+func Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isNotWordRune)
+}
+
+// isNotWordRune is a function to check if the rune separates words.
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// Options of highlighted fragments, they are the same as of ts_headline of Postgres search.
+const (
+	// highlightMaxFragments is a maximal number of fragments around matched words.
+	highlightMaxFragments = 2
+	// highlightMaxWords is a maximal number of words of a fragment.
+	highlightMaxWords = 20
+	// highlightMinWords is a number of words from the start of the text without matched words.
+	highlightMinWords = 5
+	// highlightDelimiter separates fragments.
+	highlightDelimiter = " ... "
+)
+
+// segment is a word or a text between words.
+type segment struct {
+	text string
+	word bool
+}
+
+// Highlight is a function to get HTML-escaped fragments of the text around words which start with any of the terms,
+// the matched words are marked. The text is user input, so only marks are HTML.
+func Highlight(text string, terms []string) string {
+	segments := split(text)
+
+	// Indexes of words in segments and indexes of matched words in words
+	var words, matched []int
+
+	for i, s := range segments {
+		if !s.word {
+			continue
+		}
+
+		if startsWithAny(s.text, terms) {
+			matched = append(matched, len(words))
+		}
+
+		words = append(words, i)
+	}
+
+	if len(words) == 0 {
+		return ""
+	}
+
+	if len(matched) == 0 {
+		last := highlightMinWords
+		if last > len(words) {
+			last = len(words)
+		}
+
+		return fragment(segments, words[0], words[last-1], terms)
+	}
+
+	fragments := make([]string, 0, highlightMaxFragments)
+	end := -1
+
+	for _, word := range matched {
+		if word <= end {
+			continue
+		}
+
+		if len(fragments) == highlightMaxFragments {
+			break
+		}
+
+		// A matched word is at the quarter of its fragment, fragments do not overlap
+		start := word - highlightMaxWords/4
+		if start <= end {
+			start = end + 1
+		}
+
+		end = start + highlightMaxWords - 1
+		if end >= len(words) {
+			end = len(words) - 1
+		}
+		fragments = append(fragments, fragment(segments, words[start], words[end], terms))
+	}
+
+	return strings.Join(fragments, highlightDelimiter)
+}
+
+// split is a function to split the text into words and texts between them.
+func split(text string) []segment {
+	var (
+		segments []segment
+		current  []rune
+		word     bool
+	)
+
+	for _, r := range text {
+		isWord := !isNotWordRune(r)
+
+		if len(current) > 0 && isWord != word {
+			segments = append(segments, segment{text: string(current), word: word})
+			current = current[:0]
+		}
+
+		current = append(current, r)
+		word = isWord
+	}
+
+	if len(current) > 0 {
+		segments = append(segments, segment{text: string(current), word: word})
+	}
+
+	return segments
+}
+
+// fragment is a function to get HTML of segments from the first to the last one with marked matched words.
+func fragment(segments []segment, first int, last int, terms []string) string {
+	var builder strings.Builder
+
+	for _, s := range segments[first : last+1] {
+		if s.word && startsWithAny(s.text, terms) {
+			builder.WriteString("<mark>" + html.EscapeString(s.text) + "</mark>")
+		} else {
+			builder.WriteString(html.EscapeString(s.text))
+		}
+	}
+
+	return builder.String()
+}
+
+// startsWithAny is a function to check if the word starts with any of the terms.
+func startsWithAny(word string, terms []string) bool {
+	word = strings.ToLower(word)
+
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package search_test
+
+import (
+	"film-management/pkg/query/search"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	t.Parallel()
+
+	include, exclude := search.Terms("Dark -Knight or Rises")
+	assert.Equal(t, []string{"dark", "rises"}, include)
+	assert.Equal(t, []string{"knight"}, exclude)
+}
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	longText := "one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen " +
+		"sixteen seventeen eighteen nineteen twenty twentyone twentytwo twentythree twentyfour target end"
+
+	type testCase struct {
+		name     string
+		text     string
+		terms    []string
+		expected string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "MarkedWords",
+			text:     "The Dark Knight. Batman fights the Joker",
+			terms:    []string{"dark", "bat"},
+			expected: "The <mark>Dark</mark> Knight. <mark>Batman</mark> fights the Joker",
+		},
+		{
+			name:     "EscapedMarkup",
+			text:     `Film <script>alert("x")</script>. Tom & Jerry's chase`,
+			terms:    []string{"script", "tom"},
+			expected: "Film &lt;<mark>script</mark>&gt;alert(&#34;x&#34;)&lt;/<mark>script</mark>&gt;. <mark>Tom</mark> &amp; Jerry&#39;s chase",
+		},
+		{
+			name:     "NoMatchedWords",
+			text:     "One <b>two</b> three four five six seven",
+			terms:    []string{"eight"},
+			expected: "One &lt;b&gt;two&lt;/b&gt; three",
+		},
+		{
+			name:     "FragmentOfLongText",
+			text:     longText,
+			terms:    []string{"target"},
+			expected: "twenty twentyone twentytwo twentythree twentyfour <mark>target</mark> end",
+		},
+		{
+			name:     "TwoFragments",
+			text:     "alpha " + longText + " alpha",
+			terms:    []string{"alpha"},
+			expected: "<mark>alpha</mark> one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen sixteen seventeen eighteen nineteen" + " ... " + "twentytwo twentythree twentyfour target end <mark>alpha</mark>",
+		},
+		{
+			name:     "EmptyText",
+			text:     "",
+			terms:    []string{"alpha"},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, search.Highlight(tc.text, tc.terms))
+		})
+	}
+}
+
+func TestHighlightFragmentSize(t *testing.T) {
+	t.Parallel()
+
+	text := strings.Repeat("word ", 100) + "match " + strings.Repeat("word ", 100) + "match"
+
+	highlight := search.Highlight(text, []string{"match"})
+	fragments := strings.Split(highlight, " ... ")
+
+	assert.Len(t, fragments, 2)
+
+	for _, fragment := range fragments {
+		assert.LessOrEqual(t, len(strings.Fields(fragment)), 20)
+		assert.Contains(t, fragment, "<mark>match</mark>")
+	}
+}
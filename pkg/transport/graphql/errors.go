@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	customError "film-management/pkg/errors"
+	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/validation"
+	"github.com/go-playground/validator/v10"
+	"strings"
+)
+
+// Codes of errors in extensions of GraphQL errors.
+const (
+	CodeBadUserInput       = "BAD_USER_INPUT"
+	CodeNotFound           = "NOT_FOUND"
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodeForbidden          = "FORBIDDEN"
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	CodeUnavailable        = "SERVICE_UNAVAILABLE"
+	CodeCanceled           = "CANCELED"
+	CodeInternal           = "INTERNAL_SERVER_ERROR"
+)
+
+var (
+	ErrContextUserID   = errors.New("user uuid not found in context")
+	ErrContextUserRole = errors.New("user role not found in context")
+)
+
+// Error is an error of a resolver with the code of the error type and invalid fields in extensions.
+type Error struct {
+	Err    error
+	Code   string
+	Fields map[string]string
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error of the resolver.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Extensions implements extensions of GraphQL errors.
+func (e *Error) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": e.Code}
+	if len(e.Fields) > 0 {
+		extensions["fields"] = e.Fields
+	}
+
+	return extensions
+}
+
+// EncodeError converts an error of endpoints to an error of a resolver with the code of the error type.
+func EncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Errors of resolvers are already encoded
+	var graphqlErr *Error
+	if errors.As(err, &graphqlErr) {
+		return err
+	}
+
+	// Validation errors are returned with invalid fields
+	if fields := errorsValidationMap(err); len(fields) > 0 {
+		return &Error{Err: httpTransport.ErrDataValidation, Code: CodeBadUserInput, Fields: fields}
+	}
+
+	return &Error{Err: err, Code: errorCode(err)}
+}
+
+// errorCode is a function to get a code of an error.
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, httpTransport.ErrBadRouting),
+		errors.Is(err, httpTransport.ErrJSONDecode):
+		return CodeBadUserInput
+	case errors.Is(err, httpTransport.ErrNotFound),
+		errors.As(err, &customError.NotFoundError{}):
+		return CodeNotFound
+	case errors.As(err, &customError.AuthError{}):
+		return CodeUnauthenticated
+	case errors.As(err, &customError.CorsError{}),
+		errors.As(err, &customError.PermissionError{}):
+		return CodeForbidden
+	case errors.As(err, &customError.PreconditionFailedError{}),
+		errors.As(err, &customError.PreconditionRequiredError{}):
+		return CodePreconditionFailed
+	case errors.As(err, &customError.UnavailableError{}):
+		return CodeUnavailable
+	case errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded):
+		return CodeCanceled
+	default:
+		return CodeInternal
+	}
+}
+
+// errorsValidationMap is a function to convert validation errors to a map of invalid fields.
+func errorsValidationMap(err error) map[string]string {
+	result := make(map[string]string)
+
+	var (
+		validationErrors validator.ValidationErrors
+		validationError  customError.ValidationError
+	)
+
+	if errors.As(err, &validationErrors) {
+		for _, fieldError := range validationErrors {
+			result[strings.ToLower(fieldError.Field())] = fieldError.Translate(validation.GetTranslator())
+		}
+	} else if errors.As(err, &validationError) {
+		result[validationError.Field] = validationError.Err.Error()
+	}
+
+	return result
+}
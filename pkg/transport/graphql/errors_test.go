@@ -0,0 +1,62 @@
+package graphql_test
+
+import (
+	"errors"
+	customError "film-management/pkg/errors"
+	graphqlTransport "film-management/pkg/transport/graphql"
+	httpTransport "film-management/pkg/transport/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeError(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test")
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode string
+	}{
+		{name: "BadRequest", err: httpTransport.ErrJSONDecode, expectedCode: graphqlTransport.CodeBadUserInput},
+		{name: "NotFound", err: customError.NotFoundError{Err: errTest}, expectedCode: graphqlTransport.CodeNotFound},
+		{name: "Auth", err: customError.AuthError{Err: errTest}, expectedCode: graphqlTransport.CodeUnauthenticated},
+		{name: "Permission", err: customError.PermissionError{Err: errTest}, expectedCode: graphqlTransport.CodeForbidden},
+		{name: "PreconditionFailed", err: customError.PreconditionFailedError{Err: errTest}, expectedCode: graphqlTransport.CodePreconditionFailed},
+		{name: "PreconditionRequired", err: customError.PreconditionRequiredError{Err: errTest}, expectedCode: graphqlTransport.CodePreconditionFailed},
+		{name: "Unavailable", err: customError.UnavailableError{Err: errTest}, expectedCode: graphqlTransport.CodeUnavailable},
+		{name: "Default", err: errTest, expectedCode: graphqlTransport.CodeInternal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var graphqlErr *graphqlTransport.Error
+
+			err := graphqlTransport.EncodeError(tc.err)
+			require.ErrorAs(t, err, &graphqlErr)
+
+			assert.Equal(t, tc.expectedCode, graphqlErr.Extensions()["code"])
+			assert.Equal(t, tc.err.Error(), err.Error())
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestEncodeErrorValidation(t *testing.T) {
+	t.Parallel()
+
+	var graphqlErr *graphqlTransport.Error
+
+	err := graphqlTransport.EncodeError(customError.ValidationError{Field: "title", Err: errors.New("film already exists")})
+	require.ErrorAs(t, err, &graphqlErr)
+
+	extensions := graphqlErr.Extensions()
+	assert.Equal(t, graphqlTransport.CodeBadUserInput, extensions["code"])
+	assert.Equal(t, map[string]string{"title": "film already exists"}, extensions["fields"])
+}
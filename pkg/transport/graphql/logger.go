@@ -0,0 +1,23 @@
+package graphql
+
+import (
+	"context"
+	"go.uber.org/zap"
+)
+
+// PanicLogger is a logger of panics in resolvers.
+type PanicLogger struct {
+	logger *zap.Logger
+}
+
+// LogPanic logs the panic, the panic is returned as an error of the field.
+func (l PanicLogger) LogPanic(_ context.Context, value interface{}) {
+	l.logger.Error("panic occurred", zap.Any("error", value), zap.Stack("stack"))
+}
+
+// NewPanicLogger returns a new PanicLogger.
+func NewPanicLogger(logger *zap.Logger) *PanicLogger {
+	return &PanicLogger{
+		logger: logger,
+	}
+}
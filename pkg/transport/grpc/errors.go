@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	customError "film-management/pkg/errors"
+	httpTransport "film-management/pkg/transport/http"
+	"film-management/pkg/validation"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+var (
+	ErrContextUserID   = errors.New("user uuid not found in context")
+	ErrContextUserRole = errors.New("user role not found in context")
+)
+
+// EncodeError converts an error of endpoints to a gRPC status error with the code of the error type.
+func EncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// Errors of gRPC and interceptors already have a status
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	// Validation errors are returned with violations of fields
+	if violations := fieldViolations(err); len(violations) > 0 {
+		st := status.New(codes.InvalidArgument, httpTransport.ErrDataValidation.Error())
+		if stWithDetails, errDetails := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); errDetails == nil {
+			st = stWithDetails
+		}
+
+		return st.Err()
+	}
+
+	return status.Error(errorCode(err), err.Error())
+}
+
+// errorCode is a function to get a gRPC code of an error.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, httpTransport.ErrBadRouting),
+		errors.Is(err, httpTransport.ErrJSONDecode),
+		errors.Is(err, httpTransport.ErrUnsupportedMediaType):
+		return codes.InvalidArgument
+	case errors.Is(err, httpTransport.ErrNotFound),
+		errors.As(err, &customError.NotFoundError{}):
+		return codes.NotFound
+	case errors.As(err, &customError.AuthError{}):
+		return codes.Unauthenticated
+	case errors.As(err, &customError.CorsError{}),
+		errors.As(err, &customError.PermissionError{}):
+		return codes.PermissionDenied
+	case errors.As(err, &customError.PreconditionFailedError{}),
+		errors.As(err, &customError.PreconditionRequiredError{}):
+		return codes.FailedPrecondition
+	case errors.As(err, &customError.UnavailableError{}):
+		return codes.Unavailable
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
+
+// fieldViolations is a function to convert validation errors to violations of fields.
+func fieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var (
+		violations       []*errdetails.BadRequest_FieldViolation
+		validationErrors validator.ValidationErrors
+		validationError  customError.ValidationError
+	)
+
+	if errors.As(err, &validationErrors) {
+		for _, fieldError := range validationErrors {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       strings.ToLower(fieldError.Field()),
+				Description: fieldError.Translate(validation.GetTranslator()),
+			})
+		}
+	} else if errors.As(err, &validationError) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       validationError.Field,
+			Description: validationError.Err.Error(),
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,72 @@
+package grpc_test
+
+import (
+	"errors"
+	customError "film-management/pkg/errors"
+	grpcTransport "film-management/pkg/transport/grpc"
+	httpTransport "film-management/pkg/transport/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncodeError(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test")
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+	}{
+		{name: "BadRequest", err: httpTransport.ErrJSONDecode, expectedCode: codes.InvalidArgument},
+		{name: "NotFound", err: customError.NotFoundError{Err: errTest}, expectedCode: codes.NotFound},
+		{name: "Auth", err: customError.AuthError{Err: errTest}, expectedCode: codes.Unauthenticated},
+		{name: "Permission", err: customError.PermissionError{Err: errTest}, expectedCode: codes.PermissionDenied},
+		{name: "PreconditionFailed", err: customError.PreconditionFailedError{Err: errTest}, expectedCode: codes.FailedPrecondition},
+		{name: "PreconditionRequired", err: customError.PreconditionRequiredError{Err: errTest}, expectedCode: codes.FailedPrecondition},
+		{name: "Unavailable", err: customError.UnavailableError{Err: errTest}, expectedCode: codes.Unavailable},
+		{name: "Default", err: errTest, expectedCode: codes.Internal},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := grpcTransport.EncodeError(tc.err)
+
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+			assert.Equal(t, tc.err.Error(), status.Convert(err).Message())
+		})
+	}
+}
+
+func TestEncodeErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	err := status.Error(codes.Unavailable, "test")
+
+	assert.Equal(t, err, grpcTransport.EncodeError(err))
+}
+
+func TestEncodeErrorValidation(t *testing.T) {
+	t.Parallel()
+
+	err := grpcTransport.EncodeError(customError.ValidationError{Field: "title", Err: errors.New("film already exists")})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "title", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "film already exists", badRequest.GetFieldViolations()[0].GetDescription())
+}
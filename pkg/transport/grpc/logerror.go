@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogErrorHandler is a error handler that logs the error, errors of clients are logged as debug.
+type LogErrorHandler struct {
+	logger *zap.Logger
+}
+
+// Handle logs the error.
+func (l LogErrorHandler) Handle(_ context.Context, err error) {
+	switch status.Code(EncodeError(err)) {
+	case codes.Internal, codes.Unknown:
+		l.logger.Error(err.Error())
+	default:
+		l.logger.Debug(err.Error())
+	}
+}
+
+// NewLogErrorHandler returns a new LogErrorHandler.
+func NewLogErrorHandler(logger *zap.Logger) *LogErrorHandler {
+	return &LogErrorHandler{
+		logger: logger,
+	}
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	customError "film-management/pkg/errors"
+	grpcTransport "film-management/pkg/transport/grpc"
+	httpAuth "film-management/pkg/transport/http/middlewares/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+// AuthorizationMetadata is a key of metadata with a bearer token, keys of metadata are lower case.
+const AuthorizationMetadata = "authorization"
+
+// UnaryServerInterceptor is an interceptor for authentication by a bearer token in metadata,
+// it sets the same user ID and role to context as the HTTP middleware.
+func UnaryServerInterceptor(notAuthMethods []string, authService httpAuth.Service, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// Skip authentication for some methods
+		for _, method := range notAuthMethods {
+			if info.FullMethod == method {
+				return handler(ctx, req)
+			}
+		}
+
+		token, err := TokenFromContext(ctx)
+		if err != nil {
+			return nil, grpcTransport.EncodeError(err)
+		}
+
+		// Parse token
+		claims, err := authService.ParseAuthToken(token)
+		if err != nil {
+			return nil, grpcTransport.EncodeError(customError.AuthError{Err: httpAuth.ErrWrongAuthToken})
+		}
+
+		// Check if token is revoked
+		revoked, err := authService.IsTokenRevoked(ctx, claims.ID)
+		if err != nil {
+			logger.Error("Error check revoked auth token", zap.String("method", info.FullMethod), zap.Error(err))
+
+			return nil, grpcTransport.EncodeError(customError.UnavailableError{Err: httpAuth.ErrRevocationUnavailable})
+		}
+
+		if revoked {
+			return nil, grpcTransport.EncodeError(customError.AuthError{Err: httpAuth.ErrRevokedAuthToken})
+		}
+
+		// Add user id and role to context
+		ctx = context.WithValue(ctx, httpAuth.ContextKeyUserID, claims.UUID)
+		ctx = context.WithValue(ctx, httpAuth.ContextKeyUserRole, claims.Role)
+
+		return handler(ctx, req)
+	}
+}
+
+// TokenFromContext returns a bearer token from authorization metadata of incoming context.
+func TokenFromContext(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// Check if token is missing
+	values := md.Get(AuthorizationMetadata)
+	if len(values) == 0 || values[0] == "" {
+		return "", customError.AuthError{Err: httpAuth.ErrMissingAuthToken}
+	}
+
+	// Check if token is valid
+	tokenParts := strings.Split(values[0], " ")
+	if len(tokenParts) != 2 || tokenParts[0] != httpAuth.AuthorizationPrefix {
+		return "", customError.AuthError{Err: httpAuth.ErrInvalidAuthToken}
+	}
+
+	// Check if token is empty
+	if len(tokenParts[1]) == 0 {
+		return "", customError.AuthError{Err: httpAuth.ErrAuthTokenEmpty}
+	}
+
+	return tokenParts[1], nil
+}
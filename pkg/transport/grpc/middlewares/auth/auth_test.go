@@ -0,0 +1,138 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"film-management/config"
+	auth2 "film-management/pkg/auth"
+	"film-management/pkg/transport/grpc/middlewares/auth"
+	httpAuth "film-management/pkg/transport/http/middlewares/auth"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ConfigPath = "../../../../../config"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name         string
+		method       string
+		authToken    string
+		expectedCode codes.Code
+	}
+
+	cfg := config.GetConfig(ConfigPath)
+
+	var (
+		logger         = zap.NewNop()
+		notAuthMethods = []string{"/test.v1.TestService/Login"}
+		authService    = auth2.NewAuthService(cfg.Services.Auth, logger)
+	)
+
+	token, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", "editor")
+	if err != nil {
+		return
+	}
+
+	interceptor := auth.UnaryServerInterceptor(notAuthMethods, authService, logger)
+
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return ctx.Value(httpAuth.ContextKeyUserID), nil
+	}
+
+	testCases := []testCase{
+		{
+			name:         "ValidToken",
+			method:       "/test.v1.TestService/View",
+			authToken:    httpAuth.AuthorizationPrefix + " " + token,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "MissingToken",
+			method:       "/test.v1.TestService/View",
+			authToken:    "",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "InvalidToken",
+			method:       "/test.v1.TestService/View",
+			authToken:    "invalidtoken",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "WrongToken",
+			method:       "/test.v1.TestService/View",
+			authToken:    httpAuth.AuthorizationPrefix + " wrong",
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "NotAuthMethod",
+			method:       "/test.v1.TestService/Login",
+			authToken:    "",
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.AuthorizationMetadata, tc.authToken))
+
+			userID, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+
+			if tc.expectedCode == codes.OK && tc.authToken != "" {
+				assert.Equal(t, "d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", userID)
+			}
+		})
+	}
+}
+
+// failingDenylist is a stub for storage of revoked tokens which is unavailable.
+type failingDenylist struct{}
+
+func (failingDenylist) IsAccessTokenRevoked(context.Context, string) (bool, error) {
+	return false, errors.New("database is down")
+}
+
+func TestAuthInterceptorRevocationUnavailable(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetConfig(ConfigPath)
+
+	var (
+		logger      = zap.NewNop()
+		authService = auth2.NewAuthService(cfg.Services.Auth, logger, auth2.WithDenylist(failingDenylist{}))
+	)
+
+	token, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", "editor")
+	if err != nil {
+		return
+	}
+
+	interceptor := auth.UnaryServerInterceptor(nil, authService, logger)
+
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.AuthorizationMetadata, httpAuth.AuthorizationPrefix+" "+token))
+
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/View"}, handler)
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, httpAuth.ErrRevocationUnavailable.Error(), status.Convert(err).Message())
+}
@@ -0,0 +1,27 @@
+package recovery
+
+import (
+	"context"
+	grpcTransport "film-management/pkg/transport/grpc"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var (
+	ErrInvalidServer = errors.New("invalid server error")
+)
+
+// UnaryServerInterceptor is an interceptor for recovering from panic.
+func UnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("panic occurred", zap.String("method", info.FullMethod), zap.Any("error", r))
+				err = grpcTransport.EncodeError(errors.Wrap(ErrInvalidServer, "middlewares.RecoveryInterceptor"))
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,27 @@
+package recovery_test
+
+import (
+	"context"
+	"film-management/pkg/transport/grpc/middlewares/recovery"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	logger := zap.NewNop()
+
+	panicHandler := func(context.Context, interface{}) (interface{}, error) {
+		panic("test panic")
+	}
+
+	_, err := recovery.UnaryServerInterceptor(logger)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, panicHandler)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, err.Error(), recovery.ErrInvalidServer.Error())
+}
@@ -10,4 +10,9 @@ var (
 	ErrSystemActionNotFound         = errors.New("action not found")
 	ErrSystemActionMethodNotAllowed = errors.New("method not allowed")
 	ErrContextUserID                = errors.New("user uuid not found in context")
+	ErrContextUserRole              = errors.New("user role not found in context")
+	ErrUnsupportedMediaType         = errors.New("unsupported content type")
+	ErrNotAcceptable                = errors.New("none of accepted content types is supported")
+	ErrIfMatchWrong                 = errors.New("if-match header must be an etag of the resource")
+	ErrRequestEntityTooLarge        = errors.New("request body is too large")
 )
@@ -7,6 +7,7 @@ import (
 	httpResponse "film-management/pkg/transport/http/response"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 	"net/http"
 	"strings"
 )
@@ -15,6 +16,7 @@ const (
 	AuthorizationHeader string     = "Authorization"
 	AuthorizationPrefix string     = "Bearer"
 	ContextKeyUserID    ContextKey = "user_id"
+	ContextKeyUserRole  ContextKey = "user_role"
 )
 
 var (
@@ -22,16 +24,20 @@ var (
 	ErrInvalidAuthToken = errors.New("invalid auth token. Bearer token is expected")
 	ErrAuthTokenEmpty   = errors.New("auth token is empty")
 	ErrWrongAuthToken   = errors.New("wrong auth token")
+	ErrRevokedAuthToken = errors.New("auth token is revoked")
+	// ErrRevocationUnavailable is an error of the denylist of revoked tokens, the token is not trusted without it.
+	ErrRevocationUnavailable = errors.New("revocation status of auth token is unavailable")
 )
 
 type ContextKey string
 
 type Service interface {
 	ParseAuthToken(token string) (*auth.JwtClaims, error)
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
 }
 
 // Middleware is a middleware for authentication.
-func Middleware(notAuthUrls []string, authService Service) mux.MiddlewareFunc {
+func Middleware(notAuthUrls []string, authService Service, logger *zap.Logger) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Skip authentication for some urls
@@ -77,8 +83,24 @@ func Middleware(notAuthUrls []string, authService Service) mux.MiddlewareFunc {
 				return
 			}
 
-			// Add user id to context
+			// Check if token is revoked
+			revoked, err := authService.IsTokenRevoked(r.Context(), token.ID)
+			if err != nil {
+				logger.Error("Error check revoked auth token", zap.String("path", requestPath), zap.Error(err))
+				httpResponse.EncodeError(r.Context(), customError.UnavailableError{Err: ErrRevocationUnavailable}, w)
+
+				return
+			}
+
+			if revoked {
+				httpResponse.EncodeError(r.Context(), customError.AuthError{Err: ErrRevokedAuthToken}, w)
+
+				return
+			}
+
+			// Add user id and role to context
 			ctx := setUserIDToContext(r.Context(), token.UUID)
+			ctx = setUserRoleToContext(ctx, token.Role)
 			r = r.WithContext(ctx)
 
 			next.ServeHTTP(w, r)
@@ -90,3 +112,8 @@ func Middleware(notAuthUrls []string, authService Service) mux.MiddlewareFunc {
 func setUserIDToContext(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, ContextKeyUserID, userID)
 }
+
+// setUserRoleToContext is a function for setting user role to context.
+func setUserRoleToContext(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, ContextKeyUserRole, role)
+}
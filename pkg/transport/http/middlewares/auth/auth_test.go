@@ -1,6 +1,8 @@
 package auth_test
 
 import (
+	"context"
+	"errors"
 	"film-management/config"
 	auth2 "film-management/pkg/auth"
 	"film-management/pkg/transport/http/middlewares/auth"
@@ -34,7 +36,7 @@ func TestAuthMiddleware(t *testing.T) {
 		authService = auth2.NewAuthService(cfg.Services.Auth, logger)
 	)
 
-	token, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e")
+	token, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", "editor")
 	if err != nil {
 		return
 	}
@@ -43,7 +45,7 @@ func TestAuthMiddleware(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	handler := auth.Middleware(notAuthURLs, authService)(authHandler)
+	handler := auth.Middleware(notAuthURLs, authService, logger)(authHandler)
 
 	testCases := []testCase{
 		{
@@ -92,3 +94,111 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+// denylistStub is a stub for storage of revoked tokens.
+type denylistStub struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (d denylistStub) IsAccessTokenRevoked(_ context.Context, tokenID string) (bool, error) {
+	return d.revoked[tokenID], d.err
+}
+
+func TestAuthMiddlewareRevokedToken(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetConfig(ConfigPath)
+
+	var (
+		logger   = zap.NewNop()
+		denylist = denylistStub{revoked: make(map[string]bool)}
+	)
+
+	authService := auth2.NewAuthService(cfg.Services.Auth, logger, auth2.WithDenylist(denylist))
+
+	revokedToken, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", "editor")
+	if err != nil {
+		return
+	}
+
+	validToken, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", "editor")
+	if err != nil {
+		return
+	}
+
+	// Revoke the first token by its jti claim
+	claims, err := authService.ParseAuthToken(revokedToken)
+	if err != nil {
+		return
+	}
+
+	denylist.revoked[claims.ID] = true
+
+	handler := auth.Middleware(nil, authService, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	testCases := []struct {
+		name           string
+		authToken      string
+		expectedStatus int
+	}{
+		{
+			name:           "RevokedToken",
+			authToken:      auth.AuthorizationPrefix + " " + revokedToken,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "NotRevokedToken",
+			authToken:      auth.AuthorizationPrefix + " " + validToken,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			request := httptest.NewRequest(http.MethodGet, "/protected", nil)
+			request.Header.Set("Authorization", tc.authToken)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+func TestAuthMiddlewareRevocationUnavailable(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetConfig(ConfigPath)
+
+	var (
+		logger   = zap.NewNop()
+		denylist = denylistStub{err: errors.New("database is down")}
+	)
+
+	authService := auth2.NewAuthService(cfg.Services.Auth, logger, auth2.WithDenylist(denylist))
+
+	token, _, err := authService.GenerateAuthToken("d83d97ab-ff68-4de2-b2a9-7cd5f0fc9a5e", "editor")
+	if err != nil {
+		return
+	}
+
+	handler := auth.Middleware(nil, authService, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	request.Header.Set("Authorization", auth.AuthorizationPrefix+" "+token)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	// A token is not trusted while its revocation status is unknown
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), auth.ErrRevocationUnavailable.Error())
+}
@@ -21,7 +21,8 @@ var (
 func Middleware(corsAllowedOrigins []string, logger *zap.Logger) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Authorization")
+			w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Authorization, If-Match")
+			w.Header().Set("Access-Control-Expose-Headers", "ETag, Content-Disposition")
 			w.Header().Set("Access-Control-Allow-Credentials", "true")
 
 			origin := r.Header.Get(HeaderOrigin)
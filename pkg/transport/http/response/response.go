@@ -6,6 +6,7 @@ import (
 	transportHttp "film-management/pkg/transport/http"
 	"film-management/pkg/validation"
 	endpointKit "github.com/go-kit/kit/endpoint"
+	httpKitTransport "github.com/go-kit/kit/transport/http"
 	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 	jsoniter "github.com/json-iterator/go"
@@ -43,6 +44,15 @@ func EncodeHTTPResponse(ctx context.Context, w http.ResponseWriter, response int
 		return nil
 	}
 
+	// Set headers of response, e.g. ETag
+	if h, ok := response.(httpKitTransport.Headerer); ok {
+		for key, values := range h.Headers() {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+	}
+
 	// Set Content-Type header
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
@@ -81,6 +91,12 @@ func createErrorResponse(err error) (data interface{}, code int) {
 	case errors.Is(err, transportHttp.ErrBadRouting),
 		errors.Is(err, transportHttp.ErrJSONDecode):
 		data, code = handleBadRequestErrors(err)
+	case errors.Is(err, transportHttp.ErrUnsupportedMediaType):
+		data, code = handleUnsupportedMediaTypeErrors(err)
+	case errors.Is(err, transportHttp.ErrNotAcceptable):
+		data, code = handleNotAcceptableErrors(err)
+	case errors.Is(err, transportHttp.ErrRequestEntityTooLarge):
+		data, code = handleRequestEntityTooLargeErrors(err)
 	case errors.Is(err, transportHttp.ErrNotFound),
 		errors.As(err, &customError.NotFoundError{}):
 		data, code = handleNotFoundError(err)
@@ -89,6 +105,12 @@ func createErrorResponse(err error) (data interface{}, code int) {
 	case errors.As(err, &customError.CorsError{}) ||
 		errors.As(err, &customError.PermissionError{}):
 		data, code = handlePermissionErrors(err)
+	case errors.As(err, &customError.PreconditionFailedError{}):
+		data, code = handlePreconditionFailedErrors(err)
+	case errors.As(err, &customError.PreconditionRequiredError{}):
+		data, code = handlePreconditionRequiredErrors(err)
+	case errors.As(err, &customError.UnavailableError{}):
+		data, code = handleServiceUnavailableErrors(err)
 	default:
 		data, code = handleDefaultErrors(err)
 	}
@@ -118,6 +140,39 @@ func handlePermissionErrors(err error) (data interface{}, code int) {
 	return
 }
 
+// handlePreconditionFailedErrors is the common method to handle all precondition failed errors.
+func handlePreconditionFailedErrors(err error) (data interface{}, code int) {
+	data = ErrorResponse{
+		Code:    http.StatusPreconditionFailed,
+		Message: err.Error(),
+	}
+	code = http.StatusPreconditionFailed
+
+	return
+}
+
+// handlePreconditionRequiredErrors is the common method to handle all precondition required errors.
+func handlePreconditionRequiredErrors(err error) (data interface{}, code int) {
+	data = ErrorResponse{
+		Code:    http.StatusPreconditionRequired,
+		Message: err.Error(),
+	}
+	code = http.StatusPreconditionRequired
+
+	return
+}
+
+// handleServiceUnavailableErrors is the common method to handle all service unavailable errors.
+func handleServiceUnavailableErrors(err error) (data interface{}, code int) {
+	data = ErrorResponse{
+		Code:    http.StatusServiceUnavailable,
+		Message: err.Error(),
+	}
+	code = http.StatusServiceUnavailable
+
+	return
+}
+
 // handleValidationErrors is the common method to handle all validation errors.
 func handleValidationErrors(validationErr map[string]string) (data interface{}, code int) {
 	data = ErrorResponseValidation{
@@ -141,6 +196,39 @@ func handleBadRequestErrors(err error) (data interface{}, code int) {
 	return
 }
 
+// handleUnsupportedMediaTypeErrors is the common method to handle all unsupported media type errors.
+func handleUnsupportedMediaTypeErrors(err error) (data interface{}, code int) {
+	data = ErrorResponse{
+		Code:    http.StatusUnsupportedMediaType,
+		Message: err.Error(),
+	}
+	code = http.StatusUnsupportedMediaType
+
+	return
+}
+
+// handleRequestEntityTooLargeErrors is the common method to handle all request entity too large errors.
+func handleRequestEntityTooLargeErrors(err error) (data interface{}, code int) {
+	data = ErrorResponse{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: err.Error(),
+	}
+	code = http.StatusRequestEntityTooLarge
+
+	return
+}
+
+// handleNotAcceptableErrors is the common method to handle all not acceptable errors.
+func handleNotAcceptableErrors(err error) (data interface{}, code int) {
+	data = ErrorResponse{
+		Code:    http.StatusNotAcceptable,
+		Message: err.Error(),
+	}
+	code = http.StatusNotAcceptable
+
+	return
+}
+
 // handleNotFoundError is the common method to handle all not found errors.
 func handleNotFoundError(err error) (data interface{}, code int) {
 	data = ErrorResponse{
@@ -0,0 +1,92 @@
+package response_test
+
+import (
+	"context"
+	customError "film-management/pkg/errors"
+	transportHttp "film-management/pkg/transport/http"
+	"film-management/pkg/transport/http/response"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type headersResponse struct {
+	Item string `json:"item"`
+}
+
+func (r headersResponse) Headers() http.Header {
+	return http.Header{"Etag": []string{`"3"`}}
+}
+
+func TestEncodeHTTPResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+
+	err := response.EncodeHTTPResponse(context.Background(), recorder, headersResponse{Item: "film"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, `"3"`, recorder.Header().Get("ETag"))
+}
+
+func TestEncodeErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{
+			name:         "Precondition failed",
+			err:          customError.PreconditionFailedError{Err: errors.New("film was changed")},
+			expectedCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:         "Precondition required",
+			err:          customError.PreconditionRequiredError{Err: errors.New("if-match is required")},
+			expectedCode: http.StatusPreconditionRequired,
+		},
+		{
+			name:         "Unsupported media type",
+			err:          transportHttp.ErrUnsupportedMediaType,
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "Not acceptable",
+			err:          transportHttp.ErrNotAcceptable,
+			expectedCode: http.StatusNotAcceptable,
+		},
+		{
+			name:         "Request entity too large",
+			err:          errors.Wrap(transportHttp.ErrRequestEntityTooLarge, "file is larger than 32 MB"),
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "Service unavailable",
+			err:          errors.Wrap(customError.UnavailableError{Err: errors.New("database is down")}, "auth"),
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "Wrapped precondition failed",
+			err:          errors.Wrap(customError.PreconditionFailedError{Err: errors.New("film was changed")}, "update"),
+			expectedCode: http.StatusPreconditionFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+
+			response.EncodeError(context.Background(), tc.err, recorder)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+		})
+	}
+}
@@ -1,9 +1,15 @@
 package http
 
 import (
+	customError "film-management/pkg/errors"
 	"github.com/gorilla/mux"
 	"net/http"
 	"strconv"
+	"strings"
+)
+
+const (
+	HeaderIfMatch = "If-Match"
 )
 
 // GetValueFromPath Get value from path.
@@ -28,3 +34,27 @@ func GetIntParamFromHTTPRequest(paramName string, r *http.Request, target *int)
 
 	return nil
 }
+
+// GetVersionFromIfMatch Get version of a resource from ETag in If-Match header, target is not changed without header.
+// If-Match: * matches any version of an existing resource, it sets target to anyVersion.
+func GetVersionFromIfMatch(r *http.Request, target *int, anyVersion int) error {
+	ifMatch := strings.TrimSpace(r.Header.Get(HeaderIfMatch))
+
+	switch ifMatch {
+	case "":
+		return nil
+	case "*":
+		*target = anyVersion
+
+		return nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+	if err != nil || version < 1 {
+		return customError.PreconditionFailedError{Err: ErrIfMatchWrong}
+	}
+
+	*target = version
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package http_test
+
+import (
+	customError "film-management/pkg/errors"
+	transportHttp "film-management/pkg/transport/http"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestGetVersionFromIfMatch tests getting a version from If-Match header.
+func TestGetVersionFromIfMatch(t *testing.T) {
+	t.Parallel()
+
+	const anyVersion = -1
+
+	testCases := []struct {
+		name            string
+		ifMatch         string
+		expectedVersion int
+		expectedErr     error
+	}{
+		{name: "No header", ifMatch: "", expectedVersion: 7},
+		{name: "Strong ETag", ifMatch: `"3"`, expectedVersion: 3},
+		{name: "Weak ETag", ifMatch: `W/"3"`, expectedVersion: 3},
+		{name: "Any version", ifMatch: "*", expectedVersion: anyVersion},
+		{name: "Any version with spaces", ifMatch: " * ", expectedVersion: anyVersion},
+		{name: "Not a version", ifMatch: `"abc"`, expectedVersion: 7, expectedErr: customError.PreconditionFailedError{Err: transportHttp.ErrIfMatchWrong}},
+		{name: "Zero version", ifMatch: `"0"`, expectedVersion: 7, expectedErr: customError.PreconditionFailedError{Err: transportHttp.ErrIfMatchWrong}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodDelete, "/films/1", nil)
+			if tc.ifMatch != "" {
+				r.Header.Set(transportHttp.HeaderIfMatch, tc.ifMatch)
+			}
+
+			version := 7
+
+			err := transportHttp.GetVersionFromIfMatch(r, &version, anyVersion)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedVersion, version)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 type Validator interface {
 	Validate(i interface{}) error
+	ValidatePartial(i interface{}, fields ...string) error
 }
 
 // customValidator is a struct for validator.
@@ -22,6 +23,11 @@ func (v *customValidator) Validate(i interface{}) error {
 	return v.validate.Struct(i)
 }
 
+// ValidatePartial is a method for validate only the fields of struct.
+func (v *customValidator) ValidatePartial(i interface{}, fields ...string) error {
+	return v.validate.StructPartial(i, fields...)
+}
+
 var validatorOnce sync.Once
 var myValidator Validator
 
@@ -0,0 +1,179 @@
+package memory
+
+import (
+	"context"
+	"film-management/internal/director/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"sort"
+	"strings"
+)
+
+// DirectorRepository is a struct for work with directors in memory.
+type DirectorRepository struct {
+	store  *Store
+	logger *zap.Logger
+}
+
+// NewDirectorRepository is a constructor for DirectorRepository.
+func NewDirectorRepository(store *Store, logger *zap.Logger) *DirectorRepository {
+	return &DirectorRepository{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// FindAllDirectors is a method to find all directors.
+func (d DirectorRepository) FindAllDirectors(_ context.Context, filterSortLimit query.FilterSortLimit) ([]models.Director, pagination.Pagination, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	var name string
+
+	// Check filters
+	for field, value := range filterSortLimit.Filter {
+		switch field {
+		case "name":
+			name, _ = value.(string)
+		default:
+			return nil, pagination.Pagination{}, customError.ValidationError{Field: field, Err: domain.ErrDirectorUnknownField}
+		}
+	}
+
+	// Get sort key
+	var compare func(a, b models.Director) int
+
+	switch filterSortLimit.Sort.Field() {
+	case "name":
+		compare = func(a, b models.Director) int { return strings.Compare(a.Name, b.Name) }
+	case "films_count":
+		compare = func(a, b models.Director) int { return compareFloat(float64(a.FilmsCount), float64(b.FilmsCount)) }
+	default:
+		return nil, pagination.Pagination{}, customError.ValidationError{Field: "sort", Err: domain.ErrDirectorUnknownField}
+	}
+
+	directors := make([]models.Director, 0)
+
+	for _, director := range d.store.directors {
+		if !containsFold(director.Name, name) {
+			continue
+		}
+
+		director.FilmsCount = d.store.directorFilmsCount(director.ID)
+		directors = append(directors, director)
+	}
+
+	// Directors with the same key are ordered by ID
+	descending := isDescending(filterSortLimit.Sort.Order())
+
+	sort.Slice(directors, func(i, j int) bool {
+		if c := compare(directors[i], directors[j]); c != 0 {
+			return (c < 0) != descending
+		}
+
+		return directors[i].ID < directors[j].ID
+	})
+
+	return page(directors, filterSortLimit.Limit, filterSortLimit.Offset),
+		pagination.NewPagination(len(directors), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// FindOneDirector is a method to find one director by id.
+func (d DirectorRepository) FindOneDirector(_ context.Context, directorID uint) (models.Director, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	director, ok := d.store.directors[directorID]
+	if !ok {
+		return models.Director{}, errors.Wrap(domain.ErrDirectorNotFound, "directorRepo.FindOneDirector.First")
+	}
+
+	director.FilmsCount = d.store.directorFilmsCount(directorID)
+
+	return director, nil
+}
+
+// FindDirectorByName is a method to find one director by name.
+func (d DirectorRepository) FindDirectorByName(_ context.Context, name string) (models.Director, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	for _, director := range d.store.directors {
+		if director.Name == name {
+			return director, nil
+		}
+	}
+
+	return models.Director{}, errors.Wrap(domain.ErrDirectorNotFound, "directorRepo.FindDirectorByName.First")
+}
+
+// FindAllDirectorFilms is a method to find all films of director, newest first.
+func (d DirectorRepository) FindAllDirectorFilms(_ context.Context, directorID uint) ([]models.Film, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+
+	films := make([]models.Film, 0)
+
+	for _, film := range d.store.films {
+		if film.DirectorID == directorID && !isDeleted(film) {
+			film.Genres = d.store.filmGenresOf(film.UUID)
+			films = append(films, film)
+		}
+	}
+
+	sortFilms(films, "release_date", true)
+
+	return films, nil
+}
+
+// UpdateDirector is a method to update director.
+func (d DirectorRepository) UpdateDirector(_ context.Context, model *models.Director) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	// Name is unique
+	for _, director := range d.store.directors {
+		if director.Name == model.Name && director.ID != model.ID {
+			return errors.Wrap(domain.ErrDirectorExists, "directorRepo.UpdateDirector.Updates")
+		}
+	}
+
+	if director, ok := d.store.directors[model.ID]; ok {
+		director.Name = model.Name
+		d.store.directors[model.ID] = director
+	}
+
+	return nil
+}
+
+// MergeDirectors is a method to move films of source directors to target director and delete source directors.
+// Films in trash are not moved, they are deleted with source directors.
+func (d DirectorRepository) MergeDirectors(_ context.Context, targetID uint, sourceIDs []uint) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+
+	// Move films to target director
+	for filmID, film := range d.store.films {
+		if containsID(sourceIDs, film.DirectorID) && !isDeleted(film) {
+			film.DirectorID = targetID
+			d.store.films[filmID] = film
+		}
+	}
+
+	// Delete source directors
+	for filmID, film := range d.store.films {
+		if containsID(sourceIDs, film.DirectorID) {
+			d.store.deleteFilm(filmID)
+		}
+	}
+
+	for _, sourceID := range sourceIDs {
+		delete(d.store.directors, sourceID)
+	}
+
+	return nil
+}
@@ -0,0 +1,529 @@
+package memory
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	userModels "film-management/internal/user/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	querySort "film-management/pkg/query/sort"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FilmRepository is a struct for work with films, genres, casts, ratings, reviews and revisions in memory.
+type FilmRepository struct {
+	store  *Store
+	logger *zap.Logger
+}
+
+// NewFilmRepository is a constructor for FilmRepository.
+func NewFilmRepository(store *Store, logger *zap.Logger) *FilmRepository {
+	return &FilmRepository{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// CreateFilm is a method to create film with its first revision.
+func (f FilmRepository) CreateFilm(_ context.Context, model *models.Film, revision *models.Revision) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	// Title is unique, films in trash keep their titles
+	if f.store.filmExistsWithTitle(model.Title, uuid.Nil) {
+		return errors.Wrap(domain.ErrFilmExistsWithTitle, "filmRepo.CreateFilm.Create")
+	}
+
+	// Check if the director with the specified name exists and create it
+	f.store.createOrUpdateDirector(model)
+
+	// Create the film
+	model.UUID = uuid.New()
+	if model.Version == 0 {
+		model.Version = 1
+	}
+	model.CreatedAt, model.UpdatedAt = now(), now()
+
+	f.store.saveFilm(model)
+
+	// Save the first revision of the film
+	revision.FilmID = model.UUID
+	f.store.createRevision(revision)
+
+	return nil
+}
+
+// UpdateFilm is a method to update film of the model version and save its revision, the version is incremented.
+func (f FilmRepository) UpdateFilm(_ context.Context, model *models.Film, revision *models.Revision) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	// The film is not updated if it was changed after it was read
+	row, ok := f.store.films[model.UUID]
+	if !ok || isDeleted(row) || row.Version != model.Version {
+		return errors.Wrap(domain.ErrFilmVersionMismatch, "filmRepo.UpdateFilm.Version")
+	}
+
+	if f.store.filmExistsWithTitle(model.Title, model.UUID) {
+		return errors.Wrap(domain.ErrFilmExistsWithTitle, "filmRepo.UpdateFilm.Updates")
+	}
+
+	model.Version++
+
+	// Check if the director with the specified name exists
+	f.store.createOrUpdateDirector(model)
+
+	// Update the film, the aggregate score is maintained by SaveRating only
+	model.CreatorID, model.CreatedAt = row.CreatorID, row.CreatedAt
+	model.Rating, model.RatingCount = row.Rating, row.RatingCount
+	model.UpdatedAt = now()
+
+	// Replace genres and credits
+	f.store.saveFilm(model)
+
+	f.store.createRevision(revision)
+
+	return nil
+}
+
+// saveFilm is a method to save the row of the film and replace its genres and credits.
+// Genres and people without ID are created by name.
+func (s *Store) saveFilm(model *models.Film) {
+	row := *model
+	row.Genres, row.Credits, row.Ratings, row.Reviews, row.Revisions = nil, nil, nil, nil, nil
+	row.Director, row.Creator = models.Director{}, userModels.User{}
+	row.Relevance, row.Highlight = 0, ""
+	s.films[model.UUID] = row
+
+	// Replace genres
+	genreIDs := make(map[uint]struct{}, len(model.Genres))
+	for i := range model.Genres {
+		if model.Genres[i].ID == 0 {
+			model.Genres[i] = s.findOrCreateGenre(model.Genres[i].Name)
+		}
+
+		genreIDs[model.Genres[i].ID] = struct{}{}
+	}
+
+	s.filmGenres[model.UUID] = genreIDs
+
+	// Replace credits
+	for id, credit := range s.credits {
+		if credit.FilmID == model.UUID {
+			delete(s.credits, id)
+		}
+	}
+
+	for i := range model.Credits {
+		credit := &model.Credits[i]
+		if credit.Cast.ID == 0 && credit.CastID == 0 {
+			credit.Cast = s.findOrCreateCast(credit.Cast.Name)
+		}
+
+		if credit.CastID == 0 {
+			credit.CastID = credit.Cast.ID
+		}
+
+		if credit.Department == "" {
+			credit.Department = models.DepartmentCast
+		}
+
+		credit.ID = s.nextID("credits")
+		credit.FilmID = model.UUID
+
+		row := *credit
+		row.Cast = models.Cast{}
+		s.credits[row.ID] = row
+	}
+}
+
+// createOrUpdateDirector is a method to find the director of the film by name or create it.
+func (s *Store) createOrUpdateDirector(model *models.Film) {
+	for _, director := range s.directors {
+		if director.Name == model.Director.Name {
+			model.Director.ID = director.ID
+			model.DirectorID = director.ID
+
+			return
+		}
+	}
+
+	model.Director.ID = s.nextID("directors")
+	model.Director.FilmsCount = 0
+	model.DirectorID = model.Director.ID
+	s.directors[model.Director.ID] = model.Director
+}
+
+// filmExistsWithTitle is a method to check if another film has the title in any case, films in trash are checked too.
+func (s *Store) filmExistsWithTitle(title string, filmID uuid.UUID) bool {
+	for _, film := range s.films {
+		if strings.EqualFold(film.Title, title) && film.UUID != filmID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FindOneFilmByUUID is a method to find one film by UUID.
+func (f FilmRepository) FindOneFilmByUUID(_ context.Context, uuid uuid.UUID) (models.Film, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	film, ok := f.store.films[uuid]
+	if !ok || isDeleted(film) {
+		return models.Film{}, errors.Wrap(domain.ErrFilmNotFound, "filmRepo.FindOneFilmByUUID.First")
+	}
+
+	return film, nil
+}
+
+// FindOneFilmForViewByUUID is a method to find one film by UUID.
+func (f FilmRepository) FindOneFilmForViewByUUID(_ context.Context, uuid uuid.UUID) (models.Film, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	film, ok := f.store.films[uuid]
+	if !ok || isDeleted(film) {
+		return models.Film{}, errors.Wrap(domain.ErrFilmNotFound, "filmRepo.FindOneFilmForViewByUUID.First")
+	}
+
+	film = f.store.filmForView(film)
+	film.Creator = f.store.users[film.CreatorID]
+
+	return film, nil
+}
+
+// FindAllFilms is a method to find all films.
+// Films are paged by offset or by cursor, count of films is optional.
+func (f FilmRepository) FindAllFilms(_ context.Context, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	// Find all films with the filter
+	films, err := f.store.filterFilms(filterSortLimit.Filter)
+	if err != nil {
+		f.logger.Error("filmRepo.FindAllFilms.filterFilms", zap.Error(err))
+
+		return nil, pagination.Pagination{}, err
+	}
+
+	count := len(films)
+
+	if _, ok := filmSortColumns[filterSortLimit.Sort.Field()]; !ok {
+		return nil, pagination.Pagination{}, domain.ErrFilmFindAll
+	}
+
+	sortFilms(films, filterSortLimit.Sort.Field(), isDescending(filterSortLimit.Sort.Order()))
+
+	// Continue after the cursor, the cursor is not a filter and does not change count of films
+	if filterSortLimit.Cursor != nil {
+		after, err := filmKeysetCondition(filterSortLimit.Sort, *filterSortLimit.Cursor)
+		if err != nil {
+			return nil, pagination.Pagination{}, err
+		}
+
+		films = filmsWhere(films, after)
+	}
+
+	// One more film than the limit shows if there is a next page
+	films = page(films, filterSortLimit.Limit+1, filterSortLimit.Offset)
+	for i := range films {
+		films[i] = f.store.filmForView(films[i])
+	}
+
+	// Get cursor of the next page
+	var nextCursor string
+
+	if len(films) > filterSortLimit.Limit {
+		films = films[:filterSortLimit.Limit]
+		nextCursor = filmNextCursor(filterSortLimit.Sort, films[len(films)-1])
+	}
+
+	p := pagination.NewCursorPagination(filterSortLimit.Limit, nextCursor)
+
+	if !filterSortLimit.WithCount {
+		return films, p, nil
+	}
+
+	// Page number is known in offset mode only
+	if filterSortLimit.Cursor == nil {
+		p = pagination.NewPagination(count, filterSortLimit.Limit, filterSortLimit.Offset)
+		p.NextCursor = nextCursor
+
+		return films, p, nil
+	}
+
+	return films, p.WithTotalCount(count), nil
+}
+
+// maxFacetValues is a maximum number of values of a facet, values with more films are kept.
+const maxFacetValues = 20
+
+// CountFilmFacets is a method to count films with the filter by values of the facets.
+func (f FilmRepository) CountFilmFacets(_ context.Context, filter query.Filter, facets []string) ([]models.FacetCount, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	films, err := f.store.filterFilms(filter)
+	if err != nil {
+		f.logger.Error("filmRepo.CountFilmFacets.filterFilms", zap.Error(err))
+
+		return nil, err
+	}
+
+	var counts []models.FacetCount
+
+	for _, facet := range facets {
+		values := make(map[string]int64)
+
+		switch facet {
+		case models.FacetGenres:
+			for _, film := range films {
+				for genreID := range f.store.filmGenres[film.UUID] {
+					values[f.store.genres[genreID].Name]++
+				}
+			}
+		case models.FacetDecade:
+			for _, film := range films {
+				values[strconv.Itoa(film.ReleaseDate.Year()/10*10)]++
+			}
+		case models.FacetDirector:
+			for _, film := range films {
+				values[f.store.directors[film.DirectorID].Name]++
+			}
+		default:
+			return nil, customError.ValidationError{Field: "facets", Err: domain.ErrFilmUnknownField}
+		}
+
+		facetCounts := make([]models.FacetCount, 0, len(values))
+		for value, count := range values {
+			facetCounts = append(facetCounts, models.FacetCount{Facet: facet, Value: value, Count: count})
+		}
+
+		// Decades are ordered by value, other facets by count of films
+		sort.Slice(facetCounts, func(i, j int) bool {
+			if facet == models.FacetDecade {
+				return facetCounts[i].Value > facetCounts[j].Value
+			}
+
+			if facetCounts[i].Count != facetCounts[j].Count {
+				return facetCounts[i].Count > facetCounts[j].Count
+			}
+
+			return facetCounts[i].Value < facetCounts[j].Value
+		})
+
+		counts = append(counts, page(facetCounts, maxFacetValues, 0)...)
+	}
+
+	return counts, nil
+}
+
+// DeleteFilm is a method to move film of the version to trash and save its revision, the film is kept until purge.
+func (f FilmRepository) DeleteFilm(_ context.Context, uuid uuid.UUID, version int, revision *models.Revision) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	// The film was changed or deleted after it was read
+	film, ok := f.store.films[uuid]
+	if !ok || isDeleted(film) || film.Version != version {
+		return errors.Wrap(domain.ErrFilmVersionMismatch, "filmRepo.DeleteFilm.Delete")
+	}
+
+	film.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	f.store.films[uuid] = film
+
+	f.store.createRevision(revision)
+
+	return nil
+}
+
+// FindOneDeletedFilmByUUID is a method to find one film in trash by UUID.
+func (f FilmRepository) FindOneDeletedFilmByUUID(_ context.Context, uuid uuid.UUID) (models.Film, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	film, ok := f.store.films[uuid]
+	if !ok || !isDeleted(film) {
+		return models.Film{}, errors.Wrap(domain.ErrFilmNotFoundInTrash, "filmRepo.FindOneDeletedFilmByUUID.First")
+	}
+
+	return f.store.filmForView(film), nil
+}
+
+// FindAllDeletedFilms is a method to find all films of creator in trash.
+func (f FilmRepository) FindAllDeletedFilms(_ context.Context, creatorID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	var films []models.Film
+
+	for _, film := range f.store.films {
+		if film.CreatorID == creatorID && isDeleted(film) {
+			films = append(films, film)
+		}
+	}
+
+	// Sort deleted films, films with the same key are ordered by UUID
+	var less func(a, b models.Film) int
+
+	switch filterSortLimit.Sort.Field() {
+	case "title":
+		less = func(a, b models.Film) int { return strings.Compare(a.Title, b.Title) }
+	case "deleted_at":
+		less = func(a, b models.Film) int { return a.DeletedAt.Time.Compare(b.DeletedAt.Time) }
+	default:
+		return nil, pagination.Pagination{}, customError.ValidationError{Field: "sort", Err: domain.ErrFilmUnknownField}
+	}
+
+	descending := isDescending(filterSortLimit.Sort.Order())
+
+	sort.Slice(films, func(i, j int) bool {
+		if c := less(films[i], films[j]); c != 0 {
+			return (c < 0) != descending
+		}
+
+		return films[i].UUID.String() < films[j].UUID.String()
+	})
+
+	count := len(films)
+
+	films = page(films, filterSortLimit.Limit, filterSortLimit.Offset)
+	for i := range films {
+		films[i] = f.store.filmForView(films[i])
+	}
+
+	return films, pagination.NewPagination(count, filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// RestoreFilm is a method to restore film from trash and save its revision.
+func (f FilmRepository) RestoreFilm(_ context.Context, uuid uuid.UUID, revision *models.Revision) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	if film, ok := f.store.films[uuid]; ok {
+		film.DeletedAt = gorm.DeletedAt{}
+		f.store.films[uuid] = film
+	}
+
+	f.store.createRevision(revision)
+
+	return nil
+}
+
+// PurgeDeletedFilms is a method to permanently delete films moved to trash before the time.
+// Genres, credits, ratings, reviews and watchlist items of the films are deleted too.
+func (f FilmRepository) PurgeDeletedFilms(_ context.Context, deletedBefore time.Time) (int64, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	var count int64
+
+	for filmID, film := range f.store.films {
+		if isDeleted(film) && film.DeletedAt.Time.Before(deletedBefore) {
+			f.store.deleteFilm(filmID)
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// createRevision is a method to save revision of film with the next number.
+func (s *Store) createRevision(revision *models.Revision) {
+	var lastNumber int
+
+	for _, r := range s.revisions {
+		if r.FilmID == revision.FilmID && r.Number > lastNumber {
+			lastNumber = r.Number
+		}
+	}
+
+	revision.Number = lastNumber + 1
+	revision.ID = s.nextID("revisions")
+	revision.CreatedAt = now()
+
+	s.revisions[revision.ID] = *revision
+}
+
+// FindAllRevisions is a method to find all revisions of film.
+func (f FilmRepository) FindAllRevisions(_ context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Revision, pagination.Pagination, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	if filterSortLimit.Sort.Field() != "number" {
+		return nil, pagination.Pagination{}, domain.ErrRevisionFindAll
+	}
+
+	var revisions []models.Revision
+
+	for _, revision := range f.store.revisions {
+		if revision.FilmID == filmID {
+			revisions = append(revisions, revision)
+		}
+	}
+
+	descending := isDescending(filterSortLimit.Sort.Order())
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return (revisions[i].Number < revisions[j].Number) != descending
+	})
+
+	return page(revisions, filterSortLimit.Limit, filterSortLimit.Offset),
+		pagination.NewPagination(len(revisions), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// FindOneRevision is a method to find one revision of film by number.
+func (f FilmRepository) FindOneRevision(_ context.Context, filmID uuid.UUID, number int) (models.Revision, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	for _, revision := range f.store.revisions {
+		if revision.FilmID == filmID && revision.Number == number {
+			return revision, nil
+		}
+	}
+
+	return models.Revision{}, errors.Wrap(domain.ErrRevisionNotFound, "filmRepo.FindOneRevision.First")
+}
+
+// FilmExistsWithTitle checks if a film with the given filmID and title exists.
+// The operation parameter specifies the type of operation: "add" or "update".
+// Films in trash keep their titles until purge.
+func (f FilmRepository) FilmExistsWithTitle(_ context.Context, title string, filmID uuid.UUID, operation models.Operation) error {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	switch operation {
+	case models.OperationAdd:
+		if f.store.filmExistsWithTitle(title, uuid.Nil) {
+			return errors.Wrap(domain.ErrFilmExistsWithTitle, "filmRepo.FilmExists.OperationAdd.Count")
+		}
+	case models.OperationUpdate:
+		// Check if another film with the same title exists, except for the current film
+		if f.store.filmExistsWithTitle(title, filmID) {
+			return errors.Wrap(domain.ErrFilmExistsWithTitle, "filmRepo.FilmExists.OperationUpdate.Count")
+		}
+	default:
+		f.logger.Error("filmRepo.FilmExists.unknown operation", zap.String("operation", string(operation)))
+
+		return errors.Wrap(domain.ErrFilmExistsWithTitle, "filmRepo.FilmExists.unknown operation")
+	}
+
+	return nil
+}
+
+// isDescending is a function to check if the order of sort is descending, the order is in any case.
+func isDescending(order string) bool {
+	return strings.EqualFold(order, querySort.OrderDESC)
+}
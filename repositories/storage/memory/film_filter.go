@@ -0,0 +1,415 @@
+package memory
+
+import (
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/search"
+	querySort "film-management/pkg/query/sort"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+	"time"
+)
+
+// filmSortColumns is a map of sort fields of films to functions which compare films by the field.
+var filmSortColumns = map[string]func(a, b models.Film) int{
+	"title":        func(a, b models.Film) int { return strings.Compare(a.Title, b.Title) },
+	"release_date": func(a, b models.Film) int { return a.ReleaseDate.Compare(b.ReleaseDate) },
+	"rating":       func(a, b models.Film) int { return compareFloat(a.Rating, b.Rating) },
+	"relevance":    func(a, b models.Film) int { return compareFloat(a.Relevance, b.Relevance) },
+}
+
+// sortFilms is a function to sort films by the field, films with the same key are ordered by UUID in the same order.
+func sortFilms(films []models.Film, field string, descending bool) {
+	compare := filmSortColumns[field]
+
+	sort.Slice(films, func(i, j int) bool {
+		return compareFilmKeys(films[i], films[j], compare, descending) < 0
+	})
+}
+
+// compareFilmKeys is a function to compare films by the sort key and UUID in the order of sort.
+func compareFilmKeys(a, b models.Film, compare func(a, b models.Film) int, descending bool) int {
+	c := compare(a, b)
+	if c == 0 {
+		c = strings.Compare(a.UUID.String(), b.UUID.String())
+	}
+
+	if descending {
+		return -c
+	}
+
+	return c
+}
+
+// compareFloat is a function to compare two numbers.
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// filmKeysetCondition is a function to get condition of films after the cursor.
+func filmKeysetCondition(sortOption querySort.Sortable, cursor pagination.Cursor) (func(models.Film) bool, error) {
+	compare, ok := filmSortColumns[sortOption.Field()]
+	if !ok || sortOption.Field() == "relevance" {
+		return nil, customError.ValidationError{Field: "cursor", Err: domain.ErrFilmCursorSort}
+	}
+
+	// Check type of sort key value and get the film of the cursor
+	last := models.Film{UUID: cursor.UUID}
+
+	switch value := cursor.Value.(type) {
+	case string:
+		switch sortOption.Field() {
+		case "title":
+			last.Title = value
+		case "release_date":
+			releaseDate, err := time.Parse(time.DateOnly, value)
+			ok = err == nil
+			last.ReleaseDate = releaseDate
+		default:
+			ok = false
+		}
+	case float64:
+		ok = sortOption.Field() == "rating"
+		last.Rating = value
+	default:
+		ok = false
+	}
+
+	if !ok {
+		return nil, customError.ValidationError{Field: "cursor", Err: pagination.ErrCursorInvalid}
+	}
+
+	descending := isDescending(sortOption.Order())
+
+	return func(film models.Film) bool {
+		return compareFilmKeys(film, last, compare, descending) > 0
+	}, nil
+}
+
+// filmNextCursor is a function to get encoded cursor after the film or empty string if sort has no cursor.
+func filmNextCursor(sortOption querySort.Sortable, film models.Film) string {
+	var value interface{}
+
+	switch sortOption.Field() {
+	case "title":
+		value = film.Title
+	case "release_date":
+		value = film.ReleaseDate.Format(time.DateOnly)
+	case "rating":
+		value = film.Rating
+	default:
+		return ""
+	}
+
+	return pagination.NewCursor(sortOption, value, film.UUID).Encode()
+}
+
+// filmsWhere is a function to get films which match the condition.
+func filmsWhere(films []models.Film, condition func(models.Film) bool) []models.Film {
+	matched := make([]models.Film, 0, len(films))
+
+	for _, film := range films {
+		if condition(film) {
+			matched = append(matched, film)
+		}
+	}
+
+	return matched
+}
+
+// filterFilms is a method to find films which are not in trash with the filter.
+// Films found by full-text search have relevance and highlighted snippet.
+func (s *Store) filterFilms(filter query.Filter) ([]models.Film, error) {
+	conditions := make([]func(models.Film) bool, 0, len(filter))
+
+	// Build conditions of filters
+	for field, value := range filter {
+		condition, err := s.filmCondition(field, value)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	q, _ := filter["q"].(string)
+
+	films := make([]models.Film, 0)
+
+films:
+	for _, film := range s.films {
+		if isDeleted(film) {
+			continue
+		}
+
+		for _, condition := range conditions {
+			if !condition(film) {
+				continue films
+			}
+		}
+
+		if q != "" {
+			film.Relevance, film.Highlight = s.searchRank(film, q)
+		}
+
+		films = append(films, film)
+	}
+
+	return films, nil
+}
+
+// filmCondition is a method to get condition of the film filter.
+func (s *Store) filmCondition(field string, value interface{}) (func(models.Film) bool, error) {
+	switch field {
+	case "q":
+		return s.searchCondition(value)
+	case "title":
+		title, ok := value.(string)
+		if !ok {
+			return nil, customError.ValidationError{Field: "title", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool { return containsFold(film.Title, title) }, nil
+	case "release_date":
+		dates, ok := value.([]string)
+		if !ok || len(dates) != 2 {
+			return nil, customError.ValidationError{Field: "release_date", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool {
+			releaseDate := film.ReleaseDate.Format(time.DateOnly)
+
+			return releaseDate >= dates[0] && releaseDate <= dates[1]
+		}, nil
+	case "year":
+		year, ok := value.(int)
+		if !ok {
+			return nil, customError.ValidationError{Field: "year", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool { return film.ReleaseDate.Year() == year }, nil
+	case "genres", "genres_all", "genres_none":
+		return s.genresCondition(field, value)
+	case "director":
+		name, ok := value.(string)
+		if !ok {
+			return nil, customError.ValidationError{Field: "director", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool { return containsFold(s.directors[film.DirectorID].Name, name) }, nil
+	case "director_ids":
+		directorIDs, ok := value.([]uint)
+		if !ok || len(directorIDs) == 0 {
+			return nil, customError.ValidationError{Field: "director_ids", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool { return containsID(directorIDs, film.DirectorID) }, nil
+	case "cast":
+		name, ok := value.(string)
+		if !ok {
+			return nil, customError.ValidationError{Field: "cast", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool {
+			for _, credit := range s.credits {
+				if credit.FilmID == film.UUID && credit.Department == models.DepartmentCast && containsFold(s.casts[credit.CastID].Name, name) {
+					return true
+				}
+			}
+
+			return false
+		}, nil
+	case "cast_ids":
+		castIDs, ok := value.([]uint)
+		if !ok || len(castIDs) == 0 {
+			return nil, customError.ValidationError{Field: "cast_ids", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool {
+			for _, credit := range s.credits {
+				if credit.FilmID == film.UUID && containsID(castIDs, credit.CastID) {
+					return true
+				}
+			}
+
+			return false
+		}, nil
+	case "creator_id":
+		creatorID, ok := value.(uuid.UUID)
+		if !ok {
+			return nil, customError.ValidationError{Field: "creator_id", Err: domain.ErrFilmFilterWrong}
+		}
+
+		return func(film models.Film) bool { return film.CreatorID == creatorID }, nil
+	default:
+		return nil, customError.ValidationError{Field: field, Err: domain.ErrFilmUnknownField}
+	}
+}
+
+// genresCondition is a method to get condition of genres filter, films match any, all or none of the genres by the field.
+func (s *Store) genresCondition(field string, value interface{}) (func(models.Film) bool, error) {
+	genreNames, ok := value.([]string)
+	if !ok {
+		return nil, customError.ValidationError{Field: "genres", Err: domain.ErrFilmFilterWrong}
+	}
+
+	// Get genre IDs, names of the filter are in lower case
+	names := make(map[string]struct{}, len(genreNames))
+	for _, name := range genreNames {
+		names[name] = struct{}{}
+	}
+
+	genreIDs := make(map[uint]struct{})
+
+	for _, genre := range s.genres {
+		if _, ok := names[strings.ToLower(genre.Name)]; ok {
+			genreIDs[genre.ID] = struct{}{}
+		}
+	}
+
+	if len(genreIDs) == 0 {
+		return nil, customError.ValidationError{Field: "genres", Err: domain.ErrFilmGenresNotFound}
+	}
+
+	// A film can not have a genre which does not exist
+	if field == "genres_all" && len(genreIDs) < len(names) {
+		return nil, customError.ValidationError{Field: "genres", Err: domain.ErrFilmGenresNotFound}
+	}
+
+	return func(film models.Film) bool {
+		var matched int
+
+		for genreID := range s.filmGenres[film.UUID] {
+			if _, ok := genreIDs[genreID]; ok {
+				matched++
+			}
+		}
+
+		switch field {
+		case "genres_all":
+			return matched == len(genreIDs)
+		case "genres_none":
+			return matched == 0
+		default:
+			return matched > 0
+		}
+	}, nil
+}
+
+// Weights of full-text search by fields of film, they are the default weights of ts_rank in Postgres.
+const (
+	searchWeightTitle    = 1.0
+	searchWeightPeople   = 0.4
+	searchWeightSynopsis = 0.2
+)
+
+// searchCondition is a method to get condition of full-text search over title, synopsis, director and credited people.
+// Search in memory has no stemming, every word of the query matches words of the film which start with it,
+// words prefixed with "-" must not match.
+func (s *Store) searchCondition(value interface{}) (func(models.Film) bool, error) {
+	q, ok := value.(string)
+	if !ok {
+		return nil, customError.ValidationError{Field: "q", Err: domain.ErrFilmFilterWrong}
+	}
+
+	include, exclude := search.Terms(q)
+
+	return func(film models.Film) bool {
+		words := search.Words(s.searchText(film))
+
+		for _, term := range exclude {
+			if matchesWord(words, term) {
+				return false
+			}
+		}
+
+		for _, term := range include {
+			if !matchesWord(words, term) {
+				return false
+			}
+		}
+
+		return len(include) > 0
+	}, nil
+}
+
+// searchText is a method to get text of the film for full-text search.
+func (s *Store) searchText(film models.Film) string {
+	return strings.Join([]string{film.Title, s.searchPeople(film), film.Synopsis}, " ")
+}
+
+// searchPeople is a method to get names of director and people credited in the film.
+func (s *Store) searchPeople(film models.Film) string {
+	names := []string{s.directors[film.DirectorID].Name}
+
+	for _, credit := range s.credits {
+		if credit.FilmID == film.UUID {
+			names = append(names, s.casts[credit.CastID].Name)
+		}
+	}
+
+	return strings.Join(names, " ")
+}
+
+// searchRank is a method to get relevance of the film for the query and snippet with highlighted words.
+func (s *Store) searchRank(film models.Film, q string) (float64, string) {
+	include, _ := search.Terms(q)
+
+	title, people, synopsis := search.Words(film.Title), search.Words(s.searchPeople(film)), search.Words(film.Synopsis)
+
+	var relevance float64
+
+	for _, term := range include {
+		if matchesWord(title, term) {
+			relevance += searchWeightTitle
+		}
+
+		if matchesWord(people, term) {
+			relevance += searchWeightPeople
+		}
+
+		if matchesWord(synopsis, term) {
+			relevance += searchWeightSynopsis
+		}
+	}
+
+	return relevance, search.Highlight(film.Title+". "+film.Synopsis, include)
+}
+
+// matchesWord is a function to check if any of the words starts with the term.
+func matchesWord(words []string, term string) bool {
+	for _, word := range words {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsFold is a function to check if the text contains the part in any case, like ILIKE.
+func containsFold(text string, part string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(part))
+}
+
+// containsID is a function to check if the slice contains the ID.
+func containsID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+
+	return false
+}
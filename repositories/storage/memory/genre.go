@@ -0,0 +1,212 @@
+package memory
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+)
+
+// CreateGenre creates a new genre.
+func (f FilmRepository) CreateGenre(_ context.Context, genre *models.Genre) (*models.Genre, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	// Name is unique
+	if _, ok := f.store.findGenreByName(genre.Name); ok {
+		return nil, errors.Wrap(domain.ErrGenreExists, "filmRepo.CreateGenre.Create")
+	}
+
+	genre.ID = f.store.nextID("genres")
+	genre.FilmsCount = 0
+	f.store.genres[genre.ID] = *genre
+
+	return genre, nil
+}
+
+// GetGenresByNames returns genres by names.
+func (f FilmRepository) GetGenresByNames(_ context.Context, names []string) ([]models.Genre, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	genres := make([]models.Genre, 0, len(names))
+
+	for _, genre := range f.store.genres {
+		for _, name := range names {
+			if genre.Name == name {
+				genres = append(genres, genre)
+
+				break
+			}
+		}
+	}
+
+	sort.Slice(genres, func(i, j int) bool { return genres[i].ID < genres[j].ID })
+
+	return genres, nil
+}
+
+// UpdateGenre updates a genre.
+func (f FilmRepository) UpdateGenre(_ context.Context, genre *models.Genre) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	if existing, ok := f.store.findGenreByName(genre.Name); ok && existing.ID != genre.ID {
+		return errors.Wrap(domain.ErrGenreExists, "filmRepo.UpdateGenre.Updates")
+	}
+
+	if row, ok := f.store.genres[genre.ID]; ok {
+		row.Name = genre.Name
+		f.store.genres[genre.ID] = row
+	}
+
+	return nil
+}
+
+// FindOneGenre returns a genre by ID with count of its films.
+func (f FilmRepository) FindOneGenre(_ context.Context, genreID uint) (models.Genre, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	genre, ok := f.store.genres[genreID]
+	if !ok {
+		return models.Genre{}, errors.Wrap(domain.ErrGenreNotFound, "filmRepo.FindOneGenre.First")
+	}
+
+	genre.FilmsCount = f.store.genreFilmsCount(genreID)
+
+	return genre, nil
+}
+
+// FindAllGenres returns all genres with count of their films.
+func (f FilmRepository) FindAllGenres(_ context.Context, filterSortLimit query.FilterSortLimit) ([]models.Genre, pagination.Pagination, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	genres := make([]models.Genre, 0, len(f.store.genres))
+
+	for _, genre := range f.store.genres {
+		genre.FilmsCount = f.store.genreFilmsCount(genre.ID)
+		genres = append(genres, genre)
+	}
+
+	// Sort genres, genres with the same key are ordered by ID
+	var compare func(a, b models.Genre) int
+
+	switch filterSortLimit.Sort.Field() {
+	case "name":
+		compare = func(a, b models.Genre) int { return strings.Compare(a.Name, b.Name) }
+	case "films_count":
+		compare = func(a, b models.Genre) int { return compareFloat(float64(a.FilmsCount), float64(b.FilmsCount)) }
+	default:
+		return nil, pagination.Pagination{}, domain.ErrGenreFindAll
+	}
+
+	descending := isDescending(filterSortLimit.Sort.Order())
+
+	sort.Slice(genres, func(i, j int) bool {
+		if c := compare(genres[i], genres[j]); c != 0 {
+			return (c < 0) != descending
+		}
+
+		return genres[i].ID < genres[j].ID
+	})
+
+	return page(genres, filterSortLimit.Limit, filterSortLimit.Offset),
+		pagination.NewPagination(len(genres), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// DeleteGenre deletes a genre and removes it from all films.
+func (f FilmRepository) DeleteGenre(_ context.Context, genreID uint) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	for _, genreIDs := range f.store.filmGenres {
+		delete(genreIDs, genreID)
+	}
+
+	delete(f.store.genres, genreID)
+
+	return nil
+}
+
+// findGenreByName is a method to find the genre by name.
+func (s *Store) findGenreByName(name string) (models.Genre, bool) {
+	for _, genre := range s.genres {
+		if genre.Name == name {
+			return genre, true
+		}
+	}
+
+	return models.Genre{}, false
+}
+
+// findOrCreateGenre is a method to find the genre by name or create it.
+func (s *Store) findOrCreateGenre(name string) models.Genre {
+	if genre, ok := s.findGenreByName(name); ok {
+		return genre
+	}
+
+	genre := models.Genre{ID: s.nextID("genres"), Name: name}
+	s.genres[genre.ID] = genre
+
+	return genre
+}
+
+// CreateCast creates a new cast.
+func (f FilmRepository) CreateCast(_ context.Context, cast *models.Cast) (*models.Cast, error) {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	// Name is unique
+	for _, c := range f.store.casts {
+		if c.Name == cast.Name {
+			return nil, errors.Wrap(domain.ErrFilmCreateCast, "filmRepo.CreateCast.Create")
+		}
+	}
+
+	cast.ID = f.store.nextID("casts")
+	f.store.casts[cast.ID] = *cast
+
+	return cast, nil
+}
+
+// GetCastsByNames returns casts by names.
+func (f FilmRepository) GetCastsByNames(_ context.Context, names []string) ([]models.Cast, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	casts := make([]models.Cast, 0, len(names))
+
+	for _, cast := range f.store.casts {
+		for _, name := range names {
+			if cast.Name == name {
+				casts = append(casts, cast)
+
+				break
+			}
+		}
+	}
+
+	sort.Slice(casts, func(i, j int) bool { return casts[i].ID < casts[j].ID })
+
+	return casts, nil
+}
+
+// findOrCreateCast is a method to find the person by name or create it.
+func (s *Store) findOrCreateCast(name string) models.Cast {
+	for _, cast := range s.casts {
+		if cast.Name == name {
+			return cast
+		}
+	}
+
+	cast := models.Cast{ID: s.nextID("casts"), Name: name}
+	s.casts[cast.ID] = cast
+
+	return cast
+}
@@ -0,0 +1,23 @@
+package memory_test
+
+import (
+	"film-management/repositories/storage/memory"
+	"film-management/repositories/storage/storagetest"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestRepositories(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Repositories {
+		store := memory.NewStore()
+
+		return storagetest.Repositories{
+			Films:     memory.NewFilmRepository(store, zap.NewNop()),
+			Directors: memory.NewDirectorRepository(store, zap.NewNop()),
+			Users:     memory.NewUserRepository(store, zap.NewNop()),
+			Watchlist: memory.NewWatchlistRepository(store, zap.NewNop()),
+			Stats:     memory.NewStatsRepository(store, zap.NewNop()),
+		}
+	})
+}
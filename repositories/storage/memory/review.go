@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	userModels "film-management/internal/user/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"math"
+	"sort"
+)
+
+// SaveRating creates or replaces a user rating of film and recalculates the aggregate score of the film.
+func (f FilmRepository) SaveRating(_ context.Context, model *models.Rating) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	// Replace the score if the user has already rated the film
+	model.ID = 0
+
+	for id, rating := range f.store.ratings {
+		if rating.FilmID == model.FilmID && rating.UserID == model.UserID {
+			model.ID, model.CreatedAt = id, rating.CreatedAt
+		}
+	}
+
+	if model.ID == 0 {
+		model.ID = f.store.nextID("ratings")
+		model.CreatedAt = now()
+	}
+
+	model.UpdatedAt = now()
+
+	rating := *model
+	rating.User = userModels.User{}
+	f.store.ratings[rating.ID] = rating
+
+	// Recalculate the aggregate score of the film, films in trash are not changed
+	film, ok := f.store.films[model.FilmID]
+	if !ok || isDeleted(film) {
+		return nil
+	}
+
+	var sum, count int64
+
+	for _, r := range f.store.ratings {
+		if r.FilmID == model.FilmID {
+			sum += int64(r.Score)
+			count++
+		}
+	}
+
+	// The score is rounded to cents like in SQL databases
+	film.Rating, film.RatingCount = math.Round(float64(sum)/float64(count)*100)/100, count
+	f.store.films[model.FilmID] = film
+
+	return nil
+}
+
+// CreateReview creates a new review.
+func (f FilmRepository) CreateReview(_ context.Context, model *models.Review) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	model.UUID = uuid.New()
+	model.CreatedAt, model.UpdatedAt = now(), now()
+
+	review := *model
+	review.Author = userModels.User{}
+	f.store.reviews[review.UUID] = review
+
+	return nil
+}
+
+// UpdateReview updates a review.
+func (f FilmRepository) UpdateReview(_ context.Context, model *models.Review) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	review, ok := f.store.reviews[model.UUID]
+	if !ok {
+		return nil
+	}
+
+	model.UpdatedAt = now()
+	review.Text, review.Hidden, review.UpdatedAt = model.Text, model.Hidden, model.UpdatedAt
+	f.store.reviews[review.UUID] = review
+
+	return nil
+}
+
+// FindOneReviewByUUID is a method to find one review of film by UUID.
+func (f FilmRepository) FindOneReviewByUUID(_ context.Context, filmID uuid.UUID, reviewID uuid.UUID) (models.Review, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	review, ok := f.store.reviews[reviewID]
+	if !ok || review.FilmID != filmID {
+		return models.Review{}, errors.Wrap(domain.ErrReviewNotFound, "filmRepo.FindOneReviewByUUID.First")
+	}
+
+	review.Author = f.store.users[review.AuthorID]
+
+	return review, nil
+}
+
+// reviewSortColumns is a map of sort fields of reviews to their values.
+var reviewSortColumns = map[string]func(review models.Review) int64{
+	"created_at": func(review models.Review) int64 { return review.CreatedAt },
+	"updated_at": func(review models.Review) int64 { return review.UpdatedAt },
+}
+
+// FindAllReviews is a method to find all reviews of film.
+func (f FilmRepository) FindAllReviews(_ context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Review, pagination.Pagination, error) {
+	f.store.mu.RLock()
+	defer f.store.mu.RUnlock()
+
+	// Check filters
+	for field := range filterSortLimit.Filter {
+		if field != "hidden" {
+			return nil, pagination.Pagination{}, customError.ValidationError{Field: field, Err: domain.ErrFilmUnknownField}
+		}
+	}
+
+	column, ok := reviewSortColumns[filterSortLimit.Sort.Field()]
+	if !ok {
+		return nil, pagination.Pagination{}, errors.Wrap(domain.ErrReviewFindAll, "filmRepo.FindAllReviews.Find")
+	}
+
+	hidden, withHidden := filterSortLimit.Filter["hidden"].(bool)
+
+	var reviews []models.Review
+
+	for _, review := range f.store.reviews {
+		if review.FilmID != filmID || (withHidden && review.Hidden != hidden) {
+			continue
+		}
+
+		review.Author = f.store.users[review.AuthorID]
+		reviews = append(reviews, review)
+	}
+
+	// Reviews with the same time are ordered by UUID
+	descending := isDescending(filterSortLimit.Sort.Order())
+
+	sort.Slice(reviews, func(i, j int) bool {
+		if a, b := column(reviews[i]), column(reviews[j]); a != b {
+			return (a < b) != descending
+		}
+
+		return reviews[i].UUID.String() < reviews[j].UUID.String()
+	})
+
+	return page(reviews, filterSortLimit.Limit, filterSortLimit.Offset),
+		pagination.NewPagination(len(reviews), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// DeleteReview is a method to delete review.
+func (f FilmRepository) DeleteReview(_ context.Context, reviewID uuid.UUID) error {
+	f.store.mu.Lock()
+	defer f.store.mu.Unlock()
+
+	delete(f.store.reviews, reviewID)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"context"
+	"film-management/internal/stats/domain/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sort"
+)
+
+// StatsRepository is a struct for counting statistics in memory.
+type StatsRepository struct {
+	store  *Store
+	logger *zap.Logger
+}
+
+// NewStatsRepository is a constructor for StatsRepository.
+func NewStatsRepository(store *Store, logger *zap.Logger) *StatsRepository {
+	return &StatsRepository{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// FindStats is a method to count statistics of the catalogue with the number of top directors and creators.
+// All counts are read under one lock, so they are consistent with each other.
+func (r StatsRepository) FindStats(_ context.Context, topLimit int) (models.Stats, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var (
+		stats     models.Stats
+		casts     = make(map[uint]struct{})
+		genres    = make(map[uint]int64, len(r.store.genres))
+		years     = make(map[int]int64)
+		directors = make(map[uint]int64)
+		creators  = make(map[uuid.UUID]int64)
+	)
+
+	for genreID := range r.store.genres {
+		genres[genreID] = 0
+	}
+
+	// Count films which are not in trash
+	for _, film := range r.store.films {
+		if isDeleted(film) {
+			continue
+		}
+
+		stats.Films++
+		years[film.ReleaseDate.Year()]++
+		directors[film.DirectorID]++
+
+		if _, ok := r.store.users[film.CreatorID]; ok {
+			creators[film.CreatorID]++
+		}
+
+		for genreID := range r.store.filmGenres[film.UUID] {
+			genres[genreID]++
+		}
+	}
+
+	for _, credit := range r.store.credits {
+		if film, ok := r.store.films[credit.FilmID]; ok && !isDeleted(film) {
+			casts[credit.CastID] = struct{}{}
+		}
+	}
+
+	stats.Directors, stats.Casts, stats.Users = int64(len(directors)), int64(len(casts)), int64(len(r.store.users))
+
+	// Genres with the most films first
+	stats.FilmsByGenre = make([]models.GenreCount, 0, len(genres))
+	for genreID, count := range genres {
+		stats.FilmsByGenre = append(stats.FilmsByGenre, models.GenreCount{Name: r.store.genres[genreID].Name, Count: count})
+	}
+
+	sort.Slice(stats.FilmsByGenre, func(i, j int) bool {
+		a, b := stats.FilmsByGenre[i], stats.FilmsByGenre[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+
+		return a.Name < b.Name
+	})
+
+	// Years in order
+	stats.FilmsByYear = make([]models.YearCount, 0, len(years))
+	for year, count := range years {
+		stats.FilmsByYear = append(stats.FilmsByYear, models.YearCount{Year: year, Count: count})
+	}
+
+	sort.Slice(stats.FilmsByYear, func(i, j int) bool { return stats.FilmsByYear[i].Year < stats.FilmsByYear[j].Year })
+
+	// Directors with the most films first
+	stats.TopDirectors = make([]models.DirectorCount, 0, len(directors))
+	for directorID, count := range directors {
+		stats.TopDirectors = append(stats.TopDirectors, models.DirectorCount{ID: directorID, Name: r.store.directors[directorID].Name, Count: count})
+	}
+
+	sort.Slice(stats.TopDirectors, func(i, j int) bool {
+		a, b := stats.TopDirectors[i], stats.TopDirectors[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+
+		return a.Name < b.Name
+	})
+
+	stats.TopDirectors = page(stats.TopDirectors, topLimit, 0)
+
+	// Users who added the most films first
+	stats.TopCreators = make([]models.CreatorCount, 0, len(creators))
+	for userID, count := range creators {
+		stats.TopCreators = append(stats.TopCreators, models.CreatorCount{UUID: userID, Username: r.store.users[userID].Username, Count: count})
+	}
+
+	sort.Slice(stats.TopCreators, func(i, j int) bool {
+		a, b := stats.TopCreators[i], stats.TopCreators[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+
+		return a.Username < b.Username
+	})
+
+	stats.TopCreators = page(stats.TopCreators, topLimit, 0)
+
+	return stats, nil
+}
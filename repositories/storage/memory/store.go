@@ -0,0 +1,201 @@
+package memory
+
+import (
+	filmModels "film-management/internal/film/domain/models"
+	userModels "film-management/internal/user/domain/models"
+	watchlistModels "film-management/internal/watchlist/domain/models"
+	"github.com/google/uuid"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Driver is a name of the in-memory storage in config.
+const Driver = "memory"
+
+// Store is a thread-safe storage of all tables in memory, it is shared by the repositories like a database.
+// Rows are stored without associations, the repositories join them on read as the database does.
+type Store struct {
+	mu sync.RWMutex
+
+	users         map[uuid.UUID]userModels.User
+	refreshTokens map[uint]userModels.RefreshToken
+	revokedTokens map[string]userModels.RevokedToken
+
+	films     map[uuid.UUID]filmModels.Film
+	directors map[uint]filmModels.Director
+	genres    map[uint]filmModels.Genre
+	casts     map[uint]filmModels.Cast
+	credits   map[uint]filmModels.Credit
+	ratings   map[uint]filmModels.Rating
+	reviews   map[uuid.UUID]filmModels.Review
+	revisions map[uint]filmModels.Revision
+
+	// filmGenres is a join table of films and genres
+	filmGenres map[uuid.UUID]map[uint]struct{}
+
+	watchlistItems map[uint]watchlistModels.WatchlistItem
+
+	// sequences are the last IDs of the tables with serial keys
+	sequences map[string]uint
+}
+
+// NewStore is a constructor for Store.
+func NewStore() *Store {
+	return &Store{
+		users:          make(map[uuid.UUID]userModels.User),
+		refreshTokens:  make(map[uint]userModels.RefreshToken),
+		revokedTokens:  make(map[string]userModels.RevokedToken),
+		films:          make(map[uuid.UUID]filmModels.Film),
+		directors:      make(map[uint]filmModels.Director),
+		genres:         make(map[uint]filmModels.Genre),
+		casts:          make(map[uint]filmModels.Cast),
+		credits:        make(map[uint]filmModels.Credit),
+		ratings:        make(map[uint]filmModels.Rating),
+		reviews:        make(map[uuid.UUID]filmModels.Review),
+		revisions:      make(map[uint]filmModels.Revision),
+		filmGenres:     make(map[uuid.UUID]map[uint]struct{}),
+		watchlistItems: make(map[uint]watchlistModels.WatchlistItem),
+		sequences:      make(map[string]uint),
+	}
+}
+
+// nextID is a method to get the next ID of the table.
+func (s *Store) nextID(table string) uint {
+	s.sequences[table]++
+
+	return s.sequences[table]
+}
+
+// now is a function to get the time of created and updated rows.
+func now() int64 {
+	return time.Now().Unix()
+}
+
+// isDeleted is a function to check if the film is in trash.
+func isDeleted(film filmModels.Film) bool {
+	return film.DeletedAt.Valid
+}
+
+// filmForView is a method to get the film with director, genres and credits.
+func (s *Store) filmForView(film filmModels.Film) filmModels.Film {
+	film.Director = s.directors[film.DirectorID]
+	film.Genres = s.filmGenresOf(film.UUID)
+	film.Credits = s.filmCreditsOf(film.UUID)
+
+	return film
+}
+
+// filmGenresOf is a method to get genres of the film ordered by ID.
+func (s *Store) filmGenresOf(filmID uuid.UUID) []filmModels.Genre {
+	genres := make([]filmModels.Genre, 0, len(s.filmGenres[filmID]))
+	for genreID := range s.filmGenres[filmID] {
+		genres = append(genres, s.genres[genreID])
+	}
+
+	sort.Slice(genres, func(i, j int) bool { return genres[i].ID < genres[j].ID })
+
+	return genres
+}
+
+// filmCreditsOf is a method to get credits of the film with people in billing order.
+func (s *Store) filmCreditsOf(filmID uuid.UUID) []filmModels.Credit {
+	credits := make([]filmModels.Credit, 0)
+
+	for _, credit := range s.credits {
+		if credit.FilmID == filmID {
+			credit.Cast = s.casts[credit.CastID]
+			credits = append(credits, credit)
+		}
+	}
+
+	sort.Slice(credits, func(i, j int) bool {
+		if credits[i].BillingOrder != credits[j].BillingOrder {
+			return credits[i].BillingOrder < credits[j].BillingOrder
+		}
+
+		return credits[i].ID < credits[j].ID
+	})
+
+	return credits
+}
+
+// directorFilmsCount is a method to count films of the director which are not in trash.
+func (s *Store) directorFilmsCount(directorID uint) int64 {
+	var count int64
+
+	for _, film := range s.films {
+		if film.DirectorID == directorID && !isDeleted(film) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// genreFilmsCount is a method to count films of the genre which are not in trash.
+func (s *Store) genreFilmsCount(genreID uint) int64 {
+	var count int64
+
+	for filmID, genreIDs := range s.filmGenres {
+		if _, ok := genreIDs[genreID]; ok && !isDeleted(s.films[filmID]) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// deleteFilm is a method to permanently delete the film, rows of the film are deleted by cascade.
+func (s *Store) deleteFilm(filmID uuid.UUID) {
+	delete(s.films, filmID)
+	delete(s.filmGenres, filmID)
+
+	for id, credit := range s.credits {
+		if credit.FilmID == filmID {
+			delete(s.credits, id)
+		}
+	}
+
+	for id, rating := range s.ratings {
+		if rating.FilmID == filmID {
+			delete(s.ratings, id)
+		}
+	}
+
+	for id, review := range s.reviews {
+		if review.FilmID == filmID {
+			delete(s.reviews, id)
+		}
+	}
+
+	for id, revision := range s.revisions {
+		if revision.FilmID == filmID {
+			delete(s.revisions, id)
+		}
+	}
+
+	for id, item := range s.watchlistItems {
+		if item.FilmID == filmID {
+			delete(s.watchlistItems, id)
+		}
+	}
+}
+
+// page is a function to get the part of items by limit and offset, negative offset is no offset like in gorm.
+func page[T any](items []T, limit int, offset int) []T {
+	if offset < 0 {
+		offset = 0
+	}
+
+	if offset >= len(items) {
+		return []T{}
+	}
+
+	items = items[offset:]
+	if limit >= 0 && limit < len(items) {
+		items = items[:limit]
+	}
+
+	return items
+}
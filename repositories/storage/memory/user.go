@@ -0,0 +1,192 @@
+package memory
+
+import (
+	"context"
+	"film-management/internal/user/domain"
+	"film-management/internal/user/domain/models"
+	"film-management/pkg/policy"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"time"
+)
+
+// UserRepository is a struct for work with users in memory.
+type UserRepository struct {
+	store  *Store
+	logger *zap.Logger
+}
+
+// NewUserRepository is a constructor for UserRepository.
+func NewUserRepository(store *Store, logger *zap.Logger) *UserRepository {
+	return &UserRepository{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// CreateUser is a method to create user.
+func (r UserRepository) CreateUser(_ context.Context, user *models.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Username is unique
+	for _, u := range r.store.users {
+		if u.Username == user.Username {
+			return errors.Wrap(domain.ErrUserExistsWithUsername, "userRepo.CreateUser.Create")
+		}
+	}
+
+	user.UUID = uuid.New()
+	if user.Role == "" {
+		user.Role = policy.RoleEditor
+	}
+	user.CreatedAt, user.UpdatedAt = now(), now()
+
+	r.store.users[user.UUID] = *user
+
+	return nil
+}
+
+// FindOneUserByUUID is a method to find one user.
+func (r UserRepository) FindOneUserByUUID(_ context.Context, uuid uuid.UUID) (models.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	user, ok := r.store.users[uuid]
+	if !ok {
+		return models.User{}, errors.Wrap(domain.ErrUserNotFound, "userRepo.FindOneUserByUUID.First")
+	}
+
+	return user, nil
+}
+
+// FindOneUserByUsername is a method to find one user.
+func (r UserRepository) FindOneUserByUsername(_ context.Context, username string) (models.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, user := range r.store.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+
+	return models.User{}, errors.Wrap(domain.ErrUserNotFound, "userRepo.FindOneUserByUsername.First")
+}
+
+// UserExistsWithUsername checks if a user with the given username exists.
+func (r UserRepository) UserExistsWithUsername(_ context.Context, username string) error {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, user := range r.store.users {
+		if user.Username == username {
+			return errors.Wrap(domain.ErrUserExistsWithUsername, "userRepo.UserExistsWithUsername.Count")
+		}
+	}
+
+	return nil
+}
+
+// CreateRefreshToken is a method to create refresh token.
+func (r UserRepository) CreateRefreshToken(_ context.Context, model *models.RefreshToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Hash of the token is unique
+	for _, token := range r.store.refreshTokens {
+		if token.TokenHash == model.TokenHash {
+			return errors.Wrap(domain.ErrRefreshTokenCreate, "userRepo.CreateRefreshToken.Create")
+		}
+	}
+
+	model.ID = r.store.nextID("refresh_tokens")
+	model.CreatedAt = now()
+
+	token := *model
+	token.User = models.User{}
+	r.store.refreshTokens[token.ID] = token
+
+	return nil
+}
+
+// FindOneRefreshTokenByHash is a method to find one refresh token by hash.
+func (r UserRepository) FindOneRefreshTokenByHash(_ context.Context, tokenHash string) (models.RefreshToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, token := range r.store.refreshTokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+
+	return models.RefreshToken{}, errors.Wrap(domain.ErrRefreshTokenNotFound, "userRepo.FindOneRefreshTokenByHash.First")
+}
+
+// UseRefreshToken is a method to mark refresh token as used.
+// The token is checked and marked under the lock, so only one of concurrent requests can use the token.
+func (r UserRepository) UseRefreshToken(_ context.Context, id uint) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	token, ok := r.store.refreshTokens[id]
+	if !ok || token.RevokedAt != nil {
+		return errors.Wrap(domain.ErrRefreshTokenReused, "userRepo.UseRefreshToken.Update")
+	}
+
+	revokedAt := time.Now().Unix()
+	token.RevokedAt = &revokedAt
+	r.store.refreshTokens[id] = token
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily is a method to revoke all refresh tokens of the family.
+func (r UserRepository) RevokeRefreshTokenFamily(_ context.Context, familyID uuid.UUID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	revokedAt := time.Now().Unix()
+
+	for id, token := range r.store.refreshTokens {
+		if token.FamilyID == familyID && token.RevokedAt == nil {
+			token.RevokedAt = &revokedAt
+			r.store.refreshTokens[id] = token
+		}
+	}
+
+	return nil
+}
+
+// RevokeAccessToken is a method to add access token to denylist. Expired tokens are removed from denylist.
+func (r UserRepository) RevokeAccessToken(_ context.Context, model *models.RevokedToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Remove expired tokens, they are rejected anyway
+	for tokenID, token := range r.store.revokedTokens {
+		if token.ExpiresAt < time.Now().Unix() {
+			delete(r.store.revokedTokens, tokenID)
+		}
+	}
+
+	// Add token to denylist, a token revoked twice is kept as is
+	if _, ok := r.store.revokedTokens[model.TokenID]; !ok {
+		model.CreatedAt = now()
+		r.store.revokedTokens[model.TokenID] = *model
+	}
+
+	return nil
+}
+
+// IsAccessTokenRevoked is a method to check if access token is in denylist.
+func (r UserRepository) IsAccessTokenRevoked(_ context.Context, tokenID string) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.revokedTokens[tokenID]
+
+	return ok, nil
+}
@@ -0,0 +1,207 @@
+package memory
+
+import (
+	"context"
+	modelsFilm "film-management/internal/film/domain/models"
+	userModels "film-management/internal/user/domain/models"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"sort"
+	"strings"
+)
+
+// watchlistSortColumns is a map of sort fields of watchlist to functions which compare items by the field.
+var watchlistSortColumns = map[string]func(a, b models.WatchlistItem) int{
+	"title":        func(a, b models.WatchlistItem) int { return strings.Compare(a.Film.Title, b.Film.Title) },
+	"release_date": func(a, b models.WatchlistItem) int { return a.Film.ReleaseDate.Compare(b.Film.ReleaseDate) },
+	"rating":       func(a, b models.WatchlistItem) int { return compareFloat(a.Film.Rating, b.Film.Rating) },
+	"added_at":     func(a, b models.WatchlistItem) int { return compareFloat(float64(a.CreatedAt), float64(b.CreatedAt)) },
+	"watched_at": func(a, b models.WatchlistItem) int {
+		// Items which are not watched are the last in ascending order, like NULL values
+		switch {
+		case a.WatchedAt == nil && b.WatchedAt == nil:
+			return 0
+		case a.WatchedAt == nil:
+			return 1
+		case b.WatchedAt == nil:
+			return -1
+		default:
+			return a.WatchedAt.Compare(*b.WatchedAt)
+		}
+	},
+}
+
+// WatchlistRepository is a struct for work with watchlist in memory.
+type WatchlistRepository struct {
+	store  *Store
+	logger *zap.Logger
+}
+
+// NewWatchlistRepository is a constructor for WatchlistRepository.
+func NewWatchlistRepository(store *Store, logger *zap.Logger) *WatchlistRepository {
+	return &WatchlistRepository{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// CreateWatchlistItem is a method to create watchlist item.
+func (w WatchlistRepository) CreateWatchlistItem(_ context.Context, model *models.WatchlistItem) error {
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+
+	// Film is added to watchlist of user once
+	if w.store.watchlistItemExists(model.UserID, model.FilmID) {
+		return errors.Wrap(domain.ErrWatchlistItemExists, "watchlistRepo.CreateWatchlistItem.Create")
+	}
+
+	model.ID = w.store.nextID("watchlist_items")
+	model.CreatedAt, model.UpdatedAt = now(), now()
+
+	item := *model
+	item.Film, item.User = modelsFilm.Film{}, userModels.User{}
+	w.store.watchlistItems[item.ID] = item
+
+	return nil
+}
+
+// UpdateWatchlistItem is a method to update watchlist item.
+func (w WatchlistRepository) UpdateWatchlistItem(_ context.Context, model *models.WatchlistItem) error {
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+
+	item, ok := w.store.watchlistItems[model.ID]
+	if !ok {
+		return nil
+	}
+
+	model.UpdatedAt = now()
+	item.Watched, item.WatchedAt, item.UpdatedAt = model.Watched, model.WatchedAt, model.UpdatedAt
+	w.store.watchlistItems[item.ID] = item
+
+	return nil
+}
+
+// FindOneWatchlistItem is a method to find one watchlist item by user and film.
+func (w WatchlistRepository) FindOneWatchlistItem(_ context.Context, userID uuid.UUID, filmID uuid.UUID) (models.WatchlistItem, error) {
+	w.store.mu.RLock()
+	defer w.store.mu.RUnlock()
+
+	for _, item := range w.store.watchlistItems {
+		if item.UserID != userID || item.FilmID != filmID {
+			continue
+		}
+
+		// Films in trash are not in watchlist
+		if film, ok := w.store.films[item.FilmID]; ok && !isDeleted(film) {
+			item.Film = w.store.filmForView(film)
+
+			return item, nil
+		}
+	}
+
+	return models.WatchlistItem{}, errors.Wrap(domain.ErrWatchlistItemNotFound, "watchlistRepo.FindOneWatchlistItem.First")
+}
+
+// FindAllWatchlistItems is a method to find all watchlist items of user.
+func (w WatchlistRepository) FindAllWatchlistItems(_ context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.WatchlistItem, pagination.Pagination, error) {
+	w.store.mu.RLock()
+	defer w.store.mu.RUnlock()
+
+	// Check filters
+	for field := range filterSortLimit.Filter {
+		if field != "watched" {
+			return nil, pagination.Pagination{}, customError.ValidationError{Field: field, Err: domain.ErrWatchlistUnknownField}
+		}
+	}
+
+	// Get sort key
+	compare, ok := watchlistSortColumns[filterSortLimit.Sort.Field()]
+	if !ok {
+		return nil, pagination.Pagination{}, customError.ValidationError{Field: "sort", Err: domain.ErrWatchlistUnknownField}
+	}
+
+	watched, withWatched := filterSortLimit.Filter["watched"].(bool)
+
+	items := make([]models.WatchlistItem, 0)
+
+	for _, item := range w.store.watchlistItems {
+		if item.UserID != userID || (withWatched && item.Watched != watched) {
+			continue
+		}
+
+		// Films in trash are not in watchlist
+		film, ok := w.store.films[item.FilmID]
+		if !ok || isDeleted(film) {
+			continue
+		}
+
+		item.Film = w.store.filmForView(film)
+		items = append(items, item)
+	}
+
+	// Items with the same key are ordered by ID
+	descending := isDescending(filterSortLimit.Sort.Order())
+
+	sort.Slice(items, func(i, j int) bool {
+		if c := compare(items[i], items[j]); c != 0 {
+			return (c < 0) != descending
+		}
+
+		return items[i].ID < items[j].ID
+	})
+
+	return page(items, filterSortLimit.Limit, filterSortLimit.Offset),
+		pagination.NewPagination(len(items), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// DeleteWatchlistItem is a method to delete watchlist item.
+func (w WatchlistRepository) DeleteWatchlistItem(_ context.Context, id uint) error {
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+
+	delete(w.store.watchlistItems, id)
+
+	return nil
+}
+
+// WatchlistItemExists is a method to check if film is already in user watchlist.
+func (w WatchlistRepository) WatchlistItemExists(_ context.Context, userID uuid.UUID, filmID uuid.UUID) error {
+	w.store.mu.RLock()
+	defer w.store.mu.RUnlock()
+
+	if w.store.watchlistItemExists(userID, filmID) {
+		return domain.ErrWatchlistItemExists
+	}
+
+	return nil
+}
+
+// FilmExists is a method to check if film exists.
+func (w WatchlistRepository) FilmExists(_ context.Context, filmID uuid.UUID) error {
+	w.store.mu.RLock()
+	defer w.store.mu.RUnlock()
+
+	if film, ok := w.store.films[filmID]; !ok || isDeleted(film) {
+		return domain.ErrWatchlistFilmNotFound
+	}
+
+	return nil
+}
+
+// watchlistItemExists is a method to check if the film is in watchlist of the user.
+func (s *Store) watchlistItemExists(userID uuid.UUID, filmID uuid.UUID) bool {
+	for _, item := range s.watchlistItems {
+		if item.UserID == userID && item.FilmID == filmID {
+			return true
+		}
+	}
+
+	return false
+}
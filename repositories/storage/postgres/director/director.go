@@ -0,0 +1,191 @@
+package director
+
+import (
+	"context"
+	"film-management/internal/director/domain"
+	"film-management/internal/film/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	filmRepo "film-management/repositories/storage/postgres/film"
+	"fmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// selectWithFilmsCount is a select of director columns with count of director films.
+const selectWithFilmsCount = "directors.*, (SELECT COUNT(*) FROM films WHERE films.director_id = directors.id AND films.deleted_at IS NULL) AS films_count"
+
+// sortColumns is a map of sort fields to db columns.
+var sortColumns = map[string]string{
+	"name":        "directors.name",
+	"films_count": "films_count",
+}
+
+// Repository is a struct for work with directors in db.
+type Repository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewDirectorRepository is a constructor for Repository.
+func NewDirectorRepository(db *gorm.DB, logger *zap.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// FindAllDirectors is a method to find all directors.
+func (d Repository) FindAllDirectors(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Director, pagination.Pagination, error) {
+	var directors []models.Director
+
+	// Build condition
+	condition := d.db
+
+	// Add filters to condition
+	for field, value := range filterSortLimit.Filter {
+		switch field {
+		case "name":
+			name, _ := value.(string)
+			condition = condition.Where(`LOWER(directors.name) LIKE LOWER(?) ESCAPE '\'`, filmRepo.ContainsPattern(name))
+		default:
+			return nil, pagination.Pagination{}, customError.ValidationError{Field: field, Err: domain.ErrDirectorUnknownField}
+		}
+	}
+
+	// Get sort column
+	column, ok := sortColumns[filterSortLimit.Sort.Field()]
+	if !ok {
+		return nil, pagination.Pagination{}, customError.ValidationError{Field: "sort", Err: domain.ErrDirectorUnknownField}
+	}
+
+	// Find all directors with condition
+	if result := d.db.WithContext(ctx).
+		Select(selectWithFilmsCount).
+		Where(condition).
+		Limit(filterSortLimit.Limit).
+		Offset(filterSortLimit.Offset).
+		Order(fmt.Sprintf("%s %s, directors.id", column, filterSortLimit.Sort.Order())).
+		Find(&directors); result.Error != nil {
+		d.logger.Error("directorRepo.FindAllDirectors.Find", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrDirectorFindAll
+	}
+
+	// Get count of directors with condition for pagination
+	var count int64
+
+	if result := d.db.WithContext(ctx).Model(models.Director{}).Where(condition).Count(&count); result.Error != nil {
+		d.logger.Error("directorRepo.FindAllDirectors.Count", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrDirectorFindAll
+	}
+
+	return directors, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// FindOneDirector is a method to find one director by id.
+func (d Repository) FindOneDirector(ctx context.Context, directorID uint) (models.Director, error) {
+	var director models.Director
+
+	if result := d.db.WithContext(ctx).
+		Select(selectWithFilmsCount).
+		Where("directors.id = ?", directorID).
+		First(&director); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Director{}, errors.Wrap(domain.ErrDirectorNotFound, "directorRepo.FindOneDirector.First")
+		}
+
+		d.logger.Error("directorRepo.FindOneDirector.First", zap.Error(result.Error))
+
+		return models.Director{}, errors.Wrap(result.Error, "directorRepo.FindOneDirector.First")
+	}
+
+	return director, nil
+}
+
+// FindDirectorByName is a method to find one director by name.
+func (d Repository) FindDirectorByName(ctx context.Context, name string) (models.Director, error) {
+	var director models.Director
+
+	if result := d.db.WithContext(ctx).Where("name = ?", name).First(&director); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Director{}, errors.Wrap(domain.ErrDirectorNotFound, "directorRepo.FindDirectorByName.First")
+		}
+
+		d.logger.Error("directorRepo.FindDirectorByName.First", zap.Error(result.Error))
+
+		return models.Director{}, errors.Wrap(result.Error, "directorRepo.FindDirectorByName.First")
+	}
+
+	return director, nil
+}
+
+// FindAllDirectorFilms is a method to find all films of director, newest first.
+func (d Repository) FindAllDirectorFilms(ctx context.Context, directorID uint) ([]models.Film, error) {
+	var films []models.Film
+
+	if result := d.db.WithContext(ctx).
+		Preload("Genres").
+		Where("director_id = ?", directorID).
+		Order("release_date desc").
+		Find(&films); result.Error != nil {
+		d.logger.Error("directorRepo.FindAllDirectorFilms.Find", zap.Error(result.Error))
+
+		return nil, errors.Wrap(result.Error, "directorRepo.FindAllDirectorFilms.Find")
+	}
+
+	return films, nil
+}
+
+// UpdateDirector is a method to update director.
+func (d Repository) UpdateDirector(ctx context.Context, model *models.Director) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(model).Select("Name").Updates(model).Error; err != nil {
+			d.logger.Error("directorRepo.UpdateDirector.Updates", zap.Error(err))
+
+			return errors.Wrap(err, "directorRepo.UpdateDirector.Updates")
+		}
+
+		// Rebuild the search vector of director films
+		if err := filmRepo.RefreshSearchVector(tx, "films.director_id = ?", model.ID); err != nil {
+			d.logger.Error("directorRepo.UpdateDirector.RefreshSearchVector", zap.Error(err))
+
+			return errors.Wrap(err, "directorRepo.UpdateDirector.RefreshSearchVector")
+		}
+
+		return nil
+	})
+}
+
+// MergeDirectors is a method to move films of source directors to target director and delete source directors.
+func (d Repository) MergeDirectors(ctx context.Context, targetID uint, sourceIDs []uint) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Move films to target director
+		if err := tx.Model(&models.Film{}).
+			Where("director_id IN ?", sourceIDs).
+			Update("director_id", targetID).Error; err != nil {
+			d.logger.Error("directorRepo.MergeDirectors.Update", zap.Error(err))
+
+			return errors.Wrap(err, "directorRepo.MergeDirectors.Update")
+		}
+
+		// Rebuild the search vector of moved films
+		if err := filmRepo.RefreshSearchVector(tx, "films.director_id = ?", targetID); err != nil {
+			d.logger.Error("directorRepo.MergeDirectors.RefreshSearchVector", zap.Error(err))
+
+			return errors.Wrap(err, "directorRepo.MergeDirectors.RefreshSearchVector")
+		}
+
+		// Delete source directors
+		if err := tx.Delete(&models.Director{}, sourceIDs).Error; err != nil {
+			d.logger.Error("directorRepo.MergeDirectors.Delete", zap.Error(err))
+
+			return errors.Wrap(err, "directorRepo.MergeDirectors.Delete")
+		}
+
+		return nil
+	})
+}
@@ -2,18 +2,70 @@ package film
 
 import (
 	"context"
+	"database/sql"
 	"film-management/internal/film/domain"
 	"film-management/internal/film/domain/models"
+	"film-management/pkg/database"
 	customError "film-management/pkg/errors"
 	"film-management/pkg/query"
 	"film-management/pkg/query/pagination"
 	"film-management/pkg/query/sort"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
 )
 
+// selectGenreWithFilmsCount is a select of genre columns with count of genre films.
+const selectGenreWithFilmsCount = "genres.*, (SELECT COUNT(*) FROM film_genres JOIN films ON films.uuid = film_genres.film_uuid AND films.deleted_at IS NULL " +
+	"WHERE film_genres.genre_id = genres.id) AS films_count"
+
+// trashSortColumns is a map of sort fields of trash to db columns.
+var trashSortColumns = map[string]string{
+	"title":      "films.title",
+	"deleted_at": "films.deleted_at",
+}
+
+// searchConfig is a text search configuration for full-text search over films.
+const searchConfig = "english"
+
+// searchVectorQuery is a query to rebuild the search vector of films from title, director, credited people and synopsis.
+const searchVectorQuery = `UPDATE films SET search_vector =
+	setweight(to_tsvector('` + searchConfig + `', films.title), 'A') ||
+	setweight(to_tsvector('` + searchConfig + `', COALESCE((SELECT directors.name FROM directors WHERE directors.id = films.director_id), '')), 'B') ||
+	setweight(to_tsvector('` + searchConfig + `', COALESCE((SELECT string_agg(casts.name, ' ') FROM credits JOIN casts ON casts.id = credits.cast_id WHERE credits.film_id = films.uuid), '')), 'B') ||
+	setweight(to_tsvector('` + searchConfig + `', films.synopsis), 'C')
+	WHERE `
+
+// highlightText is a text of the highlighted snippet. It is user input, so it is HTML-escaped like by html.EscapeString
+// and only marks of the snippet are HTML.
+const highlightText = "replace(replace(replace(replace(replace(films.title || '. ' || films.synopsis, " +
+	`'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&#34;'), '''', '&#39;')`
+
+// selectFilmWithSearch is a select of film columns with relevance and highlighted snippet of full-text search.
+const selectFilmWithSearch = "films.*, " +
+	"ts_rank(films.search_vector, websearch_to_tsquery('" + searchConfig + "', @q)) AS relevance, " +
+	"ts_headline('" + searchConfig + "', " + highlightText + ", websearch_to_tsquery('" + searchConfig + "', @q), " +
+	"'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5') AS highlight"
+
+// RefreshSearchVector is a function to rebuild the search vector of films matching the condition.
+// SQLite has no search vector, films are searched by their text.
+func RefreshSearchVector(tx *gorm.DB, condition string, args ...interface{}) error {
+	if database.IsSQLite(tx) {
+		return nil
+	}
+
+	if err := tx.Exec(searchVectorQuery+condition, args...).Error; err != nil {
+		return errors.Wrap(err, "filmRepo.RefreshSearchVector.Exec")
+	}
+
+	return nil
+}
+
 // Repository is a struct for work with film in db.
 type Repository struct {
 	db     *gorm.DB
@@ -28,7 +80,8 @@ func NewFilmRepository(db *gorm.DB, logger *zap.Logger) *Repository {
 	}
 }
 
-func (f Repository) CreateFilm(ctx context.Context, model *models.Film) error {
+// CreateFilm is a method to create film with its first revision.
+func (f Repository) CreateFilm(ctx context.Context, model *models.Film, revision *models.Revision) error {
 	// Start a new transaction
 	tx := f.db.WithContext(ctx).Begin()
 
@@ -49,15 +102,52 @@ func (f Repository) CreateFilm(ctx context.Context, model *models.Film) error {
 		return errors.Wrap(err, "filmRepo.CreateFilm.Create")
 	}
 
+	// Build the search vector of the film
+	if err := RefreshSearchVector(tx, "films.uuid = ?", model.UUID); err != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.CreateFilm.RefreshSearchVector", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.CreateFilm.RefreshSearchVector")
+	}
+
+	// Save the first revision of the film
+	revision.FilmID = model.UUID
+	if err := f.createRevision(tx, revision); err != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.CreateFilm.createRevision", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.CreateFilm.createRevision")
+	}
+
 	// Commit the transaction if everything is successful
 	tx.Commit()
 
 	return nil
 }
 
-func (f Repository) UpdateFilm(ctx context.Context, model *models.Film) error {
+// UpdateFilm is a method to update film of the model version and save its revision, the version is incremented.
+func (f Repository) UpdateFilm(ctx context.Context, model *models.Film, revision *models.Revision) error {
 	tx := f.db.WithContext(ctx).Begin()
 
+	// Increment the version, the film is not updated if it was changed after it was read
+	result := tx.Model(&models.Film{}).
+		Where("uuid = ? AND version = ?", model.UUID, model.Version).
+		UpdateColumn("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.UpdateFilm.Version", zap.Error(result.Error))
+
+		return errors.Wrap(result.Error, "filmRepo.UpdateFilm.Version")
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+
+		return errors.Wrap(domain.ErrFilmVersionMismatch, "filmRepo.UpdateFilm.Version")
+	}
+
+	model.Version++
+
 	// Check if the director with the specified name exists
 	if err := f.createOrUpdateDirector(tx, model); err != nil {
 		tx.Rollback()
@@ -66,15 +156,15 @@ func (f Repository) UpdateFilm(ctx context.Context, model *models.Film) error {
 		return errors.Wrap(err, "filmRepo.UpdateFilm.createOrUpdateDirector")
 	}
 
-	// Update the film
-	if err := tx.Model(&model).Updates(model).Error; err != nil {
+	// Update the film, the aggregate score is maintained by SaveRating only and the version is incremented above
+	if err := tx.Model(&model).Omit("Rating", "RatingCount", "Credits", "Version", "Creator").Updates(model).Error; err != nil {
 		tx.Rollback()
 		f.logger.Error("filmRepo.UpdateFilm.Updates", zap.Error(err))
 
 		return errors.Wrap(err, "filmRepo.UpdateFilm.Updates")
 	}
 
-	// Replace genres and casts
+	// Replace genres and credits
 	if err := tx.Model(&model).Association("Genres").Replace(model.Genres); err != nil {
 		tx.Rollback()
 		f.logger.Error("filmRepo.UpdateFilm.ReplaceGenres", zap.Error(err))
@@ -82,11 +172,27 @@ func (f Repository) UpdateFilm(ctx context.Context, model *models.Film) error {
 		return errors.Wrap(err, "filmRepo.UpdateFilm.ReplaceGenres")
 	}
 
-	if err := tx.Model(&model).Association("Casts").Replace(model.Casts); err != nil {
+	if err := f.replaceCredits(tx, model); err != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.UpdateFilm.replaceCredits", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.UpdateFilm.replaceCredits")
+	}
+
+	// Rebuild the search vector of the film
+	if err := RefreshSearchVector(tx, "films.uuid = ?", model.UUID); err != nil {
 		tx.Rollback()
-		f.logger.Error("filmRepo.UpdateFilm.ReplaceCasts", zap.Error(err))
+		f.logger.Error("filmRepo.UpdateFilm.RefreshSearchVector", zap.Error(err))
 
-		return errors.Wrap(err, "filmRepo.UpdateFilm.ReplaceCasts")
+		return errors.Wrap(err, "filmRepo.UpdateFilm.RefreshSearchVector")
+	}
+
+	// Save the revision, the film row is locked by the update so numbers of revisions do not race
+	if err := f.createRevision(tx, revision); err != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.UpdateFilm.createRevision", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.UpdateFilm.createRevision")
 	}
 
 	tx.Commit()
@@ -94,6 +200,33 @@ func (f Repository) UpdateFilm(ctx context.Context, model *models.Film) error {
 	return nil
 }
 
+// replaceCredits is a method to replace all credits of film.
+func (f Repository) replaceCredits(tx *gorm.DB, model *models.Film) error {
+	if err := tx.Where("film_id = ?", model.UUID).Delete(&models.Credit{}).Error; err != nil {
+		return errors.Wrap(err, "filmRepo.replaceCredits.Delete")
+	}
+
+	if len(model.Credits) == 0 {
+		return nil
+	}
+
+	for i := range model.Credits {
+		model.Credits[i].ID = 0
+		model.Credits[i].FilmID = model.UUID
+	}
+
+	if err := tx.Create(&model.Credits).Error; err != nil {
+		return errors.Wrap(err, "filmRepo.replaceCredits.Create")
+	}
+
+	return nil
+}
+
+// preloadCredits is a function to preload film credits in billing order.
+func preloadCredits(db *gorm.DB) *gorm.DB {
+	return db.Order("billing_order, id")
+}
+
 // createOrUpdateDirector is a method to create or update director.
 func (f Repository) createOrUpdateDirector(tx *gorm.DB, model *models.Film) error {
 	// Check if the director with the specified name exists
@@ -144,7 +277,8 @@ func (f Repository) FindOneFilmForViewByUUID(ctx context.Context, uuid uuid.UUID
 		Preload("Creator").
 		Preload("Genres").
 		Preload("Director").
-		Preload("Casts").
+		Preload("Credits", preloadCredits).
+		Preload("Credits.Cast").
 		Where("uuid = ?", uuid).
 		First(&film); result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -160,30 +294,50 @@ func (f Repository) FindOneFilmForViewByUUID(ctx context.Context, uuid uuid.UUID
 }
 
 // FindAllFilms is a method to find all films.
+// Films are paged by offset or by cursor, count of films is optional.
 func (f Repository) FindAllFilms(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error) {
 	var films []models.Film
 
 	// Build condition
-	condition := f.db.Where("1 = 1")
+	condition, err := f.filmsCondition(filterSortLimit.Filter)
+	if err != nil {
+		f.logger.Error("filmRepo.FindAllFilms.filmsCondition", zap.Error(err))
 
-	// Add filters to condition
-	for field, value := range filterSortLimit.Filter {
-		if err := addFilmFiltersToCondition(condition, field, value, f); err != nil {
-			f.logger.Error("filmRepo.FindAllFilms.addFilmFiltersToCondition", zap.Error(err))
+		return nil, pagination.Pagination{}, err
+	}
+
+	// Select relevance and highlighted snippet for full-text search
+	db := f.db.WithContext(ctx)
+	q, withSearch := filterSortLimit.Filter["q"].(string)
 
+	switch {
+	case withSearch && database.IsSQLite(db):
+		selectWithSearch, args := selectSQLiteFilmWithSearch(q)
+		db = db.Select(selectWithSearch, args...)
+	case withSearch:
+		db = db.Select(selectFilmWithSearch, sql.Named("q", q))
+	}
+
+	// Continue after the cursor, the cursor is not a filter and does not change count of films
+	if filterSortLimit.Cursor != nil {
+		keyset, args, err := filmKeysetCondition(db, filterSortLimit.Sort, *filterSortLimit.Cursor)
+		if err != nil {
 			return nil, pagination.Pagination{}, err
 		}
+
+		db = db.Where(keyset, args...)
 	}
 
-	// Find all films with condition
-	if result := f.db.WithContext(ctx).
+	// Find all films with condition, one more film than the limit shows if there is a next page
+	if result := db.
 		Preload("Genres").
 		Preload("Director").
-		Preload("Casts").
+		Preload("Credits", preloadCredits).
+		Preload("Credits.Cast").
 		Where(condition).
-		Limit(filterSortLimit.Limit).
+		Limit(filterSortLimit.Limit + 1).
 		Offset(filterSortLimit.Offset).
-		Order(sort.GetDBQueryForSort(filterSortLimit.Sort)).
+		Order(sort.GetDBQueryForSort(filterSortLimit.Sort) + ", films.uuid " + filterSortLimit.Sort.Order()).
 		Find(&films); result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, pagination.Pagination{}, nil
@@ -194,6 +348,25 @@ func (f Repository) FindAllFilms(ctx context.Context, filterSortLimit query.Filt
 		return nil, pagination.Pagination{}, domain.ErrFilmFindAll
 	}
 
+	// SQLite has no snippets of full-text search, words of the query are highlighted in the film
+	if withSearch && database.IsSQLite(db) {
+		highlightSQLiteSearch(films, q)
+	}
+
+	// Get cursor of the next page
+	var nextCursor string
+
+	if len(films) > filterSortLimit.Limit {
+		films = films[:filterSortLimit.Limit]
+		nextCursor = filmNextCursor(filterSortLimit.Sort, films[len(films)-1])
+	}
+
+	p := pagination.NewCursorPagination(filterSortLimit.Limit, nextCursor)
+
+	if !filterSortLimit.WithCount {
+		return films, p, nil
+	}
+
 	// Get count of films with condition for pagination
 	var count int64
 
@@ -203,30 +376,201 @@ func (f Repository) FindAllFilms(ctx context.Context, filterSortLimit query.Filt
 		return nil, pagination.Pagination{}, domain.ErrFilmFindAll
 	}
 
-	return films, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+	// Page number is known in offset mode only
+	if filterSortLimit.Cursor == nil {
+		p = pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset)
+		p.NextCursor = nextCursor
+
+		return films, p, nil
+	}
+
+	return films, p.WithTotalCount(int(count)), nil
+}
+
+// facetQueries is a map of facets to queries of counts of the filtered films by values of the facet.
+// The {year} of queries is the expression of the year of release in the SQL dialect of the database.
+var facetQueries = map[string]string{
+	models.FacetGenres: `SELECT 'genres' AS facet, genres.name AS value, COUNT(*) AS count
+		FROM filtered JOIN film_genres ON film_genres.film_uuid = filtered.uuid JOIN genres ON genres.id = film_genres.genre_id
+		GROUP BY genres.name ORDER BY count DESC, value`,
+	models.FacetDecade: `SELECT 'decade' AS facet, CAST({year} / 10 * 10 AS TEXT) AS value, COUNT(*) AS count
+		FROM filtered
+		GROUP BY value ORDER BY value DESC`,
+	models.FacetDirector: `SELECT 'director' AS facet, directors.name AS value, COUNT(*) AS count
+		FROM filtered JOIN directors ON directors.id = filtered.director_id
+		GROUP BY directors.name ORDER BY count DESC, value`,
+}
+
+// maxFacetValues is a maximum number of values of a facet, values with more films are kept.
+const maxFacetValues = 20
+
+// CountFilmFacets is a method to count films with the filter by values of the facets.
+// All facets are counted in one query over the same condition as FindAllFilms.
+func (f Repository) CountFilmFacets(ctx context.Context, filter query.Filter, facets []string) ([]models.FacetCount, error) {
+	// Build condition
+	condition, err := f.filmsCondition(filter)
+	if err != nil {
+		f.logger.Error("filmRepo.CountFilmFacets.filmsCondition", zap.Error(err))
+
+		return nil, err
+	}
+
+	// Build a query of counts of every facet
+	queries := make([]string, 0, len(facets))
+
+	for _, facet := range facets {
+		facetQuery, ok := facetQueries[facet]
+		if !ok {
+			return nil, customError.ValidationError{Field: "facets", Err: domain.ErrFilmUnknownField}
+		}
+
+		// Every facet is a subquery, SQLite has no parentheses around queries of UNION
+		facetQuery = strings.ReplaceAll(facetQuery, "{year}", database.Year(f.db, "filtered.release_date"))
+		queries = append(queries, fmt.Sprintf("SELECT * FROM (%s LIMIT %d) AS %s_counts", facetQuery, maxFacetValues, facet))
+	}
+
+	filtered := f.db.Model(&models.Film{}).Select("films.uuid, films.director_id, films.release_date").Where(condition)
+
+	var counts []models.FacetCount
+
+	if result := f.db.WithContext(ctx).
+		Raw("WITH filtered AS (?) "+strings.Join(queries, " UNION ALL "), filtered).
+		Scan(&counts); result.Error != nil {
+		f.logger.Error("filmRepo.CountFilmFacets.Scan", zap.Error(result.Error))
+
+		return nil, domain.ErrFilmFacets
+	}
+
+	return counts, nil
+}
+
+// filmsCondition is a method to build condition of films with the filter.
+func (f Repository) filmsCondition(filter query.Filter) (*gorm.DB, error) {
+	condition := f.db.Where("1 = 1")
+
+	// Add filters to condition
+	for field, value := range filter {
+		if err := addFilmFiltersToCondition(condition, field, value, f); err != nil {
+			return nil, err
+		}
+	}
+
+	return condition, nil
+}
+
+// filmKeysetColumns is a map of sort fields available for cursor to db columns.
+var filmKeysetColumns = map[string]string{
+	"title":        "films.title",
+	"release_date": "films.release_date",
+	"rating":       "films.rating",
+}
+
+// filmKeysetCondition is a function to get condition of films after the cursor.
+func filmKeysetCondition(db *gorm.DB, sortOption sort.Sortable, cursor pagination.Cursor) (string, []interface{}, error) {
+	column, ok := filmKeysetColumns[sortOption.Field()]
+	if !ok {
+		return "", nil, customError.ValidationError{Field: "cursor", Err: domain.ErrFilmCursorSort}
+	}
+
+	// Release date of the cursor is a date without time
+	if sortOption.Field() == "release_date" {
+		column = database.Date(db, column)
+	}
+
+	// Check type of sort key value
+	switch cursor.Value.(type) {
+	case string:
+		ok = sortOption.Field() != "rating"
+	case float64:
+		ok = sortOption.Field() == "rating"
+	default:
+		ok = false
+	}
+
+	if !ok {
+		return "", nil, customError.ValidationError{Field: "cursor", Err: pagination.ErrCursorInvalid}
+	}
+
+	// Order of the sort option is in upper case
+	operator := ">"
+	if strings.EqualFold(sortOption.Order(), sort.OrderDESC) {
+		operator = "<"
+	}
+
+	return fmt.Sprintf("(%s, films.uuid) %s (?, ?)", column, operator), []interface{}{cursor.Value, cursor.UUID}, nil
+}
+
+// filmNextCursor is a function to get encoded cursor after the film or empty string if sort has no cursor.
+func filmNextCursor(sortOption sort.Sortable, film models.Film) string {
+	var value interface{}
+
+	switch sortOption.Field() {
+	case "title":
+		value = film.Title
+	case "release_date":
+		value = film.ReleaseDate.Format(time.DateOnly)
+	case "rating":
+		value = film.Rating
+	default:
+		return ""
+	}
+
+	return pagination.NewCursor(sortOption, value, film.UUID).Encode()
 }
 
 // addFilmFiltersToCondition is a method to add film filters to condition.
 func addFilmFiltersToCondition(condition *gorm.DB, field string, value interface{}, f Repository) error {
 	switch field {
+	case "q":
+		return addSearchFilter(condition, value)
 	case "title":
 		return addTitleFilter(condition, value)
 	case "release_date":
 		return addReleaseDateFilter(condition, value)
-	case "genres":
-		return addGenresFilter(condition, value, f)
+	case "year":
+		return addYearFilter(condition, value)
+	case "genres", "genres_all", "genres_none":
+		return addGenresFilter(condition, field, value, f)
+	case "director":
+		return addDirectorFilter(condition, value)
+	case "director_ids":
+		return addDirectorIDsFilter(condition, value)
+	case "cast":
+		return addCastFilter(condition, value)
+	case "cast_ids":
+		return addCastIDsFilter(condition, value)
+	case "creator_id":
+		return addCreatorFilter(condition, value)
 	default:
 		return customError.ValidationError{Field: field, Err: domain.ErrFilmUnknownField}
 	}
 }
 
+// addSearchFilter is a method to add full-text search filter over title, synopsis, director and credited people.
+func addSearchFilter(condition *gorm.DB, value interface{}) error {
+	q, ok := value.(string)
+	if !ok {
+		return customError.ValidationError{Field: "q", Err: domain.ErrFilmFilterWrong}
+	}
+
+	if database.IsSQLite(condition) {
+		addSQLiteSearchFilter(condition, q)
+
+		return nil
+	}
+
+	condition = condition.Where("films.search_vector @@ websearch_to_tsquery('"+searchConfig+"', ?)", q)
+
+	return nil
+}
+
 // addTitleFilter is a method to add title filter.
 func addTitleFilter(condition *gorm.DB, value interface{}) error {
 	title, ok := value.(string)
 	if !ok {
 		return customError.ValidationError{Field: "title", Err: domain.ErrFilmFilterWrong}
 	}
-	condition = condition.Where("title LIKE ?", "%"+title+"%")
+	condition = condition.Where(`LOWER(title) LIKE LOWER(?) ESCAPE '\'`, ContainsPattern(title))
 
 	return nil
 }
@@ -238,13 +582,82 @@ func addReleaseDateFilter(condition *gorm.DB, value interface{}) error {
 	if !ok || len(dates) != 2 {
 		return customError.ValidationError{Field: "release_date", Err: domain.ErrFilmFilterWrong}
 	}
-	condition = condition.Where("release_date BETWEEN ? AND ?", dates[0], dates[1])
+	condition = condition.Where(database.Date(condition, "release_date")+" BETWEEN ? AND ?", dates[0], dates[1])
+
+	return nil
+}
+
+// addYearFilter is a method to add release year filter.
+func addYearFilter(condition *gorm.DB, value interface{}) error {
+	year, ok := value.(int)
+	if !ok {
+		return customError.ValidationError{Field: "year", Err: domain.ErrFilmFilterWrong}
+	}
+
+	// Range of dates keeps the index of release date in use
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	condition = condition.Where("films.release_date >= ? AND films.release_date < ?", from.Format(time.DateOnly), from.AddDate(1, 0, 0).Format(time.DateOnly))
 
 	return nil
 }
 
-// addGenresFilter is a method to add genres filter.
-func addGenresFilter(condition *gorm.DB, value interface{}, f Repository) error {
+// addDirectorFilter is a method to add filter by part of director name.
+func addDirectorFilter(condition *gorm.DB, value interface{}) error {
+	name, ok := value.(string)
+	if !ok {
+		return customError.ValidationError{Field: "director", Err: domain.ErrFilmFilterWrong}
+	}
+	condition = condition.Where(`EXISTS (SELECT 1 FROM directors WHERE directors.id = films.director_id AND LOWER(directors.name) LIKE LOWER(?) ESCAPE '\')`, ContainsPattern(name))
+
+	return nil
+}
+
+// addDirectorIDsFilter is a method to add filter by any of the directors.
+func addDirectorIDsFilter(condition *gorm.DB, value interface{}) error {
+	directorIDs, ok := value.([]uint)
+	if !ok || len(directorIDs) == 0 {
+		return customError.ValidationError{Field: "director_ids", Err: domain.ErrFilmFilterWrong}
+	}
+	condition = condition.Where("films.director_id IN ?", directorIDs)
+
+	return nil
+}
+
+// addCastFilter is a method to add filter by part of name of an actor of the film.
+func addCastFilter(condition *gorm.DB, value interface{}) error {
+	name, ok := value.(string)
+	if !ok {
+		return customError.ValidationError{Field: "cast", Err: domain.ErrFilmFilterWrong}
+	}
+	condition = condition.Where(`EXISTS (SELECT 1 FROM credits JOIN casts ON casts.id = credits.cast_id WHERE credits.film_id = films.uuid AND credits.department = ? AND LOWER(casts.name) LIKE LOWER(?) ESCAPE '\')`, models.DepartmentCast, ContainsPattern(name))
+
+	return nil
+}
+
+// addCastIDsFilter is a method to add filter by any of the people credited in the film.
+func addCastIDsFilter(condition *gorm.DB, value interface{}) error {
+	castIDs, ok := value.([]uint)
+	if !ok || len(castIDs) == 0 {
+		return customError.ValidationError{Field: "cast_ids", Err: domain.ErrFilmFilterWrong}
+	}
+	condition = condition.Where("EXISTS (SELECT 1 FROM credits WHERE credits.film_id = films.uuid AND credits.cast_id IN ?)", castIDs)
+
+	return nil
+}
+
+// addCreatorFilter is a method to add filter by creator of the film.
+func addCreatorFilter(condition *gorm.DB, value interface{}) error {
+	creatorID, ok := value.(uuid.UUID)
+	if !ok {
+		return customError.ValidationError{Field: "creator_id", Err: domain.ErrFilmFilterWrong}
+	}
+	condition = condition.Where("films.creator_id = ?", creatorID)
+
+	return nil
+}
+
+// addGenresFilter is a method to add genres filter, films match any, all or none of the genres by the field.
+func addGenresFilter(condition *gorm.DB, field string, value interface{}, f Repository) error {
 	genreNames, ok := value.([]string)
 	if !ok {
 		return customError.ValidationError{Field: "genres", Err: domain.ErrFilmFilterWrong}
@@ -258,30 +671,238 @@ func addGenresFilter(condition *gorm.DB, value interface{}, f Repository) error
 		return domain.ErrFilmFindGenres
 	}
 
+	if len(genreIDs) == 0 {
+		return customError.ValidationError{Field: "genres", Err: domain.ErrFilmGenresNotFound}
+	}
+
 	// Add condition
-	if len(genreIDs) > 0 {
+	switch field {
+	case "genres_all":
+		// A film can not have a genre which does not exist
+		if len(genreIDs) < countUniqueStrings(genreNames) {
+			return customError.ValidationError{Field: "genres", Err: domain.ErrFilmGenresNotFound}
+		}
+
+		condition = condition.Where("(SELECT COUNT(*) FROM film_genres WHERE films.uuid = film_genres.film_uuid AND film_genres.genre_id IN ?) = ?", genreIDs, len(genreIDs))
+	case "genres_none":
+		condition = condition.Where("NOT EXISTS (SELECT 1 FROM film_genres WHERE films.uuid = film_genres.film_uuid AND film_genres.genre_id IN ?)", genreIDs)
+	default:
 		condition = condition.Where("EXISTS (SELECT 1 FROM film_genres WHERE films.uuid = film_genres.film_uuid AND film_genres.genre_id IN ?)", genreIDs)
-	} else {
-		return customError.ValidationError{Field: "genres", Err: domain.ErrFilmGenresNotFound}
 	}
 
 	return nil
 }
 
-// DeleteFilm is a method to delete film.
-func (f Repository) DeleteFilm(ctx context.Context, uuid uuid.UUID) error {
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Film{}).Error
-	if err != nil {
-		f.logger.Error("filmRepo.DeleteFilm.Delete", zap.Error(err))
+// countUniqueStrings is a function to count unique strings of the slice.
+func countUniqueStrings(values []string) int {
+	unique := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		unique[value] = struct{}{}
+	}
+
+	return len(unique)
+}
+
+// DeleteFilm is a method to move film of the version to trash and save its revision, the film is kept until purge.
+func (f Repository) DeleteFilm(ctx context.Context, uuid uuid.UUID, version int, revision *models.Revision) error {
+	return f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("uuid = ? AND version = ?", uuid, version).Delete(&models.Film{})
+		if result.Error != nil {
+			f.logger.Error("filmRepo.DeleteFilm.Delete", zap.Error(result.Error))
+
+			return errors.Wrap(result.Error, "filmRepo.DeleteFilm.Delete")
+		}
+
+		// The film was changed or deleted after it was read
+		if result.RowsAffected == 0 {
+			return errors.Wrap(domain.ErrFilmVersionMismatch, "filmRepo.DeleteFilm.Delete")
+		}
+
+		if err := f.createRevision(tx, revision); err != nil {
+			f.logger.Error("filmRepo.DeleteFilm.createRevision", zap.Error(err))
+
+			return errors.Wrap(err, "filmRepo.DeleteFilm.createRevision")
+		}
+
+		return nil
+	})
+}
+
+// FindOneDeletedFilmByUUID is a method to find one film in trash by UUID.
+func (f Repository) FindOneDeletedFilmByUUID(ctx context.Context, uuid uuid.UUID) (models.Film, error) {
+	var film models.Film
+
+	if result := f.db.WithContext(ctx).
+		Unscoped().
+		Preload("Genres").
+		Preload("Director").
+		Preload("Credits", preloadCredits).
+		Preload("Credits.Cast").
+		Where("uuid = ? AND deleted_at IS NOT NULL", uuid).
+		First(&film); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Film{}, errors.Wrap(domain.ErrFilmNotFoundInTrash, "filmRepo.FindOneDeletedFilmByUUID.First")
+		}
+
+		f.logger.Error("filmRepo.FindOneDeletedFilmByUUID.First", zap.Error(result.Error))
 
-		return errors.Wrap(err, "filmRepo.DeleteFilm.Delete")
+		return models.Film{}, errors.Wrap(result.Error, "filmRepo.FindOneDeletedFilmByUUID.First")
+	}
+
+	return film, nil
+}
+
+// FindAllDeletedFilms is a method to find all films of creator in trash.
+func (f Repository) FindAllDeletedFilms(ctx context.Context, creatorID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination, error) {
+	var films []models.Film
+
+	// Build condition
+	condition := f.db.Where("films.creator_id = ? AND films.deleted_at IS NOT NULL", creatorID)
+
+	// Get sort column
+	column, ok := trashSortColumns[filterSortLimit.Sort.Field()]
+	if !ok {
+		return nil, pagination.Pagination{}, customError.ValidationError{Field: "sort", Err: domain.ErrFilmUnknownField}
+	}
+
+	// Find all deleted films with condition
+	if result := f.db.WithContext(ctx).
+		Unscoped().
+		Preload("Genres").
+		Preload("Director").
+		Preload("Credits", preloadCredits).
+		Preload("Credits.Cast").
+		Where(condition).
+		Limit(filterSortLimit.Limit).
+		Offset(filterSortLimit.Offset).
+		Order(fmt.Sprintf("%s %s, films.uuid", column, filterSortLimit.Sort.Order())).
+		Find(&films); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllDeletedFilms.Find", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrFilmFindTrash
+	}
+
+	// Get count of deleted films with condition for pagination
+	var count int64
+
+	if result := f.db.WithContext(ctx).Unscoped().Model(models.Film{}).Where(condition).Count(&count); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllDeletedFilms.Count", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrFilmFindTrash
+	}
+
+	return films, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// RestoreFilm is a method to restore film from trash and save its revision.
+func (f Repository) RestoreFilm(ctx context.Context, uuid uuid.UUID, revision *models.Revision) error {
+	return f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Model(&models.Film{}).
+			Where("uuid = ?", uuid).
+			Update("deleted_at", nil).Error; err != nil {
+			f.logger.Error("filmRepo.RestoreFilm.Update", zap.Error(err))
+
+			return errors.Wrap(err, "filmRepo.RestoreFilm.Update")
+		}
+
+		if err := f.createRevision(tx, revision); err != nil {
+			f.logger.Error("filmRepo.RestoreFilm.createRevision", zap.Error(err))
+
+			return errors.Wrap(err, "filmRepo.RestoreFilm.createRevision")
+		}
+
+		return nil
+	})
+}
+
+// PurgeDeletedFilms is a method to permanently delete films moved to trash before the time.
+// Genres, credits, ratings, reviews and watchlist items of the films are deleted by cascade.
+func (f Repository) PurgeDeletedFilms(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result := f.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Delete(&models.Film{})
+	if result.Error != nil {
+		f.logger.Error("filmRepo.PurgeDeletedFilms.Delete", zap.Error(result.Error))
+
+		return 0, errors.Wrap(result.Error, "filmRepo.PurgeDeletedFilms.Delete")
+	}
+
+	return result.RowsAffected, nil
+}
+
+// createRevision is a method to save revision of film with the next number.
+func (f Repository) createRevision(tx *gorm.DB, revision *models.Revision) error {
+	var lastNumber int
+
+	if err := tx.Model(&models.Revision{}).
+		Where("film_id = ?", revision.FilmID).
+		Select("COALESCE(MAX(number), 0)").
+		Scan(&lastNumber).Error; err != nil {
+		return errors.Wrap(err, "filmRepo.createRevision.Scan")
+	}
+
+	revision.Number = lastNumber + 1
+
+	if err := tx.Create(revision).Error; err != nil {
+		return errors.Wrap(err, "filmRepo.createRevision.Create")
 	}
 
 	return nil
 }
 
+// FindAllRevisions is a method to find all revisions of film.
+func (f Repository) FindAllRevisions(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Revision, pagination.Pagination, error) {
+	var revisions []models.Revision
+
+	// Build condition
+	condition := f.db.Where("film_id = ?", filmID)
+
+	// Find all revisions with condition
+	if result := f.db.WithContext(ctx).
+		Where(condition).
+		Limit(filterSortLimit.Limit).
+		Offset(filterSortLimit.Offset).
+		Order(sort.GetDBQueryForSort(filterSortLimit.Sort)).
+		Find(&revisions); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllRevisions.Find", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrRevisionFindAll
+	}
+
+	// Get count of revisions with condition for pagination
+	var count int64
+
+	if result := f.db.WithContext(ctx).Model(models.Revision{}).Where(condition).Count(&count); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllRevisions.Count", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrRevisionFindAll
+	}
+
+	return revisions, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// FindOneRevision is a method to find one revision of film by number.
+func (f Repository) FindOneRevision(ctx context.Context, filmID uuid.UUID, number int) (models.Revision, error) {
+	var revision models.Revision
+
+	if result := f.db.WithContext(ctx).Where("film_id = ? AND number = ?", filmID, number).First(&revision); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Revision{}, errors.Wrap(domain.ErrRevisionNotFound, "filmRepo.FindOneRevision.First")
+		}
+
+		f.logger.Error("filmRepo.FindOneRevision.First", zap.Error(result.Error))
+
+		return models.Revision{}, errors.Wrap(result.Error, "filmRepo.FindOneRevision.First")
+	}
+
+	return revision, nil
+}
+
 // FilmExistsWithTitle checks if a film with the given filmID and title exists.
 // The operation parameter specifies the type of operation: "add" or "update".
+// Titles are compared case-insensitively, films in trash keep their titles until purge.
 func (f Repository) FilmExistsWithTitle(ctx context.Context, title string, filmID uuid.UUID, operation models.Operation) error {
 	var count int64
 
@@ -289,8 +910,9 @@ func (f Repository) FilmExistsWithTitle(ctx context.Context, title string, filmI
 	case models.OperationAdd:
 		// Check if a film with the same title exists
 		err := f.db.WithContext(ctx).
+			Unscoped().
 			Model(&models.Film{}).
-			Where("title = ?", title).
+			Where("LOWER(title) = LOWER(?)", title).
 			Count(&count).
 			Error
 
@@ -307,8 +929,9 @@ func (f Repository) FilmExistsWithTitle(ctx context.Context, title string, filmI
 	case models.OperationUpdate:
 		// Check if another film with the same title exists, except for the current film
 		err := f.db.WithContext(ctx).
+			Unscoped().
 			Model(&models.Film{}).
-			Where("title = ? AND uuid <> ?", title, filmID).
+			Where("LOWER(title) = LOWER(?) AND uuid <> ?", title, filmID).
 			Count(&count).
 			Error
 
@@ -356,6 +979,85 @@ func (f Repository) GetGenresByNames(ctx context.Context, names []string) ([]mod
 	return genres, nil
 }
 
+// UpdateGenre updates a genre.
+func (f Repository) UpdateGenre(ctx context.Context, genre *models.Genre) error {
+	if err := f.db.WithContext(ctx).Model(genre).Select("Name").Updates(genre).Error; err != nil {
+		f.logger.Error("filmRepo.UpdateGenre.Updates", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.UpdateGenre.Updates")
+	}
+
+	return nil
+}
+
+// FindOneGenre returns a genre by ID with count of its films.
+func (f Repository) FindOneGenre(ctx context.Context, genreID uint) (models.Genre, error) {
+	var genre models.Genre
+
+	if result := f.db.WithContext(ctx).
+		Select(selectGenreWithFilmsCount).
+		Where("genres.id = ?", genreID).
+		First(&genre); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Genre{}, errors.Wrap(domain.ErrGenreNotFound, "filmRepo.FindOneGenre.First")
+		}
+
+		f.logger.Error("filmRepo.FindOneGenre.First", zap.Error(result.Error))
+
+		return models.Genre{}, errors.Wrap(result.Error, "filmRepo.FindOneGenre.First")
+	}
+
+	return genre, nil
+}
+
+// FindAllGenres returns all genres with count of their films.
+func (f Repository) FindAllGenres(ctx context.Context, filterSortLimit query.FilterSortLimit) ([]models.Genre, pagination.Pagination, error) {
+	var genres []models.Genre
+
+	if result := f.db.WithContext(ctx).
+		Select(selectGenreWithFilmsCount).
+		Limit(filterSortLimit.Limit).
+		Offset(filterSortLimit.Offset).
+		Order(sort.GetDBQueryForSort(filterSortLimit.Sort)).
+		Find(&genres); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllGenres.Find", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrGenreFindAll
+	}
+
+	// Get count of genres for pagination
+	var count int64
+
+	if result := f.db.WithContext(ctx).Model(models.Genre{}).Count(&count); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllGenres.Count", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrGenreFindAll
+	}
+
+	return genres, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// DeleteGenre deletes a genre and removes it from all films.
+func (f Repository) DeleteGenre(ctx context.Context, genreID uint) error {
+	return f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Remove genre from films
+		if err := tx.Exec("DELETE FROM film_genres WHERE genre_id = ?", genreID).Error; err != nil {
+			f.logger.Error("filmRepo.DeleteGenre.DeleteFilmGenres", zap.Error(err))
+
+			return errors.Wrap(err, "filmRepo.DeleteGenre.DeleteFilmGenres")
+		}
+
+		// Delete genre
+		if err := tx.Delete(&models.Genre{}, genreID).Error; err != nil {
+			f.logger.Error("filmRepo.DeleteGenre.Delete", zap.Error(err))
+
+			return errors.Wrap(err, "filmRepo.DeleteGenre.Delete")
+		}
+
+		return nil
+	})
+}
+
 // CreateCast creates a new cast.
 func (f Repository) CreateCast(ctx context.Context, cast *models.Cast) (*models.Cast, error) {
 	if err := f.db.WithContext(ctx).Create(cast).Error; err != nil {
@@ -379,3 +1081,132 @@ func (f Repository) GetCastsByNames(ctx context.Context, names []string) ([]mode
 
 	return casts, nil
 }
+
+// SaveRating creates or replaces a user rating of film and recalculates the aggregate score of the film.
+func (f Repository) SaveRating(ctx context.Context, model *models.Rating) error {
+	tx := f.db.WithContext(ctx).Begin()
+
+	// Create the rating or replace the score if the user has already rated the film
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "film_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"score", "updated_at"}),
+	}).Create(model).Error; err != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.SaveRating.Create", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.SaveRating.Create")
+	}
+
+	// Recalculate the aggregate score of the film, it is rounded to cents,
+	// so the score is exactly the same in float64 of the cursor and in the db
+	if err := tx.Model(&models.Film{}).
+		Where("uuid = ?", model.FilmID).
+		UpdateColumns(map[string]interface{}{
+			"rating":       gorm.Expr("(SELECT COALESCE(ROUND(AVG(score), 2), 0) FROM ratings WHERE ratings.film_id = ?)", model.FilmID),
+			"rating_count": gorm.Expr("(SELECT COUNT(*) FROM ratings WHERE ratings.film_id = ?)", model.FilmID),
+		}).Error; err != nil {
+		tx.Rollback()
+		f.logger.Error("filmRepo.SaveRating.UpdateColumns", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.SaveRating.UpdateColumns")
+	}
+
+	tx.Commit()
+
+	return nil
+}
+
+// CreateReview creates a new review.
+func (f Repository) CreateReview(ctx context.Context, model *models.Review) error {
+	if err := f.db.WithContext(ctx).Create(model).Error; err != nil {
+		f.logger.Error("filmRepo.CreateReview.Create", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.CreateReview.Create")
+	}
+
+	return nil
+}
+
+// UpdateReview updates a review.
+func (f Repository) UpdateReview(ctx context.Context, model *models.Review) error {
+	if err := f.db.WithContext(ctx).Model(model).Select("Text", "Hidden").Updates(model).Error; err != nil {
+		f.logger.Error("filmRepo.UpdateReview.Updates", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.UpdateReview.Updates")
+	}
+
+	return nil
+}
+
+// FindOneReviewByUUID is a method to find one review of film by UUID.
+func (f Repository) FindOneReviewByUUID(ctx context.Context, filmID uuid.UUID, reviewID uuid.UUID) (models.Review, error) {
+	var review models.Review
+
+	if result := f.db.WithContext(ctx).
+		Preload("Author").
+		Where("uuid = ? AND film_id = ?", reviewID, filmID).
+		First(&review); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.Review{}, errors.Wrap(domain.ErrReviewNotFound, "filmRepo.FindOneReviewByUUID.First")
+		}
+
+		f.logger.Error("filmRepo.FindOneReviewByUUID.First", zap.Error(result.Error))
+
+		return models.Review{}, errors.Wrap(result.Error, "filmRepo.FindOneReviewByUUID.First")
+	}
+
+	return review, nil
+}
+
+// FindAllReviews is a method to find all reviews of film.
+func (f Repository) FindAllReviews(ctx context.Context, filmID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.Review, pagination.Pagination, error) {
+	var reviews []models.Review
+
+	// Build condition
+	condition := f.db.Where("film_id = ?", filmID)
+
+	// Add filters to condition
+	for field, value := range filterSortLimit.Filter {
+		switch field {
+		case "hidden":
+			condition = condition.Where("hidden = ?", value)
+		default:
+			return nil, pagination.Pagination{}, customError.ValidationError{Field: field, Err: domain.ErrFilmUnknownField}
+		}
+	}
+
+	// Find all reviews with condition
+	if result := f.db.WithContext(ctx).
+		Preload("Author").
+		Where(condition).
+		Limit(filterSortLimit.Limit).
+		Offset(filterSortLimit.Offset).
+		Order(sort.GetDBQueryForSort(filterSortLimit.Sort)).
+		Find(&reviews); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllReviews.Find", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, errors.Wrap(result.Error, "filmRepo.FindAllReviews.Find")
+	}
+
+	// Get count of reviews with condition for pagination
+	var count int64
+
+	if result := f.db.WithContext(ctx).Model(models.Review{}).Where(condition).Count(&count); result.Error != nil {
+		f.logger.Error("filmRepo.FindAllReviews.Count", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, errors.Wrap(result.Error, "filmRepo.FindAllReviews.Count")
+	}
+
+	return reviews, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// DeleteReview is a method to delete review.
+func (f Repository) DeleteReview(ctx context.Context, reviewID uuid.UUID) error {
+	if err := f.db.WithContext(ctx).Where("uuid = ?", reviewID).Delete(&models.Review{}).Error; err != nil {
+		f.logger.Error("filmRepo.DeleteReview.Delete", zap.Error(err))
+
+		return errors.Wrap(err, "filmRepo.DeleteReview.Delete")
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package film
+
+import "strings"
+
+// likeEscaper escapes wildcards of LIKE and its escape character, a pattern with them needs ESCAPE '\'.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ContainsPattern is a function to get a pattern of LIKE ... ESCAPE '\' which matches text containing the value as it is.
+func ContainsPattern(value string) string {
+	return "%" + likeEscaper.Replace(value) + "%"
+}
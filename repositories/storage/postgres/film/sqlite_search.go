@@ -0,0 +1,93 @@
+package film
+
+import (
+	"film-management/internal/film/domain/models"
+	"film-management/pkg/query/search"
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// sqliteSearchFields are conditions of a word of the query by fields of film in SQLite with weights of the fields,
+// the weights are the default weights of ts_rank in Postgres. The word matches the start of a word of the field.
+var sqliteSearchFields = []struct {
+	condition string
+	weight    float64
+}{
+	{condition: "' ' || LOWER(films.title) LIKE ?", weight: 1.0},
+	{condition: "EXISTS (SELECT 1 FROM directors WHERE directors.id = films.director_id AND ' ' || LOWER(directors.name) LIKE ?) OR " +
+		"EXISTS (SELECT 1 FROM credits JOIN casts ON casts.id = credits.cast_id WHERE credits.film_id = films.uuid AND ' ' || LOWER(casts.name) LIKE ?)", weight: 0.4},
+	{condition: "' ' || LOWER(films.synopsis) LIKE ?", weight: 0.2},
+}
+
+// sqliteSearchWord is a function to get condition of films which have the word in any field and its arguments.
+func sqliteSearchWord(word string) (string, []interface{}) {
+	conditions := make([]string, 0, len(sqliteSearchFields))
+	args := make([]interface{}, 0)
+
+	for _, field := range sqliteSearchFields {
+		conditions = append(conditions, "("+field.condition+")")
+		args = append(args, sqliteSearchArgs(field.condition, word)...)
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// sqliteSearchArgs is a function to get arguments of the condition of the word, all of them are the pattern of the word.
+func sqliteSearchArgs(condition string, word string) []interface{} {
+	args := make([]interface{}, strings.Count(condition, "?"))
+	for i := range args {
+		args[i] = "% " + word + "%"
+	}
+
+	return args
+}
+
+// addSQLiteSearchFilter is a function to add search filter in SQLite which has no full-text search.
+// There is no stemming, every word of the query must match and words prefixed with "-" must not match.
+func addSQLiteSearchFilter(condition *gorm.DB, q string) {
+	include, exclude := search.Terms(q)
+
+	// A query without words matches no films like in full-text search
+	if len(include) == 0 && len(exclude) == 0 {
+		condition.Where("1 = 0")
+
+		return
+	}
+
+	for _, word := range include {
+		wordCondition, args := sqliteSearchWord(word)
+		condition.Where(wordCondition, args...)
+	}
+
+	for _, word := range exclude {
+		wordCondition, args := sqliteSearchWord(word)
+		condition.Where("NOT "+wordCondition, args...)
+	}
+}
+
+// selectSQLiteFilmWithSearch is a function to get select of film columns with relevance of search in SQLite and its arguments.
+func selectSQLiteFilmWithSearch(q string) (string, []interface{}) {
+	include, _ := search.Terms(q)
+
+	ranks := []string{"0"}
+	args := make([]interface{}, 0)
+
+	for _, word := range include {
+		for _, field := range sqliteSearchFields {
+			ranks = append(ranks, fmt.Sprintf("CASE WHEN %s THEN %g ELSE 0 END", field.condition, field.weight))
+			args = append(args, sqliteSearchArgs(field.condition, word)...)
+		}
+	}
+
+	return "films.*, (" + strings.Join(ranks, " + ") + ") AS relevance", args
+}
+
+// highlightSQLiteSearch is a function to set snippets of films with highlighted words of the query.
+func highlightSQLiteSearch(films []models.Film, q string) {
+	include, _ := search.Terms(q)
+
+	for i := range films {
+		films[i].Highlight = search.Highlight(films[i].Title+". "+films[i].Synopsis, include)
+	}
+}
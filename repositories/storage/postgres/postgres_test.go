@@ -0,0 +1,52 @@
+package postgres_test
+
+import (
+	"context"
+	"film-management/migrations"
+	"film-management/pkg/database/migrator"
+	"film-management/pkg/database/postgresql"
+	directorRepo "film-management/repositories/storage/postgres/director"
+	filmRepo "film-management/repositories/storage/postgres/film"
+	statsRepo "film-management/repositories/storage/postgres/stats"
+	userRepo "film-management/repositories/storage/postgres/user"
+	watchlistRepo "film-management/repositories/storage/postgres/watchlist"
+	"film-management/repositories/storage/storagetest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TestRepositories runs the contract against the database of TEST_POSTGRES_URL migrated up, all its data is removed.
+func TestRepositories(t *testing.T) {
+	dbURL := os.Getenv("TEST_POSTGRES_URL")
+	if dbURL == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dbURL), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+
+	postgresMigrations, err := migrations.Load(postgresql.Driver)
+	require.NoError(t, err)
+
+	_, err = migrator.NewMigrator(db, postgresMigrations, zap.NewNop()).Up(context.Background())
+	require.NoError(t, err)
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Repositories {
+		require.NoError(t, db.Exec(`TRUNCATE users, refresh_tokens, revoked_tokens, films, genres, directors, casts,
+			film_genres, credits, ratings, reviews, revisions, watchlist_items RESTART IDENTITY CASCADE`).Error)
+
+		return storagetest.Repositories{
+			Films:     filmRepo.NewFilmRepository(db, zap.NewNop()),
+			Directors: directorRepo.NewDirectorRepository(db, zap.NewNop()),
+			Users:     userRepo.NewUserRepository(db, zap.NewNop()),
+			Watchlist: watchlistRepo.NewWatchlistRepository(db, zap.NewNop()),
+			Stats:     statsRepo.NewStatsRepository(db, zap.NewNop()),
+		}
+	})
+}
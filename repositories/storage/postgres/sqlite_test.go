@@ -0,0 +1,46 @@
+package postgres_test
+
+import (
+	"film-management/cmd/server/commands/migrate"
+	"film-management/pkg/database"
+	"film-management/pkg/database/sqlite"
+	directorRepo "film-management/repositories/storage/postgres/director"
+	filmRepo "film-management/repositories/storage/postgres/film"
+	statsRepo "film-management/repositories/storage/postgres/stats"
+	userRepo "film-management/repositories/storage/postgres/user"
+	watchlistRepo "film-management/repositories/storage/postgres/watchlist"
+	"film-management/repositories/storage/storagetest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestSQLiteRepositories runs the contract against a new SQLite database of every case made by migration.
+func TestSQLiteRepositories(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Repositories {
+		config := database.Config{
+			Driver: sqlite.Driver,
+			SQLite: sqlite.Config{Path: filepath.Join(t.TempDir(), "film_management.db")},
+		}
+		require.NoError(t, migrate.Database(&config, zap.NewNop()))
+
+		db, err := database.Connect(&config, zap.NewNop())
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			if sqlDB, errDB := db.DB(); errDB == nil {
+				sqlDB.Close()
+			}
+		})
+
+		return storagetest.Repositories{
+			Films:     filmRepo.NewFilmRepository(db, zap.NewNop()),
+			Directors: directorRepo.NewDirectorRepository(db, zap.NewNop()),
+			Users:     userRepo.NewUserRepository(db, zap.NewNop()),
+			Watchlist: watchlistRepo.NewWatchlistRepository(db, zap.NewNop()),
+			Stats:     statsRepo.NewStatsRepository(db, zap.NewNop()),
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"film-management/internal/stats/domain/models"
+	"film-management/pkg/database"
+	"fmt"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// countTotals is a query of totals of the catalogue, directors and casts are counted if they have films not in trash.
+	countTotals = `SELECT
+		(SELECT COUNT(*) FROM films WHERE films.deleted_at IS NULL) AS films,
+		(SELECT COUNT(DISTINCT films.director_id) FROM films WHERE films.deleted_at IS NULL) AS directors,
+		(SELECT COUNT(DISTINCT credits.cast_id) FROM credits JOIN films ON films.uuid = credits.film_id AND films.deleted_at IS NULL) AS casts,
+		(SELECT COUNT(*) FROM users) AS users`
+
+	// countFilmsByGenre is a query of counts of films of every genre, genres without films are counted too.
+	countFilmsByGenre = `SELECT genres.name AS name, COUNT(films.uuid) AS count
+		FROM genres
+		LEFT JOIN film_genres ON film_genres.genre_id = genres.id
+		LEFT JOIN films ON films.uuid = film_genres.film_uuid AND films.deleted_at IS NULL
+		GROUP BY genres.name
+		ORDER BY count DESC, genres.name`
+
+	// countFilmsByYear is a query of counts of films released in every year, it is formatted with the expression of the year.
+	countFilmsByYear = `SELECT %s AS year, COUNT(*) AS count
+		FROM films
+		WHERE films.deleted_at IS NULL
+		GROUP BY year
+		ORDER BY year`
+
+	// findTopDirectors is a query of directors with the most films.
+	findTopDirectors = `SELECT directors.id AS id, directors.name AS name, COUNT(*) AS count
+		FROM films
+		JOIN directors ON directors.id = films.director_id
+		WHERE films.deleted_at IS NULL
+		GROUP BY directors.id, directors.name
+		ORDER BY count DESC, directors.name
+		LIMIT ?`
+
+	// findTopCreators is a query of users who added the most films.
+	findTopCreators = `SELECT users.uuid AS uuid, users.username AS username, COUNT(*) AS count
+		FROM films
+		JOIN users ON users.uuid = films.creator_id
+		WHERE films.deleted_at IS NULL
+		GROUP BY users.uuid, users.username
+		ORDER BY count DESC, users.username
+		LIMIT ?`
+)
+
+// Repository is a struct for counting statistics in db.
+type Repository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewStatsRepository is a constructor for Repository.
+func NewStatsRepository(db *gorm.DB, logger *zap.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// FindStats is a method to count statistics of the catalogue with the number of top directors and creators.
+// All counts are read in one read-only transaction, so they are consistent with each other.
+func (r Repository) FindStats(ctx context.Context, topLimit int) (models.Stats, error) {
+	var (
+		stats  models.Stats
+		totals struct {
+			Films     int64
+			Directors int64
+			Casts     int64
+			Users     int64
+		}
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if result := tx.Raw(countTotals).Scan(&totals); result.Error != nil {
+			return errors.Wrap(result.Error, "statsRepo.FindStats.countTotals")
+		}
+
+		if result := tx.Raw(countFilmsByGenre).Scan(&stats.FilmsByGenre); result.Error != nil {
+			return errors.Wrap(result.Error, "statsRepo.FindStats.countFilmsByGenre")
+		}
+
+		if result := tx.Raw(fmt.Sprintf(countFilmsByYear, database.Year(tx, "films.release_date"))).Scan(&stats.FilmsByYear); result.Error != nil {
+			return errors.Wrap(result.Error, "statsRepo.FindStats.countFilmsByYear")
+		}
+
+		if result := tx.Raw(findTopDirectors, topLimit).Scan(&stats.TopDirectors); result.Error != nil {
+			return errors.Wrap(result.Error, "statsRepo.FindStats.findTopDirectors")
+		}
+
+		if result := tx.Raw(findTopCreators, topLimit).Scan(&stats.TopCreators); result.Error != nil {
+			return errors.Wrap(result.Error, "statsRepo.FindStats.findTopCreators")
+		}
+
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		r.logger.Error("statsRepo.FindStats", zap.Error(err))
+
+		return models.Stats{}, err
+	}
+
+	stats.Films, stats.Directors, stats.Casts, stats.Users = totals.Films, totals.Directors, totals.Casts, totals.Users
+
+	return stats, nil
+}
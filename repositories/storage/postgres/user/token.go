@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"film-management/internal/user/domain"
+	"film-management/internal/user/domain/models"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// CreateRefreshToken is a method to create refresh token.
+func (r Repository) CreateRefreshToken(ctx context.Context, model *models.RefreshToken) error {
+	if err := r.db.WithContext(ctx).Omit("User").Create(model).Error; err != nil {
+		r.logger.Error("userRepo.CreateRefreshToken.Create", zap.Error(err))
+
+		return errors.Wrap(err, "userRepo.CreateRefreshToken.Create")
+	}
+
+	return nil
+}
+
+// FindOneRefreshTokenByHash is a method to find one refresh token by hash.
+func (r Repository) FindOneRefreshTokenByHash(ctx context.Context, tokenHash string) (models.RefreshToken, error) {
+	var token models.RefreshToken
+
+	if result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.RefreshToken{}, errors.Wrap(domain.ErrRefreshTokenNotFound, "userRepo.FindOneRefreshTokenByHash.First")
+		}
+		r.logger.Error("userRepo.FindOneRefreshTokenByHash.First", zap.Error(result.Error))
+
+		return models.RefreshToken{}, errors.Wrap(result.Error, "userRepo.FindOneRefreshTokenByHash.First")
+	}
+
+	return token, nil
+}
+
+// UseRefreshToken is a method to mark refresh token as used.
+// The update is conditional, so only one of concurrent requests can use the token.
+func (r Repository) UseRefreshToken(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now().Unix())
+
+	if result.Error != nil {
+		r.logger.Error("userRepo.UseRefreshToken.Update", zap.Error(result.Error))
+
+		return errors.Wrap(result.Error, "userRepo.UseRefreshToken.Update")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.Wrap(domain.ErrRefreshTokenReused, "userRepo.UseRefreshToken.Update")
+	}
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily is a method to revoke all refresh tokens of the family.
+func (r Repository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now().Unix()).Error; err != nil {
+		r.logger.Error("userRepo.RevokeRefreshTokenFamily.Update", zap.Error(err))
+
+		return errors.Wrap(err, "userRepo.RevokeRefreshTokenFamily.Update")
+	}
+
+	return nil
+}
+
+// RevokeAccessToken is a method to add access token to denylist. Expired tokens are removed from denylist.
+func (r Repository) RevokeAccessToken(ctx context.Context, model *models.RevokedToken) error {
+	tx := r.db.WithContext(ctx).Begin()
+
+	// Remove expired tokens, they are rejected anyway
+	if err := tx.Where("expires_at < ?", time.Now().Unix()).Delete(&models.RevokedToken{}).Error; err != nil {
+		tx.Rollback()
+		r.logger.Error("userRepo.RevokeAccessToken.Delete", zap.Error(err))
+
+		return errors.Wrap(err, "userRepo.RevokeAccessToken.Delete")
+	}
+
+	// Add token to denylist
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(model).Error; err != nil {
+		tx.Rollback()
+		r.logger.Error("userRepo.RevokeAccessToken.Create", zap.Error(err))
+
+		return errors.Wrap(err, "userRepo.RevokeAccessToken.Create")
+	}
+
+	tx.Commit()
+
+	return nil
+}
+
+// IsAccessTokenRevoked is a method to check if access token is in denylist.
+func (r Repository) IsAccessTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var count int64
+
+	if err := r.db.WithContext(ctx).
+		Model(&models.RevokedToken{}).
+		Where("token_id = ?", tokenID).
+		Count(&count).Error; err != nil {
+		r.logger.Error("userRepo.IsAccessTokenRevoked.Count", zap.Error(err))
+
+		return false, errors.Wrap(err, "userRepo.IsAccessTokenRevoked.Count")
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,205 @@
+package watchlist
+
+import (
+	"context"
+	modelsFilm "film-management/internal/film/domain/models"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// joinFilms is a join of watchlist items with films which are not in trash.
+const joinFilms = "JOIN films ON films.uuid = watchlist_items.film_id AND films.deleted_at IS NULL"
+
+// sortColumns is a map of sort fields to db columns.
+var sortColumns = map[string]string{
+	"title":        "films.title",
+	"release_date": "films.release_date",
+	"rating":       "films.rating",
+	"added_at":     "watchlist_items.created_at",
+	"watched_at":   "watchlist_items.watched_at",
+}
+
+// Repository is a struct for work with watchlist in db.
+type Repository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewWatchlistRepository is a constructor for Repository.
+func NewWatchlistRepository(db *gorm.DB, logger *zap.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateWatchlistItem is a method to create watchlist item.
+func (w Repository) CreateWatchlistItem(ctx context.Context, model *models.WatchlistItem) error {
+	if err := w.db.WithContext(ctx).Omit("Film", "User").Create(model).Error; err != nil {
+		w.logger.Error("watchlistRepo.CreateWatchlistItem.Create", zap.Error(err))
+
+		return errors.Wrap(err, "watchlistRepo.CreateWatchlistItem.Create")
+	}
+
+	return nil
+}
+
+// UpdateWatchlistItem is a method to update watchlist item.
+func (w Repository) UpdateWatchlistItem(ctx context.Context, model *models.WatchlistItem) error {
+	if err := w.db.WithContext(ctx).Model(model).Select("Watched", "WatchedAt").Updates(model).Error; err != nil {
+		w.logger.Error("watchlistRepo.UpdateWatchlistItem.Updates", zap.Error(err))
+
+		return errors.Wrap(err, "watchlistRepo.UpdateWatchlistItem.Updates")
+	}
+
+	return nil
+}
+
+// FindOneWatchlistItem is a method to find one watchlist item by user and film.
+func (w Repository) FindOneWatchlistItem(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) (models.WatchlistItem, error) {
+	var item models.WatchlistItem
+
+	if result := w.db.WithContext(ctx).
+		Joins(joinFilms).
+		Preload("Film").
+		Preload("Film.Genres").
+		Preload("Film.Director").
+		Preload("Film.Credits", func(db *gorm.DB) *gorm.DB {
+			return db.Order("billing_order, id")
+		}).
+		Preload("Film.Credits.Cast").
+		Where("watchlist_items.user_id = ? AND watchlist_items.film_id = ?", userID, filmID).
+		First(&item); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return models.WatchlistItem{}, errors.Wrap(domain.ErrWatchlistItemNotFound, "watchlistRepo.FindOneWatchlistItem.First")
+		}
+
+		w.logger.Error("watchlistRepo.FindOneWatchlistItem.First", zap.Error(result.Error))
+
+		return models.WatchlistItem{}, errors.Wrap(result.Error, "watchlistRepo.FindOneWatchlistItem.First")
+	}
+
+	return item, nil
+}
+
+// FindAllWatchlistItems is a method to find all watchlist items of user.
+func (w Repository) FindAllWatchlistItems(ctx context.Context, userID uuid.UUID, filterSortLimit query.FilterSortLimit) ([]models.WatchlistItem, pagination.Pagination, error) {
+	var items []models.WatchlistItem
+
+	// Build condition
+	condition := w.db.Where("watchlist_items.user_id = ?", userID)
+
+	// Add filters to condition
+	for field, value := range filterSortLimit.Filter {
+		switch field {
+		case "watched":
+			condition = condition.Where("watchlist_items.watched = ?", value)
+		default:
+			return nil, pagination.Pagination{}, customError.ValidationError{Field: field, Err: domain.ErrWatchlistUnknownField}
+		}
+	}
+
+	// Get sort column
+	column, ok := sortColumns[filterSortLimit.Sort.Field()]
+	if !ok {
+		return nil, pagination.Pagination{}, customError.ValidationError{Field: "sort", Err: domain.ErrWatchlistUnknownField}
+	}
+
+	// Items which are not watched are the last in ascending order in every database, like NULL values in Postgres
+	nulls := "NULLS LAST"
+	if strings.EqualFold(filterSortLimit.Sort.Order(), sort.OrderDESC) {
+		nulls = "NULLS FIRST"
+	}
+
+	// Find all watchlist items with condition, items with the same key are ordered by ID
+	if result := w.db.WithContext(ctx).
+		Joins(joinFilms).
+		Preload("Film").
+		Preload("Film.Genres").
+		Preload("Film.Director").
+		Preload("Film.Credits", func(db *gorm.DB) *gorm.DB {
+			return db.Order("billing_order, id")
+		}).
+		Preload("Film.Credits.Cast").
+		Where(condition).
+		Limit(filterSortLimit.Limit).
+		Offset(filterSortLimit.Offset).
+		Order(fmt.Sprintf("%s %s %s, watchlist_items.id", column, filterSortLimit.Sort.Order(), nulls)).
+		Find(&items); result.Error != nil {
+		w.logger.Error("watchlistRepo.FindAllWatchlistItems.Find", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrWatchlistItemFindAll
+	}
+
+	// Get count of watchlist items with condition for pagination
+	var count int64
+
+	if result := w.db.WithContext(ctx).Model(models.WatchlistItem{}).Joins(joinFilms).Where(condition).Count(&count); result.Error != nil {
+		w.logger.Error("watchlistRepo.FindAllWatchlistItems.Count", zap.Error(result.Error))
+
+		return nil, pagination.Pagination{}, domain.ErrWatchlistItemFindAll
+	}
+
+	return items, pagination.NewPagination(int(count), filterSortLimit.Limit, filterSortLimit.Offset), nil
+}
+
+// DeleteWatchlistItem is a method to delete watchlist item.
+func (w Repository) DeleteWatchlistItem(ctx context.Context, id uint) error {
+	if err := w.db.WithContext(ctx).Delete(&models.WatchlistItem{}, id).Error; err != nil {
+		w.logger.Error("watchlistRepo.DeleteWatchlistItem.Delete", zap.Error(err))
+
+		return errors.Wrap(err, "watchlistRepo.DeleteWatchlistItem.Delete")
+	}
+
+	return nil
+}
+
+// WatchlistItemExists is a method to check if film is already in user watchlist.
+func (w Repository) WatchlistItemExists(ctx context.Context, userID uuid.UUID, filmID uuid.UUID) error {
+	var count int64
+
+	if err := w.db.WithContext(ctx).
+		Model(&models.WatchlistItem{}).
+		Where("user_id = ? AND film_id = ?", userID, filmID).
+		Count(&count).Error; err != nil {
+		w.logger.Error("watchlistRepo.WatchlistItemExists.Count", zap.Error(err))
+
+		return errors.Wrap(err, "watchlistRepo.WatchlistItemExists.Count")
+	}
+
+	if count > 0 {
+		return domain.ErrWatchlistItemExists
+	}
+
+	return nil
+}
+
+// FilmExists is a method to check if film exists.
+func (w Repository) FilmExists(ctx context.Context, filmID uuid.UUID) error {
+	var count int64
+
+	if err := w.db.WithContext(ctx).
+		Model(&modelsFilm.Film{}).
+		Where("uuid = ?", filmID).
+		Count(&count).Error; err != nil {
+		w.logger.Error("watchlistRepo.FilmExists.Count", zap.Error(err))
+
+		return errors.Wrap(err, "watchlistRepo.FilmExists.Count")
+	}
+
+	if count == 0 {
+		return domain.ErrWatchlistFilmNotFound
+	}
+
+	return nil
+}
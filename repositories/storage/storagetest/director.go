@@ -0,0 +1,56 @@
+package storagetest
+
+import (
+	"context"
+	"film-management/pkg/query"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFilterDirectors(t *testing.T, r Repositories) {
+	user := createUser(t, r, "alice")
+
+	for i, director := range []string{"Christopher Nolan", "Studio 100% Films", "Studio_Films", `Studio\Films`, "StudioXFilms"} {
+		createFilm(t, r, user.UUID, filmData{
+			title:       "Film " + string(rune('A'+i)),
+			director:    director,
+			releaseDate: "2020-01-01",
+			genres:      []string{"drama"},
+			cast:        []string{"Cillian Murphy"},
+		})
+	}
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{"name in any case", "NOLAN", []string{"Christopher Nolan"}},
+		{"percent sign is not a wildcard", "100%", []string{"Studio 100% Films"}},
+		{"underscore is not a wildcard", "o_F", []string{"Studio_Films"}},
+		{"backslash is not an escape", `o\F`, []string{`Studio\Films`}},
+		{"only wildcards", "%_", []string{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			directors, p, err := r.Directors.FindAllDirectors(context.TODO(), query.FilterSortLimit{
+				Sort:   sortOption(t, "name.asc", "name", "films_count"),
+				Filter: query.Filter{"name": tc.value},
+				Limit:  10,
+			})
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(directors))
+			for _, director := range directors {
+				names = append(names, director.Name)
+			}
+
+			assert.Equal(t, tc.expected, names)
+			assert.Equal(t, len(tc.expected), p.TotalCount)
+		})
+	}
+}
@@ -0,0 +1,653 @@
+package storagetest
+
+import (
+	"context"
+	"film-management/internal/film/domain"
+	"film-management/internal/film/domain/models"
+	userModels "film-management/internal/user/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"film-management/pkg/query/pagination"
+	"film-management/pkg/query/sort"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// catalogue is a set of films of the filter and sort cases, titles are in the same order in any collation.
+var catalogue = []filmData{
+	{title: "Alpha", director: "Christopher Nolan", releaseDate: "2010-07-16", genres: []string{"sci-fi", "thriller"}, cast: []string{"Tom Hardy", "Cillian Murphy"}},
+	{title: "Bravo", director: "Christopher Nolan", releaseDate: "2017-07-21", genres: []string{"war"}, cast: []string{"Tom Hardy"}},
+	{title: "Charlie", director: "Denis Villeneuve", releaseDate: "2021-10-22", genres: []string{"sci-fi"}, cast: []string{"Timothee Chalamet"}},
+	{title: "Delta", director: "Denis Villeneuve", releaseDate: "2021-03-01", genres: []string{"thriller"}},
+	{title: "Echo", director: "Greta Gerwig", releaseDate: "1999-12-31", genres: []string{"drama"}, cast: []string{"Cillian Murphy"}},
+}
+
+// createCatalogue is a function to create films of the catalogue by the creator.
+func createCatalogue(t *testing.T, r Repositories, creatorID uuid.UUID) []models.Film {
+	t.Helper()
+
+	films := make([]models.Film, 0, len(catalogue))
+	for _, data := range catalogue {
+		films = append(films, createFilm(t, r, creatorID, data))
+	}
+
+	return films
+}
+
+// findFilms is a function to find films with the filter, sort and limit.
+func findFilms(t *testing.T, r Repositories, filterSortLimit query.FilterSortLimit) ([]models.Film, pagination.Pagination) {
+	t.Helper()
+
+	films, p, err := r.Films.FindAllFilms(context.TODO(), filterSortLimit)
+	require.NoError(t, err)
+
+	return films, p
+}
+
+// sortOption is a function to get the sort option of the sort query parameter.
+func sortOption(t *testing.T, value string, fields ...string) sort.Sortable {
+	t.Helper()
+
+	option, err := sort.GetSortOptions(value, fields, value)
+	require.NoError(t, err)
+
+	return option
+}
+
+func testGenres(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	createCatalogue(t, r, user.UUID)
+
+	// Name is unique
+	_, err := r.Films.CreateGenre(ctx, &models.Genre{Name: "drama"})
+	require.Error(t, err)
+
+	genres, err := r.Films.GetGenresByNames(ctx, []string{"sci-fi", "war", "unknown"})
+	require.NoError(t, err)
+	assert.Len(t, genres, 2)
+
+	// Films are counted
+	sciFi := findOrCreateGenre(t, r, "sci-fi")
+
+	genre, err := r.Films.FindOneGenre(ctx, sciFi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), genre.FilmsCount)
+
+	_, err = r.Films.FindOneGenre(ctx, sciFi.ID+1000)
+	require.ErrorIs(t, err, domain.ErrGenreNotFound)
+
+	all, p, err := r.Films.FindAllGenres(ctx, query.FilterSortLimit{Sort: sortOption(t, "films_count.desc", "name", "films_count"), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, int64(2), all[0].FilmsCount)
+	assert.Equal(t, 4, p.TotalCount)
+	assert.Equal(t, 2, p.TotalPages)
+
+	all, _, err = r.Films.FindAllGenres(ctx, query.FilterSortLimit{Sort: sortOption(t, "name.asc", "name", "films_count"), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+	assert.Equal(t, "drama", all[0].Name)
+
+	// Update and delete
+	sciFi.Name = "science fiction"
+	require.NoError(t, r.Films.UpdateGenre(ctx, &sciFi))
+
+	genre, err = r.Films.FindOneGenre(ctx, sciFi.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "science fiction", genre.Name)
+
+	require.NoError(t, r.Films.DeleteGenre(ctx, sciFi.ID))
+
+	_, err = r.Films.FindOneGenre(ctx, sciFi.ID)
+	require.ErrorIs(t, err, domain.ErrGenreNotFound)
+
+	films, _ := findFilms(t, r, query.FilterSortLimit{Sort: sortOption(t, "title.asc", "title"), Limit: 10, Filter: query.Filter{"title": "Charlie"}})
+	require.Len(t, films, 1)
+	assert.Empty(t, films[0].Genres)
+}
+
+func testCasts(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	cast, err := r.Films.CreateCast(ctx, &models.Cast{Name: "Tom Hardy"})
+	require.NoError(t, err)
+	assert.NotZero(t, cast.ID)
+
+	// Name is unique
+	_, err = r.Films.CreateCast(ctx, &models.Cast{Name: "Tom Hardy"})
+	require.Error(t, err)
+
+	casts, err := r.Films.GetCastsByNames(ctx, []string{"Tom Hardy", "Unknown"})
+	require.NoError(t, err)
+	require.Len(t, casts, 1)
+	assert.Equal(t, cast.ID, casts[0].ID)
+}
+
+func testCreateFilm(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	first := createFilm(t, r, user.UUID, catalogue[0])
+	second := createFilm(t, r, user.UUID, catalogue[1])
+
+	assert.NotEqual(t, uuid.Nil, first.UUID)
+	assert.Equal(t, 1, first.Version)
+
+	// The director is created once
+	assert.NotZero(t, first.DirectorID)
+	assert.Equal(t, first.DirectorID, second.DirectorID)
+
+	film, err := r.Films.FindOneFilmForViewByUUID(ctx, first.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Alpha", film.Title)
+	assert.Equal(t, "alice", film.Creator.Username)
+	assert.Equal(t, "Christopher Nolan", film.Director.Name)
+	assert.Equal(t, "2010-07-16", film.ReleaseDate.Format(time.DateOnly))
+	assert.ElementsMatch(t, []string{"sci-fi", "thriller"}, genreNames(film.Genres))
+
+	// Credits are in billing order
+	require.Len(t, film.Credits, 2)
+	assert.Equal(t, "Tom Hardy", film.Credits[0].Cast.Name)
+	assert.Equal(t, "Cillian Murphy", film.Credits[1].Cast.Name)
+
+	film, err = r.Films.FindOneFilmByUUID(ctx, first.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, first.UUID, film.UUID)
+
+	// Not found
+	_, err = r.Films.FindOneFilmByUUID(ctx, uuid.New())
+	require.ErrorIs(t, err, domain.ErrFilmNotFound)
+
+	_, err = r.Films.FindOneFilmForViewByUUID(ctx, uuid.New())
+	require.ErrorIs(t, err, domain.ErrFilmNotFound)
+
+	// Title is unique
+	require.ErrorIs(t, r.Films.FilmExistsWithTitle(ctx, "Alpha", uuid.Nil, models.OperationAdd), domain.ErrFilmExistsWithTitle)
+	require.NoError(t, r.Films.FilmExistsWithTitle(ctx, "Zulu", uuid.Nil, models.OperationAdd))
+	require.NoError(t, r.Films.FilmExistsWithTitle(ctx, "Alpha", first.UUID, models.OperationUpdate))
+	require.ErrorIs(t, r.Films.FilmExistsWithTitle(ctx, "Alpha", second.UUID, models.OperationUpdate), domain.ErrFilmExistsWithTitle)
+
+	// Titles differing only in case are the same
+	require.ErrorIs(t, r.Films.FilmExistsWithTitle(ctx, "ALPHA", uuid.Nil, models.OperationAdd), domain.ErrFilmExistsWithTitle)
+	require.ErrorIs(t, r.Films.FilmExistsWithTitle(ctx, "alpha", second.UUID, models.OperationUpdate), domain.ErrFilmExistsWithTitle)
+}
+
+func testUpdateFilm(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	created := createFilm(t, r, user.UUID, catalogue[0])
+
+	film, err := r.Films.FindOneFilmForViewByUUID(ctx, created.UUID)
+	require.NoError(t, err)
+
+	film.Title = "Alpha Returns"
+	film.Director = models.Director{Name: "Greta Gerwig"}
+	film.Genres = []models.Genre{findOrCreateGenre(t, r, "drama")}
+	film.Credits = []models.Credit{{Cast: findOrCreateCast(t, r, "Saoirse Ronan"), Department: models.DepartmentCast, BillingOrder: 1}}
+	film.Credits[0].CastID = film.Credits[0].Cast.ID
+
+	require.NoError(t, r.Films.UpdateFilm(ctx, &film, models.NewRevision(film, user.UUID, models.RevisionActionUpdate)))
+	assert.Equal(t, 2, film.Version)
+
+	updated, err := r.Films.FindOneFilmForViewByUUID(ctx, created.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Alpha Returns", updated.Title)
+	assert.Equal(t, 2, updated.Version)
+	assert.Equal(t, "Greta Gerwig", updated.Director.Name)
+	assert.Equal(t, []string{"drama"}, genreNames(updated.Genres))
+	require.Len(t, updated.Credits, 1)
+	assert.Equal(t, "Saoirse Ronan", updated.Credits[0].Cast.Name)
+
+	// The film was changed after it was read
+	stale := created
+	stale.Title = "Alpha Again"
+	require.ErrorIs(t, r.Films.UpdateFilm(ctx, &stale, models.NewRevision(stale, user.UUID, models.RevisionActionUpdate)), domain.ErrFilmVersionMismatch)
+}
+
+func testFilterFilms(t *testing.T, r Repositories) {
+	alice := createUser(t, r, "alice")
+	bob := createUser(t, r, "bob")
+	films := createCatalogue(t, r, alice.UUID)
+	createFilm(t, r, bob.UUID, filmData{title: "Foxtrot", director: "Greta Gerwig", releaseDate: "2019-12-25", genres: []string{"drama"}})
+
+	tests := []struct {
+		name   string
+		filter query.Filter
+		titles []string
+	}{
+		{"title in any case", query.Filter{"title": "HARL"}, []string{"Charlie"}},
+		{"title without wildcards", query.Filter{"title": "_"}, []string{}},
+		{"year", query.Filter{"year": 2021}, []string{"Charlie", "Delta"}},
+		{"release date range", query.Filter{"release_date": []string{"2010-07-16", "2017-07-21"}}, []string{"Alpha", "Bravo"}},
+		{"any genre", query.Filter{"genres": []string{"war", "drama"}}, []string{"Bravo", "Echo", "Foxtrot"}},
+		{"all genres", query.Filter{"genres_all": []string{"sci-fi", "thriller"}}, []string{"Alpha"}},
+		{"none of genres", query.Filter{"genres_none": []string{"sci-fi", "drama"}}, []string{"Bravo", "Delta"}},
+		{"director", query.Filter{"director": "villeneuve"}, []string{"Charlie", "Delta"}},
+		{"director without wildcards", query.Filter{"director": "%"}, []string{}},
+		{"director ids", query.Filter{"director_ids": []uint{films[0].DirectorID, films[4].DirectorID}}, []string{"Alpha", "Bravo", "Echo", "Foxtrot"}},
+		{"cast", query.Filter{"cast": "murphy"}, []string{"Alpha", "Echo"}},
+		{"cast without wildcards", query.Filter{"cast": "_"}, []string{}},
+		{"cast ids", query.Filter{"cast_ids": []uint{films[2].Credits[0].CastID}}, []string{"Charlie"}},
+		{"creator", query.Filter{"creator_id": bob.UUID}, []string{"Foxtrot"}},
+		{"several filters", query.Filter{"director": "nolan", "genres": []string{"war"}}, []string{"Bravo"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			found, p := findFilms(t, r, query.FilterSortLimit{
+				Filter:    tt.filter,
+				Sort:      sortOption(t, "title.asc", "title"),
+				Limit:     10,
+				WithCount: true,
+			})
+			assert.Equal(t, tt.titles, titles(found))
+			assert.Equal(t, len(tt.titles), p.TotalCount)
+		})
+	}
+
+	// Wrong filters are validation errors
+	wrongFilters := []struct {
+		name   string
+		filter query.Filter
+		err    error
+	}{
+		{"unknown field", query.Filter{"budget": 100}, domain.ErrFilmUnknownField},
+		{"wrong type", query.Filter{"year": "2021"}, domain.ErrFilmFilterWrong},
+		{"empty ids", query.Filter{"director_ids": []uint{}}, domain.ErrFilmFilterWrong},
+		{"unknown genre", query.Filter{"genres": []string{"western"}}, domain.ErrFilmGenresNotFound},
+		{"all genres with unknown genre", query.Filter{"genres_all": []string{"sci-fi", "western"}}, domain.ErrFilmGenresNotFound},
+	}
+
+	for _, tt := range wrongFilters {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := r.Films.FindAllFilms(context.TODO(), query.FilterSortLimit{
+				Filter: tt.filter,
+				Sort:   sortOption(t, "title.asc", "title"),
+				Limit:  10,
+			})
+
+			var validationErr customError.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.ErrorIs(t, validationErr.Err, tt.err)
+		})
+	}
+}
+
+func testSortFilms(t *testing.T, r Repositories) {
+	user := createUser(t, r, "alice")
+	createCatalogue(t, r, user.UUID)
+
+	films, p := findFilms(t, r, query.FilterSortLimit{Sort: sortOption(t, "release_date.desc", "release_date"), Limit: 2, Offset: 2, WithCount: true})
+	assert.Equal(t, []string{"Bravo", "Alpha"}, titles(films))
+	assert.Equal(t, 5, p.TotalCount)
+	assert.Equal(t, 3, p.TotalPages)
+	assert.Equal(t, 2, p.Page)
+
+	// Films are preloaded with genres, director and credits
+	assert.Equal(t, "Christopher Nolan", films[0].Director.Name)
+	assert.Equal(t, []string{"war"}, genreNames(films[0].Genres))
+	require.Len(t, films[0].Credits, 1)
+	assert.Equal(t, "Tom Hardy", films[0].Credits[0].Cast.Name)
+
+	films, p = findFilms(t, r, query.FilterSortLimit{Sort: sortOption(t, "title.desc", "title"), Limit: 10})
+	assert.Equal(t, []string{"Echo", "Delta", "Charlie", "Bravo", "Alpha"}, titles(films))
+	assert.Zero(t, p.TotalCount)
+	assert.Empty(t, p.NextCursor)
+
+	// Offset -1 is no offset, endpoints pass it when offset is not in the request
+	films, _ = findFilms(t, r, query.FilterSortLimit{Sort: sortOption(t, "title.asc", "title"), Limit: 2, Offset: -1})
+	assert.Equal(t, []string{"Alpha", "Bravo"}, titles(films))
+}
+
+func testCursorFilms(t *testing.T, r Repositories) {
+	user := createUser(t, r, "alice")
+	createCatalogue(t, r, user.UUID)
+
+	// Two films have the same rating, the cursor keeps their order
+	for _, sortValue := range []string{"release_date.desc", "release_date.asc", "title.asc", "title.desc", "rating.desc", "rating.asc"} {
+		sortValue := sortValue
+		t.Run(sortValue, func(t *testing.T) {
+			option := sortOption(t, sortValue, "title", "release_date", "rating")
+
+			all, _ := findFilms(t, r, query.FilterSortLimit{Sort: option, Limit: 10})
+			require.Len(t, all, len(catalogue))
+
+			var (
+				paged  []models.Film
+				cursor *pagination.Cursor
+			)
+
+			for page := 0; page < len(catalogue); page++ {
+				films, p := findFilms(t, r, query.FilterSortLimit{Sort: option, Limit: 2, Cursor: cursor, WithCount: true})
+				paged = append(paged, films...)
+				assert.Equal(t, len(catalogue), p.TotalCount)
+
+				if p.NextCursor == "" {
+					break
+				}
+
+				next, err := pagination.GetCursorOption(p.NextCursor, option)
+				require.NoError(t, err)
+
+				cursor = next
+			}
+
+			assert.Equal(t, titles(all), titles(paged))
+		})
+	}
+
+	// Value of the cursor has the type of the sort key
+	_, _, err := r.Films.FindAllFilms(context.TODO(), query.FilterSortLimit{
+		Sort:   sortOption(t, "rating.desc", "rating"),
+		Limit:  2,
+		Cursor: &pagination.Cursor{Sort: "rating.desc", Value: "high", UUID: uuid.New()},
+	})
+
+	var validationErr customError.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.ErrorIs(t, validationErr.Err, pagination.ErrCursorInvalid)
+}
+
+func testCursorFilmRatings(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	users := []userModels.User{createUser(t, r, "alice"), createUser(t, r, "bob"), createUser(t, r, "carol")}
+	films := createCatalogue(t, r, users[0].UUID)
+
+	// Averages do not terminate, two films have the same average
+	scores := [][]int{{7, 7, 8}, {7, 7, 8}, {2, 2, 3}, {10, 10, 1}, {1, 2}}
+
+	for i, filmScores := range scores {
+		for j, score := range filmScores {
+			require.NoError(t, r.Films.SaveRating(ctx, &models.Rating{FilmID: films[i].UUID, UserID: users[j].UUID, Score: score}))
+		}
+	}
+
+	rated, err := r.Films.FindOneFilmByUUID(ctx, films[0].UUID)
+	require.NoError(t, err)
+	assert.Equal(t, 7.33, rated.Rating)
+
+	for _, sortValue := range []string{"rating.desc", "rating.asc"} {
+		option := sortOption(t, sortValue, "rating")
+
+		all, _ := findFilms(t, r, query.FilterSortLimit{Sort: option, Limit: 10})
+		require.Len(t, all, len(catalogue))
+
+		// Every page has one film, so every film is at the edge of a page
+		var (
+			paged  []models.Film
+			cursor *pagination.Cursor
+		)
+
+		for page := 0; page <= len(catalogue); page++ {
+			pageFilms, p := findFilms(t, r, query.FilterSortLimit{Sort: option, Limit: 1, Cursor: cursor})
+			paged = append(paged, pageFilms...)
+
+			if p.NextCursor == "" {
+				break
+			}
+
+			cursor, err = pagination.GetCursorOption(p.NextCursor, option)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, titles(all), titles(paged), sortValue)
+	}
+}
+
+func testSearchFilms(t *testing.T, r Repositories) {
+	user := createUser(t, r, "alice")
+	createCatalogue(t, r, user.UUID)
+
+	// Title weighs more than people
+	films, _ := findFilms(t, r, query.FilterSortLimit{
+		Filter: query.Filter{"q": "echo"},
+		Sort:   sortOption(t, "relevance.desc", "relevance"),
+		Limit:  10,
+	})
+	require.Len(t, films, 1)
+	assert.Equal(t, "Echo", films[0].Title)
+	assert.Positive(t, films[0].Relevance)
+	assert.Contains(t, films[0].Highlight, "<mark>")
+
+	// Text of the snippet is escaped, only marks are HTML
+	createFilm(t, r, user.UUID, filmData{title: "Foxtrot <b>bold</b>", director: "Greta Gerwig", releaseDate: "2001-01-01"})
+
+	films, _ = findFilms(t, r, query.FilterSortLimit{
+		Filter: query.Filter{"q": "foxtrot"},
+		Sort:   sortOption(t, "relevance.desc", "relevance"),
+		Limit:  10,
+	})
+	require.Len(t, films, 1)
+	assert.Contains(t, films[0].Highlight, "<mark>Foxtrot</mark> &lt;b&gt;bold&lt;/b&gt;")
+	assert.NotContains(t, films[0].Highlight, "<b>")
+
+	// People are searched too
+	films, _ = findFilms(t, r, query.FilterSortLimit{
+		Filter: query.Filter{"q": "villeneuve"},
+		Sort:   sortOption(t, "title.asc", "title"),
+		Limit:  10,
+	})
+	assert.Equal(t, []string{"Charlie", "Delta"}, titles(films))
+
+	films, _ = findFilms(t, r, query.FilterSortLimit{
+		Filter: query.Filter{"q": "hardy -bravo"},
+		Sort:   sortOption(t, "title.asc", "title"),
+		Limit:  10,
+	})
+	assert.Equal(t, []string{"Alpha"}, titles(films))
+}
+
+func testFilmFacets(t *testing.T, r Repositories) {
+	user := createUser(t, r, "alice")
+	createCatalogue(t, r, user.UUID)
+
+	counts, err := r.Films.CountFilmFacets(context.TODO(), query.Filter{"year": 2021}, []string{models.FacetGenres, models.FacetDecade, models.FacetDirector})
+	require.NoError(t, err)
+	assert.Equal(t, []models.FacetCount{
+		{Facet: models.FacetGenres, Value: "sci-fi", Count: 1},
+		{Facet: models.FacetGenres, Value: "thriller", Count: 1},
+		{Facet: models.FacetDecade, Value: "2020", Count: 2},
+		{Facet: models.FacetDirector, Value: "Denis Villeneuve", Count: 2},
+	}, counts)
+
+	counts, err = r.Films.CountFilmFacets(context.TODO(), query.Filter{}, []string{models.FacetDecade})
+	require.NoError(t, err)
+	assert.Equal(t, []models.FacetCount{
+		{Facet: models.FacetDecade, Value: "2020", Count: 2},
+		{Facet: models.FacetDecade, Value: "2010", Count: 2},
+		{Facet: models.FacetDecade, Value: "1990", Count: 1},
+	}, counts)
+
+	_, err = r.Films.CountFilmFacets(context.TODO(), query.Filter{}, []string{"budget"})
+
+	var validationErr customError.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func testTrash(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	films := createCatalogue(t, r, user.UUID)
+	film := films[0]
+
+	// The film was changed after it was read
+	require.ErrorIs(t, r.Films.DeleteFilm(ctx, film.UUID, film.Version+1, models.NewRevision(film, user.UUID, models.RevisionActionDelete)), domain.ErrFilmVersionMismatch)
+
+	require.NoError(t, r.Films.DeleteFilm(ctx, film.UUID, film.Version, models.NewRevision(film, user.UUID, models.RevisionActionDelete)))
+
+	// Films in trash are not found and not counted
+	_, err := r.Films.FindOneFilmByUUID(ctx, film.UUID)
+	require.ErrorIs(t, err, domain.ErrFilmNotFound)
+
+	found, p := findFilms(t, r, query.FilterSortLimit{Sort: sortOption(t, "title.asc", "title"), Limit: 10, WithCount: true})
+	assert.NotContains(t, titles(found), film.Title)
+	assert.Equal(t, len(catalogue)-1, p.TotalCount)
+
+	genre, err := r.Films.FindOneGenre(ctx, film.Genres[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), genre.FilmsCount)
+
+	// Films in trash keep their titles
+	require.ErrorIs(t, r.Films.FilmExistsWithTitle(ctx, film.Title, uuid.Nil, models.OperationAdd), domain.ErrFilmExistsWithTitle)
+
+	deleted, err := r.Films.FindOneDeletedFilmByUUID(ctx, film.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Christopher Nolan", deleted.Director.Name)
+
+	_, err = r.Films.FindOneDeletedFilmByUUID(ctx, films[1].UUID)
+	require.ErrorIs(t, err, domain.ErrFilmNotFoundInTrash)
+
+	// A deleted film can not be deleted again
+	require.ErrorIs(t, r.Films.DeleteFilm(ctx, film.UUID, film.Version, models.NewRevision(film, user.UUID, models.RevisionActionDelete)), domain.ErrFilmVersionMismatch)
+
+	require.NoError(t, r.Films.DeleteFilm(ctx, films[1].UUID, films[1].Version, models.NewRevision(films[1], user.UUID, models.RevisionActionDelete)))
+
+	trash, p, err := r.Films.FindAllDeletedFilms(ctx, user.UUID, query.FilterSortLimit{Sort: sortOption(t, "title.desc", "title", "deleted_at"), Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bravo", "Alpha"}, titles(trash))
+	assert.Equal(t, 2, p.TotalCount)
+
+	trash, _, err = r.Films.FindAllDeletedFilms(ctx, uuid.New(), query.FilterSortLimit{Sort: sortOption(t, "title.desc", "title", "deleted_at"), Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	// Restore
+	require.NoError(t, r.Films.RestoreFilm(ctx, film.UUID, models.NewRevision(film, user.UUID, models.RevisionActionRestore)))
+
+	_, err = r.Films.FindOneFilmByUUID(ctx, film.UUID)
+	require.NoError(t, err)
+
+	// Purge films deleted before the time
+	count, err := r.Films.PurgeDeletedFilms(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = r.Films.PurgeDeletedFilms(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	_, err = r.Films.FindOneDeletedFilmByUUID(ctx, films[1].UUID)
+	require.ErrorIs(t, err, domain.ErrFilmNotFoundInTrash)
+	require.NoError(t, r.Films.FilmExistsWithTitle(ctx, films[1].Title, uuid.Nil, models.OperationAdd))
+}
+
+func testRatings(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	alice := createUser(t, r, "alice")
+	bob := createUser(t, r, "bob")
+	film := createFilm(t, r, alice.UUID, catalogue[0])
+
+	require.NoError(t, r.Films.SaveRating(ctx, &models.Rating{FilmID: film.UUID, UserID: alice.UUID, Score: 4}))
+	require.NoError(t, r.Films.SaveRating(ctx, &models.Rating{FilmID: film.UUID, UserID: bob.UUID, Score: 8}))
+
+	// The score of the user is replaced
+	require.NoError(t, r.Films.SaveRating(ctx, &models.Rating{FilmID: film.UUID, UserID: alice.UUID, Score: 10}))
+
+	rated, err := r.Films.FindOneFilmByUUID(ctx, film.UUID)
+	require.NoError(t, err)
+	assert.InDelta(t, 9.0, rated.Rating, 0.001)
+	assert.Equal(t, int64(2), rated.RatingCount)
+}
+
+func testReviews(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	alice := createUser(t, r, "alice")
+	bob := createUser(t, r, "bob")
+	film := createFilm(t, r, alice.UUID, catalogue[0])
+	other := createFilm(t, r, alice.UUID, catalogue[1])
+
+	review := models.Review{FilmID: film.UUID, AuthorID: bob.UUID, Text: "Great"}
+	require.NoError(t, r.Films.CreateReview(ctx, &review))
+	assert.NotEqual(t, uuid.Nil, review.UUID)
+
+	hidden := models.Review{FilmID: film.UUID, AuthorID: alice.UUID, Text: "Spam"}
+	require.NoError(t, r.Films.CreateReview(ctx, &hidden))
+
+	hidden.Hidden = true
+	require.NoError(t, r.Films.UpdateReview(ctx, &hidden))
+
+	found, err := r.Films.FindOneReviewByUUID(ctx, film.UUID, review.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "Great", found.Text)
+	assert.Equal(t, "bob", found.Author.Username)
+
+	// The review belongs to another film
+	_, err = r.Films.FindOneReviewByUUID(ctx, other.UUID, review.UUID)
+	require.ErrorIs(t, err, domain.ErrReviewNotFound)
+
+	option := sortOption(t, "created_at.desc", "created_at", "updated_at")
+
+	reviews, p, err := r.Films.FindAllReviews(ctx, film.UUID, query.FilterSortLimit{Sort: option, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, reviews, 2)
+	assert.Equal(t, 2, p.TotalCount)
+
+	reviews, p, err = r.Films.FindAllReviews(ctx, film.UUID, query.FilterSortLimit{Sort: option, Limit: 10, Filter: query.Filter{"hidden": false}})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, review.UUID, reviews[0].UUID)
+	assert.Equal(t, "bob", reviews[0].Author.Username)
+	assert.Equal(t, 1, p.TotalCount)
+
+	_, _, err = r.Films.FindAllReviews(ctx, film.UUID, query.FilterSortLimit{Sort: option, Limit: 10, Filter: query.Filter{"author": "bob"}})
+
+	var validationErr customError.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	require.NoError(t, r.Films.DeleteReview(ctx, review.UUID))
+
+	_, err = r.Films.FindOneReviewByUUID(ctx, film.UUID, review.UUID)
+	require.ErrorIs(t, err, domain.ErrReviewNotFound)
+}
+
+func testRevisions(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	created := createFilm(t, r, user.UUID, catalogue[0])
+
+	film, err := r.Films.FindOneFilmForViewByUUID(ctx, created.UUID)
+	require.NoError(t, err)
+
+	film.Synopsis = "New synopsis"
+	require.NoError(t, r.Films.UpdateFilm(ctx, &film, models.NewRevision(film, user.UUID, models.RevisionActionUpdate)))
+
+	revisions, p, err := r.Films.FindAllRevisions(ctx, film.UUID, query.FilterSortLimit{Sort: sortOption(t, "number.desc", "number"), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 2, p.TotalCount)
+	assert.Equal(t, 2, revisions[0].Number)
+	assert.Equal(t, models.RevisionActionUpdate, revisions[0].Action)
+	assert.Equal(t, "New synopsis", revisions[0].Snapshot.Synopsis)
+	assert.Equal(t, 1, revisions[1].Number)
+
+	revision, err := r.Films.FindOneRevision(ctx, film.UUID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, models.RevisionActionCreate, revision.Action)
+	assert.Equal(t, "Alpha", revision.Snapshot.Title)
+
+	_, err = r.Films.FindOneRevision(ctx, film.UUID, 3)
+	require.ErrorIs(t, err, domain.ErrRevisionNotFound)
+}
+
+// genreNames is a function to get names of the genres.
+func genreNames(genres []models.Genre) []string {
+	names := make([]string, 0, len(genres))
+	for _, genre := range genres {
+		names = append(names, genre.Name)
+	}
+
+	return names
+}
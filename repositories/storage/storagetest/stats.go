@@ -0,0 +1,89 @@
+package storagetest
+
+import (
+	"context"
+	filmModels "film-management/internal/film/domain/models"
+	"film-management/internal/stats/domain/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStats(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	alice := createUser(t, r, "alice")
+	bob := createUser(t, r, "bob")
+	createUser(t, r, "carol")
+	films := createCatalogue(t, r, alice.UUID)
+	createFilm(t, r, bob.UUID, filmData{
+		title:       "Foxtrot",
+		director:    "Greta Gerwig",
+		releaseDate: "2021-05-01",
+		genres:      []string{"drama"},
+		cast:        []string{"Saoirse Ronan"},
+	})
+
+	// Charlie is the only film of Timothee Chalamet and the second film of Denis Villeneuve, films in trash are not counted
+	charlie := films[2]
+	require.NoError(t, r.Films.DeleteFilm(ctx, charlie.UUID, charlie.Version, filmModels.NewRevision(charlie, alice.UUID, filmModels.RevisionActionDelete)))
+
+	stats, err := r.Stats.FindStats(ctx, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(5), stats.Films)
+	assert.Equal(t, int64(3), stats.Directors)
+	assert.Equal(t, int64(3), stats.Casts)
+	assert.Equal(t, int64(3), stats.Users)
+
+	// Sci-fi has one film without Charlie
+	assert.Equal(t, []models.GenreCount{
+		{Name: "drama", Count: 2},
+		{Name: "thriller", Count: 2},
+		{Name: "sci-fi", Count: 1},
+		{Name: "war", Count: 1},
+	}, stats.FilmsByGenre)
+
+	assert.Equal(t, []models.YearCount{
+		{Year: 1999, Count: 1},
+		{Year: 2010, Count: 1},
+		{Year: 2017, Count: 1},
+		{Year: 2021, Count: 2},
+	}, stats.FilmsByYear)
+
+	// Top lists are limited, Denis Villeneuve would be the first of directors with two films with Charlie
+	directors := make([]string, 0, len(stats.TopDirectors))
+	for _, director := range stats.TopDirectors {
+		assert.NotZero(t, director.ID)
+		assert.Equal(t, int64(2), director.Count)
+		directors = append(directors, director.Name)
+	}
+
+	assert.Equal(t, []string{"Christopher Nolan", "Greta Gerwig"}, directors)
+
+	// Users without films are not creators
+	assert.Equal(t, []models.CreatorCount{
+		{UUID: alice.UUID, Username: "alice", Count: 4},
+		{UUID: bob.UUID, Username: "bob", Count: 1},
+	}, stats.TopCreators)
+
+	// A restored film is counted again
+	require.NoError(t, r.Films.RestoreFilm(ctx, charlie.UUID, filmModels.NewRevision(charlie, alice.UUID, filmModels.RevisionActionRestore)))
+
+	stats, err = r.Stats.FindStats(ctx, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(6), stats.Films)
+	assert.Equal(t, int64(4), stats.Casts)
+	assert.Equal(t, []models.GenreCount{
+		{Name: "drama", Count: 2},
+		{Name: "sci-fi", Count: 2},
+		{Name: "thriller", Count: 2},
+		{Name: "war", Count: 1},
+	}, stats.FilmsByGenre)
+	require.Len(t, stats.TopDirectors, 1)
+	assert.Equal(t, "Christopher Nolan", stats.TopDirectors[0].Name)
+	require.Len(t, stats.TopCreators, 1)
+	assert.Equal(t, "alice", stats.TopCreators[0].Username)
+}
@@ -0,0 +1,176 @@
+// Package storagetest is a contract of repositories which every storage backend must pass.
+// The contract is run by tests of the backends over an empty storage for every case.
+package storagetest
+
+import (
+	"context"
+	directorDomain "film-management/internal/director/domain"
+	filmDomain "film-management/internal/film/domain"
+	filmModels "film-management/internal/film/domain/models"
+	statsDomain "film-management/internal/stats/domain"
+	userDomain "film-management/internal/user/domain"
+	userModels "film-management/internal/user/domain/models"
+	watchlistDomain "film-management/internal/watchlist/domain"
+	"film-management/pkg/auth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// UserRepository is a repository of users which is also a denylist of revoked access tokens.
+type UserRepository interface {
+	userDomain.UserRepository
+	auth.Denylist
+}
+
+// Repositories is a set of repositories of one storage backend.
+type Repositories struct {
+	Films     filmDomain.Repository
+	Directors directorDomain.Repository
+	Users     UserRepository
+	Watchlist watchlistDomain.Repository
+	Stats     statsDomain.Repository
+}
+
+// NewRepositories is a function to get repositories over an empty storage.
+type NewRepositories func(t *testing.T) Repositories
+
+// Run is a function to run the contract of repositories against the storage backend.
+func Run(t *testing.T, newRepositories NewRepositories) {
+	t.Helper()
+
+	cases := []struct {
+		name string
+		test func(t *testing.T, r Repositories)
+	}{
+		{"Users", testUsers},
+		{"RefreshTokens", testRefreshTokens},
+		{"RevokedTokens", testRevokedTokens},
+		{"Genres", testGenres},
+		{"Casts", testCasts},
+		{"CreateFilm", testCreateFilm},
+		{"UpdateFilm", testUpdateFilm},
+		{"FilterFilms", testFilterFilms},
+		{"SortFilms", testSortFilms},
+		{"CursorFilms", testCursorFilms},
+		{"CursorFilmRatings", testCursorFilmRatings},
+		{"SearchFilms", testSearchFilms},
+		{"FilmFacets", testFilmFacets},
+		{"Trash", testTrash},
+		{"Ratings", testRatings},
+		{"Reviews", testReviews},
+		{"Revisions", testRevisions},
+		{"FilterDirectors", testFilterDirectors},
+		{"Watchlist", testWatchlist},
+		{"FindAllWatchlistItems", testFindAllWatchlistItems},
+		{"Stats", testStats},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.test(t, newRepositories(t))
+		})
+	}
+}
+
+// createUser is a function to create a user with the username.
+func createUser(t *testing.T, r Repositories, username string) userModels.User {
+	t.Helper()
+
+	user := userModels.User{Username: username, Password: "hash"}
+	require.NoError(t, r.Users.CreateUser(context.TODO(), &user))
+
+	return user
+}
+
+// filmData is a data of a film to create.
+type filmData struct {
+	title       string
+	director    string
+	releaseDate string
+	genres      []string
+	// cast are names of actors in billing order
+	cast []string
+}
+
+// createFilm is a function to create a film of the creator, genres and people are created when they do not exist.
+func createFilm(t *testing.T, r Repositories, creatorID uuid.UUID, data filmData) filmModels.Film {
+	t.Helper()
+
+	ctx := context.TODO()
+
+	releaseDate, err := time.Parse(time.DateOnly, data.releaseDate)
+	require.NoError(t, err)
+
+	film := filmModels.Film{
+		CreatorID:   creatorID,
+		Title:       data.title,
+		Director:    filmModels.Director{Name: data.director},
+		ReleaseDate: releaseDate,
+		Synopsis:    "Synopsis of " + data.title,
+	}
+
+	for _, name := range data.genres {
+		film.Genres = append(film.Genres, findOrCreateGenre(t, r, name))
+	}
+
+	for i, name := range data.cast {
+		film.Credits = append(film.Credits, filmModels.Credit{
+			Cast:         findOrCreateCast(t, r, name),
+			Character:    "Character " + name,
+			Department:   filmModels.DepartmentCast,
+			BillingOrder: i + 1,
+		})
+		film.Credits[i].CastID = film.Credits[i].Cast.ID
+	}
+
+	require.NoError(t, r.Films.CreateFilm(ctx, &film, filmModels.NewRevision(film, creatorID, filmModels.RevisionActionCreate)))
+
+	return film
+}
+
+// findOrCreateGenre is a function to get the genre by name or create it.
+func findOrCreateGenre(t *testing.T, r Repositories, name string) filmModels.Genre {
+	t.Helper()
+
+	genres, err := r.Films.GetGenresByNames(context.TODO(), []string{name})
+	require.NoError(t, err)
+
+	if len(genres) > 0 {
+		return genres[0]
+	}
+
+	genre, err := r.Films.CreateGenre(context.TODO(), &filmModels.Genre{Name: name})
+	require.NoError(t, err)
+
+	return *genre
+}
+
+// findOrCreateCast is a function to get the person by name or create it.
+func findOrCreateCast(t *testing.T, r Repositories, name string) filmModels.Cast {
+	t.Helper()
+
+	casts, err := r.Films.GetCastsByNames(context.TODO(), []string{name})
+	require.NoError(t, err)
+
+	if len(casts) > 0 {
+		return casts[0]
+	}
+
+	cast, err := r.Films.CreateCast(context.TODO(), &filmModels.Cast{Name: name})
+	require.NoError(t, err)
+
+	return *cast
+}
+
+// titles is a function to get titles of the films.
+func titles(films []filmModels.Film) []string {
+	result := make([]string, 0, len(films))
+	for _, film := range films {
+		result = append(result, film.Title)
+	}
+
+	return result
+}
@@ -0,0 +1,126 @@
+package storagetest
+
+import (
+	"context"
+	"film-management/internal/user/domain"
+	"film-management/internal/user/domain/models"
+	"film-management/pkg/policy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testUsers(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	assert.NotEqual(t, uuid.Nil, user.UUID)
+	assert.Equal(t, policy.RoleEditor, user.Role)
+
+	found, err := r.Users.FindOneUserByUUID(ctx, user.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", found.Username)
+
+	found, err = r.Users.FindOneUserByUsername(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, user.UUID, found.UUID)
+
+	// Not found
+	_, err = r.Users.FindOneUserByUUID(ctx, uuid.New())
+	require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+	_, err = r.Users.FindOneUserByUsername(ctx, "bob")
+	require.ErrorIs(t, err, domain.ErrUserNotFound)
+
+	// Username is unique
+	require.ErrorIs(t, r.Users.UserExistsWithUsername(ctx, "alice"), domain.ErrUserExistsWithUsername)
+	require.NoError(t, r.Users.UserExistsWithUsername(ctx, "bob"))
+	require.Error(t, r.Users.CreateUser(ctx, &models.User{Username: "alice", Password: "hash"}))
+}
+
+func testRefreshTokens(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	user := createUser(t, r, "alice")
+	familyID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	first := models.RefreshToken{UserID: user.UUID, FamilyID: familyID, TokenHash: "first", ExpiresAt: expiresAt}
+	second := models.RefreshToken{UserID: user.UUID, FamilyID: familyID, TokenHash: "second", ExpiresAt: expiresAt}
+	require.NoError(t, r.Users.CreateRefreshToken(ctx, &first))
+	require.NoError(t, r.Users.CreateRefreshToken(ctx, &second))
+	assert.NotZero(t, first.ID)
+	assert.NotEqual(t, first.ID, second.ID)
+
+	found, err := r.Users.FindOneRefreshTokenByHash(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, found.ID)
+	assert.Nil(t, found.RevokedAt)
+
+	_, err = r.Users.FindOneRefreshTokenByHash(ctx, "unknown")
+	require.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+
+	// Only one of concurrent requests uses the token
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		used int
+	)
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := r.Users.UseRefreshToken(ctx, first.ID); err == nil {
+				mu.Lock()
+				used++
+				mu.Unlock()
+			} else {
+				assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, 1, used)
+
+	// Tokens of the family are revoked
+	require.NoError(t, r.Users.RevokeRefreshTokenFamily(ctx, familyID))
+
+	found, err = r.Users.FindOneRefreshTokenByHash(ctx, "second")
+	require.NoError(t, err)
+	assert.NotNil(t, found.RevokedAt)
+	require.ErrorIs(t, r.Users.UseRefreshToken(ctx, second.ID), domain.ErrRefreshTokenReused)
+}
+
+func testRevokedTokens(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	token := models.RevokedToken{TokenID: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour).Unix()}
+	require.NoError(t, r.Users.RevokeAccessToken(ctx, &token))
+
+	// A token revoked twice is not an error
+	require.NoError(t, r.Users.RevokeAccessToken(ctx, &models.RevokedToken{TokenID: token.TokenID, ExpiresAt: token.ExpiresAt}))
+
+	revoked, err := r.Users.IsAccessTokenRevoked(ctx, token.TokenID)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = r.Users.IsAccessTokenRevoked(ctx, uuid.NewString())
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// Expired tokens are removed when another token is revoked
+	expired := models.RevokedToken{TokenID: uuid.NewString(), ExpiresAt: time.Now().Add(-time.Hour).Unix()}
+	require.NoError(t, r.Users.RevokeAccessToken(ctx, &expired))
+	require.NoError(t, r.Users.RevokeAccessToken(ctx, &models.RevokedToken{TokenID: uuid.NewString(), ExpiresAt: token.ExpiresAt}))
+
+	revoked, err = r.Users.IsAccessTokenRevoked(ctx, expired.TokenID)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
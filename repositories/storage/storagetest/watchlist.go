@@ -0,0 +1,200 @@
+package storagetest
+
+import (
+	"context"
+	filmModels "film-management/internal/film/domain/models"
+	"film-management/internal/watchlist/domain"
+	"film-management/internal/watchlist/domain/models"
+	customError "film-management/pkg/errors"
+	"film-management/pkg/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// watchlistSortFields are sort fields of watchlist.
+var watchlistSortFields = []string{"title", "release_date", "rating", "added_at", "watched_at"}
+
+// addToWatchlist is a function to add the film to watchlist of the user.
+func addToWatchlist(t *testing.T, r Repositories, userID uuid.UUID, filmID uuid.UUID) models.WatchlistItem {
+	t.Helper()
+
+	item := models.WatchlistItem{UserID: userID, FilmID: filmID}
+	require.NoError(t, r.Watchlist.CreateWatchlistItem(context.TODO(), &item))
+
+	return item
+}
+
+// markAsWatched is a function to mark the item of watchlist as watched at the date.
+func markAsWatched(t *testing.T, r Repositories, item models.WatchlistItem, date string) {
+	t.Helper()
+
+	watchedAt, err := time.Parse(time.DateOnly, date)
+	require.NoError(t, err)
+
+	item.Watched, item.WatchedAt = true, &watchedAt
+	require.NoError(t, r.Watchlist.UpdateWatchlistItem(context.TODO(), &item))
+}
+
+// watchlistTitles is a function to get titles of films of the watchlist items.
+func watchlistTitles(items []models.WatchlistItem) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.Film.Title)
+	}
+
+	return result
+}
+
+func testWatchlist(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	alice := createUser(t, r, "alice")
+	bob := createUser(t, r, "bob")
+	films := createCatalogue(t, r, alice.UUID)
+	film := films[0]
+
+	require.NoError(t, r.Watchlist.FilmExists(ctx, film.UUID))
+	require.ErrorIs(t, r.Watchlist.FilmExists(ctx, uuid.New()), domain.ErrWatchlistFilmNotFound)
+
+	// Add
+	require.NoError(t, r.Watchlist.WatchlistItemExists(ctx, alice.UUID, film.UUID))
+
+	item := addToWatchlist(t, r, alice.UUID, film.UUID)
+	assert.NotZero(t, item.ID)
+	assert.NotZero(t, item.CreatedAt)
+
+	require.ErrorIs(t, r.Watchlist.WatchlistItemExists(ctx, alice.UUID, film.UUID), domain.ErrWatchlistItemExists)
+	require.NoError(t, r.Watchlist.WatchlistItemExists(ctx, bob.UUID, film.UUID))
+
+	found, err := r.Watchlist.FindOneWatchlistItem(ctx, alice.UUID, film.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, item.ID, found.ID)
+	assert.False(t, found.Watched)
+	assert.Nil(t, found.WatchedAt)
+	assert.Equal(t, "Alpha", found.Film.Title)
+	assert.Equal(t, "Christopher Nolan", found.Film.Director.Name)
+	assert.Len(t, found.Film.Genres, 2)
+	assert.Len(t, found.Film.Credits, 2)
+
+	_, err = r.Watchlist.FindOneWatchlistItem(ctx, bob.UUID, film.UUID)
+	require.ErrorIs(t, err, domain.ErrWatchlistItemNotFound)
+
+	// Mark as watched with a date and unwatched
+	markAsWatched(t, r, found, "2024-05-01")
+
+	found, err = r.Watchlist.FindOneWatchlistItem(ctx, alice.UUID, film.UUID)
+	require.NoError(t, err)
+	assert.True(t, found.Watched)
+	require.NotNil(t, found.WatchedAt)
+	assert.Equal(t, "2024-05-01", found.WatchedAt.Format(time.DateOnly))
+
+	found.Watched, found.WatchedAt = false, nil
+	require.NoError(t, r.Watchlist.UpdateWatchlistItem(ctx, &found))
+
+	found, err = r.Watchlist.FindOneWatchlistItem(ctx, alice.UUID, film.UUID)
+	require.NoError(t, err)
+	assert.False(t, found.Watched)
+	assert.Nil(t, found.WatchedAt)
+
+	// Remove
+	require.NoError(t, r.Watchlist.DeleteWatchlistItem(ctx, found.ID))
+
+	_, err = r.Watchlist.FindOneWatchlistItem(ctx, alice.UUID, film.UUID)
+	require.ErrorIs(t, err, domain.ErrWatchlistItemNotFound)
+	require.NoError(t, r.Watchlist.WatchlistItemExists(ctx, alice.UUID, film.UUID))
+
+	// Films in trash are not in watchlist
+	addToWatchlist(t, r, alice.UUID, films[1].UUID)
+	require.NoError(t, r.Films.DeleteFilm(ctx, films[1].UUID, films[1].Version, filmModels.NewRevision(films[1], alice.UUID, filmModels.RevisionActionDelete)))
+
+	require.ErrorIs(t, r.Watchlist.FilmExists(ctx, films[1].UUID), domain.ErrWatchlistFilmNotFound)
+
+	_, err = r.Watchlist.FindOneWatchlistItem(ctx, alice.UUID, films[1].UUID)
+	require.ErrorIs(t, err, domain.ErrWatchlistItemNotFound)
+
+	items, p, err := r.Watchlist.FindAllWatchlistItems(ctx, alice.UUID, query.FilterSortLimit{
+		Sort:  sortOption(t, "title.asc", watchlistSortFields...),
+		Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Zero(t, p.TotalCount)
+}
+
+func testFindAllWatchlistItems(t *testing.T, r Repositories) {
+	ctx := context.TODO()
+
+	alice := createUser(t, r, "alice")
+	bob := createUser(t, r, "bob")
+	films := createCatalogue(t, r, alice.UUID)
+
+	// Items are added in the same second, so they are ordered by the order of adding for the same key
+	charlie := addToWatchlist(t, r, alice.UUID, films[2].UUID)
+	alpha := addToWatchlist(t, r, alice.UUID, films[0].UUID)
+	addToWatchlist(t, r, alice.UUID, films[1].UUID)
+	addToWatchlist(t, r, bob.UUID, films[3].UUID)
+
+	markAsWatched(t, r, charlie, "2024-01-01")
+	markAsWatched(t, r, alpha, "2024-02-01")
+
+	testCases := []struct {
+		name          string
+		sort          string
+		filter        query.Filter
+		limit         int
+		offset        int
+		expected      []string
+		expectedCount int
+	}{
+		{name: "title", sort: "title.asc", expected: []string{"Alpha", "Bravo", "Charlie"}, expectedCount: 3},
+		{name: "release date", sort: "release_date.desc", expected: []string{"Charlie", "Bravo", "Alpha"}, expectedCount: 3},
+		{name: "added at", sort: "added_at.asc", expected: []string{"Charlie", "Alpha", "Bravo"}, expectedCount: 3},
+		{name: "not watched are last", sort: "watched_at.asc", expected: []string{"Charlie", "Alpha", "Bravo"}, expectedCount: 3},
+		{name: "not watched are first in descending order", sort: "watched_at.desc", expected: []string{"Bravo", "Alpha", "Charlie"}, expectedCount: 3},
+		{name: "watched", sort: "title.asc", filter: query.Filter{"watched": true}, expected: []string{"Alpha", "Charlie"}, expectedCount: 2},
+		{name: "not watched", sort: "title.asc", filter: query.Filter{"watched": false}, expected: []string{"Bravo"}, expectedCount: 1},
+		{name: "limit", sort: "title.asc", limit: 2, expected: []string{"Alpha", "Bravo"}, expectedCount: 3},
+		{name: "limit and offset", sort: "title.asc", limit: 1, offset: 1, expected: []string{"Bravo"}, expectedCount: 3},
+		{name: "offset after the last", sort: "title.asc", offset: 3, expected: []string{}, expectedCount: 3},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			limit := tc.limit
+			if limit == 0 {
+				limit = 10
+			}
+
+			items, p, err := r.Watchlist.FindAllWatchlistItems(ctx, alice.UUID, query.FilterSortLimit{
+				Sort:   sortOption(t, tc.sort, watchlistSortFields...),
+				Filter: tc.filter,
+				Limit:  limit,
+				Offset: tc.offset,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, watchlistTitles(items))
+			assert.Equal(t, tc.expectedCount, p.TotalCount)
+		})
+	}
+
+	// Watchlist of other users is not seen
+	items, p, err := r.Watchlist.FindAllWatchlistItems(ctx, bob.UUID, query.FilterSortLimit{
+		Sort:  sortOption(t, "title.asc", watchlistSortFields...),
+		Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Delta"}, watchlistTitles(items))
+	assert.Equal(t, 1, p.TotalCount)
+
+	_, _, err = r.Watchlist.FindAllWatchlistItems(ctx, alice.UUID, query.FilterSortLimit{
+		Sort:   sortOption(t, "title.asc", watchlistSortFields...),
+		Filter: query.Filter{"title": "Alpha"},
+		Limit:  10,
+	})
+	require.ErrorAs(t, err, &customError.ValidationError{})
+}